@@ -17,6 +17,120 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for ActivityType.
+const (
+	ActivityTypeComment           ActivityType = "comment"
+	ActivityTypeInviteCreated     ActivityType = "invite_created"
+	ActivityTypeMemberJoined      ActivityType = "member_joined"
+	ActivityTypeMemberLeft        ActivityType = "member_left"
+	ActivityTypeMemberRemoved     ActivityType = "member_removed"
+	ActivityTypeMemberRoleChanged ActivityType = "member_role_changed"
+	ActivityTypeTaskHistory       ActivityType = "task_history"
+)
+
+// Defines values for CalendarComponent.
+const (
+	Vevent CalendarComponent = "vevent"
+	Vtodo  CalendarComponent = "vtodo"
+)
+
+// Defines values for OpenTasksStrategy.
+const (
+	OpenTasksStrategyReassign OpenTasksStrategy = "reassign"
+	OpenTasksStrategyReject   OpenTasksStrategy = "reject"
+	OpenTasksStrategyUnassign OpenTasksStrategy = "unassign"
+)
+
+// Defines values for RepairAssigneesRequestStrategy.
+const (
+	RepairAssigneesRequestStrategyReassign RepairAssigneesRequestStrategy = "reassign"
+	RepairAssigneesRequestStrategyUnassign RepairAssigneesRequestStrategy = "unassign"
+)
+
+// Defines values for SprintState.
+const (
+	Active  SprintState = "active"
+	Closed  SprintState = "closed"
+	Planned SprintState = "planned"
+)
+
+// Defines values for TaskArchivedFilter.
+const (
+	Exclude TaskArchivedFilter = "exclude"
+	Include TaskArchivedFilter = "include"
+	Only    TaskArchivedFilter = "only"
+)
+
+// Defines values for TaskEditPolicy.
+const (
+	AssigneeFull   TaskEditPolicy = "assignee_full"
+	AssigneeStatus TaskEditPolicy = "assignee_status"
+	Strict         TaskEditPolicy = "strict"
+)
+
+// Defines values for TaskExportFormat.
+const (
+	TaskExportFormatCsv    TaskExportFormat = "csv"
+	TaskExportFormatJson   TaskExportFormat = "json"
+	TaskExportFormatNdjson TaskExportFormat = "ndjson"
+)
+
+// Defines values for TaskExportInclude.
+const (
+	Comments TaskExportInclude = "comments"
+	History  TaskExportInclude = "history"
+)
+
+// Defines values for TaskHistoryEvent.
+const (
+	Created  TaskHistoryEvent = "created"
+	Moved    TaskHistoryEvent = "moved"
+	Reverted TaskHistoryEvent = "reverted"
+	Updated  TaskHistoryEvent = "updated"
+)
+
+// Defines values for TaskHistoryField.
+const (
+	TaskHistoryFieldAssigneeId      TaskHistoryField = "assignee_id"
+	TaskHistoryFieldAssigneeIds     TaskHistoryField = "assignee_ids"
+	TaskHistoryFieldDescription     TaskHistoryField = "description"
+	TaskHistoryFieldDueAt           TaskHistoryField = "due_at"
+	TaskHistoryFieldEstimateMinutes TaskHistoryField = "estimate_minutes"
+	TaskHistoryFieldSprintId        TaskHistoryField = "sprint_id"
+	TaskHistoryFieldStatus          TaskHistoryField = "status"
+	TaskHistoryFieldStoryPoints     TaskHistoryField = "story_points"
+	TaskHistoryFieldTeamId          TaskHistoryField = "team_id"
+	TaskHistoryFieldTitle           TaskHistoryField = "title"
+)
+
+// Defines values for TaskImportFormat.
+const (
+	TaskImportFormatCsv    TaskImportFormat = "csv"
+	TaskImportFormatNdjson TaskImportFormat = "ndjson"
+)
+
+// Defines values for TaskImportStatus.
+const (
+	Completed TaskImportStatus = "completed"
+	Failed    TaskImportStatus = "failed"
+	Pending   TaskImportStatus = "pending"
+	Running   TaskImportStatus = "running"
+)
+
+// Defines values for TaskLinkType.
+const (
+	RelatesTo TaskLinkType = "relates_to"
+)
+
+// Defines values for TaskSort.
+const (
+	CreatedAtAsc  TaskSort = "created_at_asc"
+	CreatedAtDesc TaskSort = "created_at_desc"
+	Rank          TaskSort = "rank"
+	TitleAsc      TaskSort = "title_asc"
+	UpdatedAtDesc TaskSort = "updated_at_desc"
+)
+
 // Defines values for TaskStatus.
 const (
 	Done       TaskStatus = "done"
@@ -26,9 +140,29 @@ const (
 
 // Defines values for TeamMemberRole.
 const (
-	Admin  TeamMemberRole = "admin"
-	Member TeamMemberRole = "member"
-	Owner  TeamMemberRole = "owner"
+	TeamMemberRoleAdmin  TeamMemberRole = "admin"
+	TeamMemberRoleMember TeamMemberRole = "member"
+	TeamMemberRoleOwner  TeamMemberRole = "owner"
+)
+
+// Defines values for TeamMemberProfileRole.
+const (
+	TeamMemberProfileRoleAdmin  TeamMemberProfileRole = "admin"
+	TeamMemberProfileRoleMember TeamMemberProfileRole = "member"
+	TeamMemberProfileRoleOwner  TeamMemberProfileRole = "owner"
+)
+
+// Defines values for UpdateMemberRoleRequestRole.
+const (
+	Admin  UpdateMemberRoleRequestRole = "admin"
+	Member UpdateMemberRoleRequestRole = "member"
+	Owner  UpdateMemberRoleRequestRole = "owner"
+)
+
+// Defines values for VelocityGroupBy.
+const (
+	VelocityGroupBySprint VelocityGroupBy = "sprint"
+	VelocityGroupByWeek   VelocityGroupBy = "week"
 )
 
 // AcceptInviteRequest defines model for AcceptInviteRequest.
@@ -36,12 +170,104 @@ type AcceptInviteRequest struct {
 	Code string `json:"code"`
 }
 
+// ActivityFeedResponse defines model for ActivityFeedResponse.
+type ActivityFeedResponse struct {
+	Items []ActivityItem `json:"items"`
+
+	// NextCursor Отсутствует, если событий больше нет
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ActivityItem Событие ленты. Для task_history заполнены history_event и changes, для comment — body.
+// События участников строятся по журналу команды: user_id — затронутый участник, role — новая роль,
+// old_role — прежняя (member_joined — role, member_role_changed — old_role и role,
+// member_removed и member_left — old_role); для invite_created заполнен email.
+type ActivityItem struct {
+	ActorId      UUID                        `json:"actor_id"`
+	Body         *string                     `json:"body,omitempty"`
+	Changes      *map[string]TaskFieldChange `json:"changes,omitempty"`
+	Email        *openapi_types.Email        `json:"email,omitempty"`
+	HistoryEvent *TaskHistoryEvent           `json:"history_event,omitempty"`
+	Id           UUID                        `json:"id"`
+	OccurredAt   time.Time                   `json:"occurred_at"`
+	OldRole      *string                     `json:"old_role,omitempty"`
+	Role         *string                     `json:"role,omitempty"`
+	TaskId       *UUID                       `json:"task_id,omitempty"`
+	TeamId       UUID                        `json:"team_id"`
+	Type         ActivityType                `json:"type"`
+	UserId       *UUID                       `json:"user_id,omitempty"`
+}
+
+// ActivityType defines model for ActivityType.
+type ActivityType string
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	CommentId   *UUID     `json:"comment_id,omitempty"`
+	ContentType string    `json:"content_type"`
+	CreatedAt   time.Time `json:"created_at"`
+	FileName    string    `json:"file_name"`
+	Id          UUID      `json:"id"`
+	SizeBytes   int64     `json:"size_bytes"`
+	TaskId      UUID      `json:"task_id"`
+	UploadedBy  UUID      `json:"uploaded_by"`
+}
+
+// AttachmentsListResponse defines model for AttachmentsListResponse.
+type AttachmentsListResponse struct {
+	Items []Attachment `json:"items"`
+}
+
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
 	Token string `json:"token"`
 	User  User   `json:"user"`
 }
 
+// CalendarComponent defines model for CalendarComponent.
+type CalendarComponent string
+
+// CalendarFeed defines model for CalendarFeed.
+type CalendarFeed struct {
+	// CreatedAt Время выпуска действующего токена
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Enabled   bool       `json:"enabled"`
+}
+
+// CalendarFeedToken defines model for CalendarFeedToken.
+type CalendarFeedToken struct {
+	CreatedAt time.Time `json:"created_at"`
+	Token     string    `json:"token"`
+
+	// Url Адрес ленты для подписки в календаре
+	Url string `json:"url"`
+}
+
+// CloneTaskRequest defines model for CloneTaskRequest.
+type CloneTaskRequest struct {
+	CopyAssignees *bool `json:"copy_assignees,omitempty"`
+
+	// CopyAttachments Вложения задачи (без вложений комментариев) ссылаются на те же файлы
+	CopyAttachments *bool `json:"copy_attachments,omitempty"`
+	CopyDescription *bool `json:"copy_description,omitempty"`
+	CopyWatchers    *bool `json:"copy_watchers,omitempty"`
+	TeamId          *UUID `json:"team_id,omitempty"`
+
+	// Title Заголовок копии; по умолчанию заголовок исходной задачи
+	Title *string `json:"title,omitempty"`
+}
+
+// CloseSprintRequest defines model for CloseSprintRequest.
+type CloseSprintRequest struct {
+	NextSprintId *UUID `json:"next_sprint_id,omitempty"`
+}
+
+// CloseSprintResponse defines model for CloseSprintResponse.
+type CloseSprintResponse struct {
+	MovedTaskIds []UUID `json:"moved_task_ids"`
+	Sprint       Sprint `json:"sprint"`
+}
+
 // Comment defines model for Comment.
 type Comment struct {
 	Body      string    `json:"body"`
@@ -64,13 +290,42 @@ type CreateCommentRequest struct {
 	Body string `json:"body"`
 }
 
+// CreateSprintRequest defines model for CreateSprintRequest.
+type CreateSprintRequest struct {
+	EndDate   openapi_types.Date `json:"end_date"`
+	Goal      *string            `json:"goal,omitempty"`
+	Name      string             `json:"name"`
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
 // CreateTaskRequest defines model for CreateTaskRequest.
 type CreateTaskRequest struct {
-	AssigneeId  *UUID       `json:"assignee_id,omitempty"`
-	Description *string     `json:"description,omitempty"`
-	Status      *TaskStatus `json:"status,omitempty"`
-	TeamId      UUID        `json:"team_id"`
-	Title       string      `json:"title"`
+	AssigneeId *UUID `json:"assignee_id,omitempty"`
+
+	// AssigneeIds Все исполнители задачи; assignee_id становится основным
+	AssigneeIds *[]UUID `json:"assignee_ids,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// DueAt Срок выполнения; по нему отправляются напоминания
+	DueAt           *time.Time  `json:"due_at,omitempty"`
+	EstimateMinutes *int        `json:"estimate_minutes,omitempty"`
+	Status          *TaskStatus `json:"status,omitempty"`
+
+	// StoryPoints Оценка сложности в story points
+	StoryPoints *int   `json:"story_points,omitempty"`
+	TeamId      UUID   `json:"team_id"`
+	Title       string `json:"title"`
+}
+
+// CreateTaskViewRequest defines model for CreateTaskViewRequest.
+type CreateTaskViewRequest struct {
+	AssigneeIds *[]UUID `json:"assignee_ids,omitempty"`
+	Name        string  `json:"name"`
+	Shared      *bool   `json:"shared,omitempty"`
+
+	// Sort Порядок задач; по умолчанию created_at_desc, rank — ручной порядок доски
+	Sort   *TaskSort   `json:"sort,omitempty"`
+	Status *TaskStatus `json:"status,omitempty"`
 }
 
 // CreateTeamRequest defines model for CreateTeamRequest.
@@ -78,6 +333,13 @@ type CreateTeamRequest struct {
 	Name string `json:"name"`
 }
 
+// CreateWorklogRequest defines model for CreateWorklogRequest.
+type CreateWorklogRequest struct {
+	DurationMinutes int       `json:"duration_minutes"`
+	Note            *string   `json:"note,omitempty"`
+	StartedAt       time.Time `json:"started_at"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error   string  `json:"error"`
@@ -93,9 +355,9 @@ type InvalidAssignee struct {
 
 // Invite defines model for Invite.
 type Invite struct {
-	Code      string              `json:"code"`
 	CreatedAt time.Time           `json:"created_at"`
 	Email     openapi_types.Email `json:"email"`
+	ExpiresAt time.Time           `json:"expires_at"`
 	Id        UUID                `json:"id"`
 	InviterId UUID                `json:"inviter_id"`
 	TeamId    UUID                `json:"team_id"`
@@ -106,49 +368,316 @@ type InviteRequest struct {
 	Email openapi_types.Email `json:"email"`
 }
 
+// InvitesListResponse defines model for InvitesListResponse.
+type InvitesListResponse struct {
+	Items   []Invite `json:"items"`
+	Page    int      `json:"page"`
+	PerPage int      `json:"per_page"`
+	Total   int      `json:"total"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	Email    openapi_types.Email `json:"email"`
 	Password string              `json:"password"`
 }
 
+// MoveTaskRequest defines model for MoveTaskRequest.
+type MoveTaskRequest struct {
+	AfterId    *UUID       `json:"after_id,omitempty"`
+	AssigneeId *UUID       `json:"assignee_id,omitempty"`
+	BeforeId   *UUID       `json:"before_id,omitempty"`
+	Status     *TaskStatus `json:"status,omitempty"`
+	TeamId     *UUID       `json:"team_id,omitempty"`
+}
+
+// MyTask defines model for MyTask.
+type MyTask struct {
+	// ArchivedAt Время автоматической архивации; архивные задачи скрыты из списка по умолчанию
+	ArchivedAt      *time.Time `json:"archived_at,omitempty"`
+	AssigneeId      *UUID      `json:"assignee_id,omitempty"`
+	AssigneeIds     []UUID     `json:"assignee_ids"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	CreatedBy       UUID       `json:"created_by"`
+	Description     *string    `json:"description,omitempty"`
+	DueAt           *time.Time `json:"due_at,omitempty"`
+	EstimateMinutes *int       `json:"estimate_minutes,omitempty"`
+	Id              UUID       `json:"id"`
+
+	// Rank Позиция задачи в колонке доски; задачи упорядочиваются по возрастанию
+	Rank        string     `json:"rank"`
+	SprintId    *UUID      `json:"sprint_id,omitempty"`
+	Status      TaskStatus `json:"status"`
+	StoryPoints *int       `json:"story_points,omitempty"`
+	TeamId      UUID       `json:"team_id"`
+	TeamName    string     `json:"team_name"`
+	Title       string     `json:"title"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// MyTasksListResponse defines model for MyTasksListResponse.
+type MyTasksListResponse struct {
+	Items   []MyTask `json:"items"`
+	Page    int      `json:"page"`
+	PerPage int      `json:"per_page"`
+	Total   int      `json:"total"`
+}
+
+// NotificationSettings defines model for NotificationSettings.
+type NotificationSettings struct {
+	// DueReminders Письма о приближении и нарушении сроков задач, включая эскалации
+	DueReminders bool `json:"due_reminders"`
+}
+
+// OpenTasksStrategy defines model for OpenTasksStrategy.
+type OpenTasksStrategy string
+
 // RegisterRequest defines model for RegisterRequest.
 type RegisterRequest struct {
 	Email    openapi_types.Email `json:"email"`
 	Password string              `json:"password"`
 }
 
+// RepairAssigneesRequest defines model for RepairAssigneesRequest.
+type RepairAssigneesRequest struct {
+	AssigneeId *UUID                          `json:"assignee_id,omitempty"`
+	Strategy   RepairAssigneesRequestStrategy `json:"strategy"`
+}
+
+// RepairAssigneesRequestStrategy defines model for RepairAssigneesRequest.Strategy.
+type RepairAssigneesRequestStrategy string
+
+// RepairAssigneesResult defines model for RepairAssigneesResult.
+type RepairAssigneesResult struct {
+	TasksUpdated int  `json:"tasks_updated"`
+	TeamId       UUID `json:"team_id"`
+}
+
+// Sprint defines model for Sprint.
+type Sprint struct {
+	ClosedAt  *time.Time         `json:"closed_at,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	CreatedBy UUID               `json:"created_by"`
+	EndDate   openapi_types.Date `json:"end_date"`
+	Goal      *string            `json:"goal,omitempty"`
+	Id        UUID               `json:"id"`
+	Name      string             `json:"name"`
+	StartDate openapi_types.Date `json:"start_date"`
+	State     SprintState        `json:"state"`
+	TeamId    UUID               `json:"team_id"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+}
+
+// SprintState defines model for SprintState.
+type SprintState string
+
+// SprintTasksRequest defines model for SprintTasksRequest.
+type SprintTasksRequest struct {
+	TaskIds []UUID `json:"task_ids"`
+}
+
+// SprintsListResponse defines model for SprintsListResponse.
+type SprintsListResponse struct {
+	Items []Sprint `json:"items"`
+}
+
+// StopTimerRequest defines model for StopTimerRequest.
+type StopTimerRequest struct {
+	Note *string `json:"note,omitempty"`
+}
+
 // Task defines model for Task.
 type Task struct {
-	AssigneeId  *UUID      `json:"assignee_id,omitempty"`
-	CompletedAt *time.Time `json:"completed_at,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	CreatedBy   UUID       `json:"created_by"`
-	Description *string    `json:"description,omitempty"`
-	Id          UUID       `json:"id"`
+	// ArchivedAt Время автоматической архивации; архивные задачи скрыты из списка по умолчанию
+	ArchivedAt      *time.Time `json:"archived_at,omitempty"`
+	AssigneeId      *UUID      `json:"assignee_id,omitempty"`
+	AssigneeIds     []UUID     `json:"assignee_ids"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	CreatedBy       UUID       `json:"created_by"`
+	Description     *string    `json:"description,omitempty"`
+	DueAt           *time.Time `json:"due_at,omitempty"`
+	EstimateMinutes *int       `json:"estimate_minutes,omitempty"`
+	Id              UUID       `json:"id"`
+
+	// Rank Позиция задачи в колонке доски; задачи упорядочиваются по возрастанию
+	Rank        string     `json:"rank"`
+	SprintId    *UUID      `json:"sprint_id,omitempty"`
+	Status      TaskStatus `json:"status"`
+	StoryPoints *int       `json:"story_points,omitempty"`
+	TeamId      UUID       `json:"team_id"`
+	Title       string     `json:"title"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// TaskArchivedFilter Архивные задачи в списке — exclude (по умолчанию), include или only
+type TaskArchivedFilter string
+
+// TaskEditPolicy defines model for TaskEditPolicy.
+type TaskEditPolicy string
+
+// TaskExportFormat defines model for TaskExportFormat.
+type TaskExportFormat string
+
+// TaskExportInclude defines model for TaskExportInclude.
+type TaskExportInclude string
+
+// TaskExportRow defines model for TaskExportRow.
+type TaskExportRow struct {
+	// ArchivedAt Время автоматической архивации; архивные задачи скрыты из списка по умолчанию
+	ArchivedAt      *time.Time     `json:"archived_at,omitempty"`
+	AssigneeId      *UUID          `json:"assignee_id,omitempty"`
+	AssigneeIds     []UUID         `json:"assignee_ids"`
+	Comments        *[]Comment     `json:"comments,omitempty"`
+	CompletedAt     *time.Time     `json:"completed_at,omitempty"`
+	CreatedAt       time.Time      `json:"created_at"`
+	CreatedBy       UUID           `json:"created_by"`
+	Description     *string        `json:"description,omitempty"`
+	DueAt           *time.Time     `json:"due_at,omitempty"`
+	EstimateMinutes *int           `json:"estimate_minutes,omitempty"`
+	History         *[]TaskHistory `json:"history,omitempty"`
+	Id              UUID           `json:"id"`
+
+	// Rank Позиция задачи в колонке доски; задачи упорядочиваются по возрастанию
+	Rank        string     `json:"rank"`
+	SprintId    *UUID      `json:"sprint_id,omitempty"`
 	Status      TaskStatus `json:"status"`
+	StoryPoints *int       `json:"story_points,omitempty"`
 	TeamId      UUID       `json:"team_id"`
 	Title       string     `json:"title"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
 
+// TaskFieldChange Значения поля до и после изменения; для created значение from пустое
+type TaskFieldChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
 // TaskHistory defines model for TaskHistory.
 type TaskHistory struct {
-	ChangedAt time.Time              `json:"changed_at"`
-	ChangedBy UUID                   `json:"changed_by"`
-	Changes   map[string]interface{} `json:"changes"`
-	Id        UUID                   `json:"id"`
-	TaskId    UUID                   `json:"task_id"`
+	ChangedAt time.Time `json:"changed_at"`
+	ChangedBy UUID      `json:"changed_by"`
+
+	// Changes Изменения по полям (ключи — значения TaskHistoryField)
+	Changes   map[string]TaskFieldChange `json:"changes"`
+	Event     TaskHistoryEvent           `json:"event"`
+	Id        UUID                       `json:"id"`
+	RevertsId *UUID                      `json:"reverts_id,omitempty"`
+
+	// SchemaVersion Версия формата changes
+	SchemaVersion int  `json:"schema_version"`
+	TaskId        UUID `json:"task_id"`
 }
 
+// TaskHistoryEvent defines model for TaskHistoryEvent.
+type TaskHistoryEvent string
+
+// TaskHistoryField defines model for TaskHistoryField.
+type TaskHistoryField string
+
 // TaskHistoryListResponse defines model for TaskHistoryListResponse.
 type TaskHistoryListResponse struct {
-	Items []TaskHistory `json:"items"`
+	Items   []TaskHistory `json:"items"`
+	Page    int           `json:"page"`
+	PerPage int           `json:"per_page"`
+	Total   int           `json:"total"`
+}
+
+// TaskImport defines model for TaskImport.
+type TaskImport struct {
+	CreatedAt   time.Time `json:"created_at"`
+	CreatedBy   UUID      `json:"created_by"`
+	CreatedRows int       `json:"created_rows"`
+	DryRun      bool      `json:"dry_run"`
+
+	// Error Причина, по которой задание завершилось со статусом failed
+	Error         *string              `json:"error,omitempty"`
+	Errors        []TaskImportRowError `json:"errors"`
+	FinishedAt    *time.Time           `json:"finished_at,omitempty"`
+	Format        TaskImportFormat     `json:"format"`
+	Id            UUID                 `json:"id"`
+	ProcessedRows int                  `json:"processed_rows"`
+	StartedAt     *time.Time           `json:"started_at,omitempty"`
+	Status        TaskImportStatus     `json:"status"`
+	TeamId        UUID                 `json:"team_id"`
+	TotalRows     int                  `json:"total_rows"`
+}
+
+// TaskImportFormat defines model for TaskImportFormat.
+type TaskImportFormat string
+
+// TaskImportRowError defines model for TaskImportRowError.
+type TaskImportRowError struct {
+	Error string  `json:"error"`
+	Field *string `json:"field,omitempty"`
+
+	// Row Номер строки данных, начиная с 1 (без заголовка CSV)
+	Row int `json:"row"`
+}
+
+// TaskImportStatus defines model for TaskImportStatus.
+type TaskImportStatus string
+
+// TaskLink defines model for TaskLink.
+type TaskLink struct {
+	CreatedAt    time.Time    `json:"created_at"`
+	CreatedBy    UUID         `json:"created_by"`
+	LinkedTaskId UUID         `json:"linked_task_id"`
+	TaskId       UUID         `json:"task_id"`
+	Type         TaskLinkType `json:"type"`
 }
 
+// TaskLinkType defines model for TaskLinkType.
+type TaskLinkType string
+
+// TaskLinksListResponse defines model for TaskLinksListResponse.
+type TaskLinksListResponse struct {
+	Items []TaskLink `json:"items"`
+}
+
+// TaskSort Порядок задач; по умолчанию created_at_desc, rank — ручной порядок доски
+type TaskSort string
+
 // TaskStatus defines model for TaskStatus.
 type TaskStatus string
 
+// TaskView defines model for TaskView.
+type TaskView struct {
+	AssigneeIds []UUID    `json:"assignee_ids"`
+	CreatedAt   time.Time `json:"created_at"`
+	Id          UUID      `json:"id"`
+	Name        string    `json:"name"`
+	OwnerId     UUID      `json:"owner_id"`
+
+	// Shared Представление видно всем участникам команды
+	Shared bool `json:"shared"`
+
+	// Sort Порядок задач; по умолчанию created_at_desc, rank — ручной порядок доски
+	Sort      TaskSort    `json:"sort"`
+	Status    *TaskStatus `json:"status,omitempty"`
+	TeamId    UUID        `json:"team_id"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+}
+
+// TaskViewsListResponse defines model for TaskViewsListResponse.
+type TaskViewsListResponse struct {
+	Items []TaskView `json:"items"`
+}
+
+// TaskWatcher defines model for TaskWatcher.
+type TaskWatcher struct {
+	CreatedAt time.Time `json:"created_at"`
+	TaskId    UUID      `json:"task_id"`
+	UserId    UUID      `json:"user_id"`
+}
+
+// TaskWatchersListResponse defines model for TaskWatchersListResponse.
+type TaskWatchersListResponse struct {
+	Items []TaskWatcher `json:"items"`
+}
+
 // TasksListResponse defines model for TasksListResponse.
 type TasksListResponse struct {
 	Items   []Task `json:"items"`
@@ -165,6 +694,22 @@ type Team struct {
 	Name      string    `json:"name"`
 }
 
+// TeamArchivePolicy defines model for TeamArchivePolicy.
+type TeamArchivePolicy struct {
+	// ArchiveAfterDays Через сколько дней после завершения задача архивируется; null — архивация выключена
+	ArchiveAfterDays *int `json:"archive_after_days"`
+	TeamId           UUID `json:"team_id"`
+}
+
+// TeamDeletion defines model for TeamDeletion.
+type TeamDeletion struct {
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// PurgeAfter Время, после которого команда будет удалена окончательно
+	PurgeAfter time.Time `json:"purge_after"`
+	TeamId     UUID      `json:"team_id"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	CreatedAt time.Time      `json:"created_at"`
@@ -176,6 +721,26 @@ type TeamMember struct {
 // TeamMemberRole defines model for TeamMember.Role.
 type TeamMemberRole string
 
+// TeamMemberProfile defines model for TeamMemberProfile.
+type TeamMemberProfile struct {
+	CreatedAt time.Time             `json:"created_at"`
+	Email     openapi_types.Email   `json:"email"`
+	Role      TeamMemberProfileRole `json:"role"`
+	TeamId    UUID                  `json:"team_id"`
+	UserId    UUID                  `json:"user_id"`
+}
+
+// TeamMemberProfileRole defines model for TeamMemberProfile.Role.
+type TeamMemberProfileRole string
+
+// TeamMembersListResponse defines model for TeamMembersListResponse.
+type TeamMembersListResponse struct {
+	Items   []TeamMemberProfile `json:"items"`
+	Page    int                 `json:"page"`
+	PerPage int                 `json:"per_page"`
+	Total   int                 `json:"total"`
+}
+
 // TeamSummary defines model for TeamSummary.
 type TeamSummary struct {
 	DoneLast7d   int    `json:"done_last_7d"`
@@ -184,6 +749,12 @@ type TeamSummary struct {
 	TeamName     string `json:"team_name"`
 }
 
+// TeamTaskPolicy defines model for TeamTaskPolicy.
+type TeamTaskPolicy struct {
+	TaskEditPolicy TaskEditPolicy `json:"task_edit_policy"`
+	TeamId         UUID           `json:"team_id"`
+}
+
 // TeamTopCreators defines model for TeamTopCreators.
 type TeamTopCreators struct {
 	Creators []UserTaskCount `json:"creators"`
@@ -196,6 +767,27 @@ type TeamsListResponse struct {
 	Items []Team `json:"items"`
 }
 
+// TimesheetEntry defines model for TimesheetEntry.
+type TimesheetEntry struct {
+	TeamId        UUID   `json:"team_id"`
+	TeamName      string `json:"team_name"`
+	TotalMinutes  int    `json:"total_minutes"`
+	UserId        UUID   `json:"user_id"`
+	WorklogsCount int    `json:"worklogs_count"`
+}
+
+// TimesheetReport defines model for TimesheetReport.
+type TimesheetReport struct {
+	From  openapi_types.Date `json:"from"`
+	Items []TimesheetEntry   `json:"items"`
+	To    openapi_types.Date `json:"to"`
+}
+
+// TransferTeamRequest defines model for TransferTeamRequest.
+type TransferTeamRequest struct {
+	UserId UUID `json:"user_id"`
+}
+
 // UUID defines model for UUID.
 type UUID = openapi_types.UUID
 
@@ -204,12 +796,80 @@ type UpdateCommentRequest struct {
 	Body string `json:"body"`
 }
 
+// UpdateMemberRoleRequest defines model for UpdateMemberRoleRequest.
+type UpdateMemberRoleRequest struct {
+	Role UpdateMemberRoleRequestRole `json:"role"`
+}
+
+// UpdateMemberRoleRequestRole defines model for UpdateMemberRoleRequest.Role.
+type UpdateMemberRoleRequestRole string
+
+// UpdateNotificationSettingsRequest defines model for UpdateNotificationSettingsRequest.
+type UpdateNotificationSettingsRequest struct {
+	DueReminders *bool `json:"due_reminders,omitempty"`
+}
+
+// UpdateSprintRequest defines model for UpdateSprintRequest.
+type UpdateSprintRequest struct {
+	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
+	Goal      *string             `json:"goal,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
 // UpdateTaskRequest defines model for UpdateTaskRequest.
 type UpdateTaskRequest struct {
-	AssigneeId  *UUID       `json:"assignee_id,omitempty"`
-	Description *string     `json:"description,omitempty"`
-	Status      *TaskStatus `json:"status,omitempty"`
-	Title       *string     `json:"title,omitempty"`
+	AssigneeId *UUID `json:"assignee_id,omitempty"`
+
+	// AssigneeIds Все исполнители задачи; assignee_id становится основным
+	AssigneeIds *[]UUID `json:"assignee_ids,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// DueAt Срок выполнения; по нему отправляются напоминания
+	DueAt           *time.Time  `json:"due_at,omitempty"`
+	EstimateMinutes *int        `json:"estimate_minutes,omitempty"`
+	Status          *TaskStatus `json:"status,omitempty"`
+
+	// StoryPoints Оценка сложности в story points
+	StoryPoints *int    `json:"story_points,omitempty"`
+	Title       *string `json:"title,omitempty"`
+}
+
+// UpdateTaskViewRequest defines model for UpdateTaskViewRequest.
+type UpdateTaskViewRequest struct {
+	// AssigneeIds Пустой массив убирает фильтр по исполнителям
+	AssigneeIds *[]UUID `json:"assignee_ids,omitempty"`
+
+	// ClearStatus Убрать фильтр по статусу
+	ClearStatus *bool   `json:"clear_status,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Shared      *bool   `json:"shared,omitempty"`
+
+	// Sort Порядок задач; по умолчанию created_at_desc, rank — ручной порядок доски
+	Sort   *TaskSort   `json:"sort,omitempty"`
+	Status *TaskStatus `json:"status,omitempty"`
+}
+
+// UpdateTeamArchivePolicyRequest defines model for UpdateTeamArchivePolicyRequest.
+type UpdateTeamArchivePolicyRequest struct {
+	ArchiveAfterDays *int `json:"archive_after_days"`
+}
+
+// UpdateTeamRequest defines model for UpdateTeamRequest.
+type UpdateTeamRequest struct {
+	Name string `json:"name"`
+}
+
+// UpdateTeamTaskPolicyRequest defines model for UpdateTeamTaskPolicyRequest.
+type UpdateTeamTaskPolicyRequest struct {
+	TaskEditPolicy TaskEditPolicy `json:"task_edit_policy"`
+}
+
+// UpdateWorklogRequest defines model for UpdateWorklogRequest.
+type UpdateWorklogRequest struct {
+	DurationMinutes *int       `json:"duration_minutes,omitempty"`
+	Note            *string    `json:"note,omitempty"`
+	StartedAt       *time.Time `json:"started_at,omitempty"`
 }
 
 // User defines model for User.
@@ -225,46 +885,209 @@ type UserTaskCount struct {
 	UserId       UUID `json:"user_id"`
 }
 
+// VelocityEntry defines model for VelocityEntry.
+type VelocityEntry struct {
+	// CompletedPoints Сумма story points задач, завершенных в периоде
+	CompletedPoints int     `json:"completed_points"`
+	CompletedTasks  int     `json:"completed_tasks"`
+	SprintId        *UUID   `json:"sprint_id,omitempty"`
+	SprintName      *string `json:"sprint_name,omitempty"`
+	TeamId          UUID    `json:"team_id"`
+	TeamName        string  `json:"team_name"`
+
+	// UnestimatedTasks Завершенные задачи без story points
+	UnestimatedTasks int `json:"unestimated_tasks"`
+
+	// WeekStart Понедельник недели (для group_by=week)
+	WeekStart *openapi_types.Date `json:"week_start,omitempty"`
+}
+
+// VelocityGroupBy defines model for VelocityGroupBy.
+type VelocityGroupBy string
+
+// VelocityReport defines model for VelocityReport.
+type VelocityReport struct {
+	From    openapi_types.Date `json:"from"`
+	GroupBy VelocityGroupBy    `json:"group_by"`
+	Items   []VelocityEntry    `json:"items"`
+	To      openapi_types.Date `json:"to"`
+}
+
+// Worklog defines model for Worklog.
+type Worklog struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// DurationMinutes Отсутствует, пока таймер запущен
+	DurationMinutes *int      `json:"duration_minutes,omitempty"`
+	Id              UUID      `json:"id"`
+	Note            *string   `json:"note,omitempty"`
+	Running         bool      `json:"running"`
+	StartedAt       time.Time `json:"started_at"`
+	TaskId          UUID      `json:"task_id"`
+	UserId          UUID      `json:"user_id"`
+}
+
+// WorklogsListResponse defines model for WorklogsListResponse.
+type WorklogsListResponse struct {
+	Items        []Worklog `json:"items"`
+	TotalMinutes int       `json:"total_minutes"`
+}
+
+// ActivityTypes defines model for ActivityTypes.
+type ActivityTypes = []ActivityType
+
+// AttachmentId defines model for AttachmentId.
+type AttachmentId = UUID
+
 // CommentId defines model for CommentId.
 type CommentId = UUID
 
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// HistoryId defines model for HistoryId.
+type HistoryId = UUID
+
+// ImportId defines model for ImportId.
+type ImportId = UUID
+
+// InviteId defines model for InviteId.
+type InviteId = UUID
+
+// Limit defines model for Limit.
+type Limit = int
+
+// MemberUserId defines model for MemberUserId.
+type MemberUserId = UUID
+
+// OpenTasks defines model for OpenTasks.
+type OpenTasks = OpenTasksStrategy
+
 // Page defines model for Page.
 type Page = int
 
 // PerPage defines model for PerPage.
 type PerPage = int
 
+// ReassignTo defines model for ReassignTo.
+type ReassignTo = UUID
+
+// SprintId defines model for SprintId.
+type SprintId = UUID
+
 // TaskId defines model for TaskId.
 type TaskId = UUID
 
 // TeamId defines model for TeamId.
 type TeamId = UUID
 
+// ViewId defines model for ViewId.
+type ViewId = UUID
+
+// WorklogId defines model for WorklogId.
+type WorklogId = UUID
+
 // BadRequest defines model for BadRequest.
 type BadRequest = ErrorResponse
 
+// Conflict defines model for Conflict.
+type Conflict = ErrorResponse
+
 // Forbidden defines model for Forbidden.
 type Forbidden = ErrorResponse
 
 // NotFound defines model for NotFound.
 type NotFound = ErrorResponse
 
+// PayloadTooLarge defines model for PayloadTooLarge.
+type PayloadTooLarge = ErrorResponse
+
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = ErrorResponse
 
+// UnsupportedMediaType defines model for UnsupportedMediaType.
+type UnsupportedMediaType = ErrorResponse
+
+// GetApiV1CalendarTokenTasksIcsParams defines parameters for GetApiV1CalendarTokenTasksIcs.
+type GetApiV1CalendarTokenTasksIcsParams struct {
+	Component *CalendarComponent `form:"component,omitempty" json:"component,omitempty"`
+}
+
+// GetApiV1MeTasksParams defines parameters for GetApiV1MeTasks.
+type GetApiV1MeTasksParams struct {
+	Status   *TaskStatus         `form:"status,omitempty" json:"status,omitempty"`
+	Sort     *TaskSort           `form:"sort,omitempty" json:"sort,omitempty"`
+	Archived *TaskArchivedFilter `form:"archived,omitempty" json:"archived,omitempty"`
+	Page     *Page               `form:"page,omitempty" json:"page,omitempty"`
+	PerPage  *PerPage            `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// GetApiV1ReportsTimesheetParams defines parameters for GetApiV1ReportsTimesheet.
+type GetApiV1ReportsTimesheetParams struct {
+	// From Начало периода (включительно), по умолчанию первый день текущего месяца
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (включительно), по умолчанию текущий день
+	To     *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+	TeamId *UUID               `form:"team_id,omitempty" json:"team_id,omitempty"`
+	UserId *UUID               `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
 // GetApiV1ReportsTopCreatorsParams defines parameters for GetApiV1ReportsTopCreators.
 type GetApiV1ReportsTopCreatorsParams struct {
 	// Month Месяц в формате YYYY-MM
 	Month *string `form:"month,omitempty" json:"month,omitempty"`
 }
 
+// GetApiV1ReportsVelocityParams defines parameters for GetApiV1ReportsVelocity.
+type GetApiV1ReportsVelocityParams struct {
+	// GroupBy Группировка, по умолчанию week
+	GroupBy *VelocityGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// From Начало периода (включительно), по умолчанию понедельник за 11 недель до текущей
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (включительно), по умолчанию текущий день
+	To     *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+	TeamId *UUID               `form:"team_id,omitempty" json:"team_id,omitempty"`
+}
+
 // GetApiV1TasksParams defines parameters for GetApiV1Tasks.
 type GetApiV1TasksParams struct {
-	TeamId     UUID        `form:"team_id" json:"team_id"`
-	Status     *TaskStatus `form:"status,omitempty" json:"status,omitempty"`
-	AssigneeId *UUID       `form:"assignee_id,omitempty" json:"assignee_id,omitempty"`
-	Page       *Page       `form:"page,omitempty" json:"page,omitempty"`
-	PerPage    *PerPage    `form:"per_page,omitempty" json:"per_page,omitempty"`
+	TeamId *UUID       `form:"team_id,omitempty" json:"team_id,omitempty"`
+	ViewId *UUID       `form:"view_id,omitempty" json:"view_id,omitempty"`
+	Status *TaskStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// AssigneeId Задачи, где назначен хотя бы один из перечисленных пользователей
+	AssigneeId *[]UUID `form:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+
+	// SprintId Задачи спринта
+	SprintId *UUID               `form:"sprint_id,omitempty" json:"sprint_id,omitempty"`
+	Sort     *TaskSort           `form:"sort,omitempty" json:"sort,omitempty"`
+	Archived *TaskArchivedFilter `form:"archived,omitempty" json:"archived,omitempty"`
+	Page     *Page               `form:"page,omitempty" json:"page,omitempty"`
+	PerPage  *PerPage            `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// GetApiV1TasksIdActivityParams defines parameters for GetApiV1TasksIdActivity.
+type GetApiV1TasksIdActivityParams struct {
+	// Type Типы событий; по умолчанию все
+	Type *ActivityTypes `form:"type,omitempty" json:"type,omitempty"`
+
+	// Cursor Значение next_cursor из предыдущего ответа
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostApiV1TasksIdAttachmentsMultipartBody defines parameters for PostApiV1TasksIdAttachments.
+type PostApiV1TasksIdAttachmentsMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// PostApiV1TasksIdAttachmentsParams defines parameters for PostApiV1TasksIdAttachments.
+type PostApiV1TasksIdAttachmentsParams struct {
+	// CommentId Комментарий, к которому относится вложение
+	CommentId *UUID `form:"comment_id,omitempty" json:"comment_id,omitempty"`
 }
 
 // GetApiV1TasksIdCommentsParams defines parameters for GetApiV1TasksIdComments.
@@ -273,38 +1096,205 @@ type GetApiV1TasksIdCommentsParams struct {
 	PerPage *PerPage `form:"per_page,omitempty" json:"per_page,omitempty"`
 }
 
-// PostApiV1LoginJSONRequestBody defines body for PostApiV1Login for application/json ContentType.
-type PostApiV1LoginJSONRequestBody = LoginRequest
+// GetApiV1TasksIdHistoryParams defines parameters for GetApiV1TasksIdHistory.
+type GetApiV1TasksIdHistoryParams struct {
+	// Field Только записи, затрагивающие поле
+	Field     *TaskHistoryField `form:"field,omitempty" json:"field,omitempty"`
+	ChangedBy *UUID             `form:"changed_by,omitempty" json:"changed_by,omitempty"`
+	From      *time.Time        `form:"from,omitempty" json:"from,omitempty"`
+	To        *time.Time        `form:"to,omitempty" json:"to,omitempty"`
+	Page      *Page             `form:"page,omitempty" json:"page,omitempty"`
+	PerPage   *PerPage          `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
 
-// PostApiV1RegisterJSONRequestBody defines body for PostApiV1Register for application/json ContentType.
-type PostApiV1RegisterJSONRequestBody = RegisterRequest
+// GetApiV1TeamsIdActivityParams defines parameters for GetApiV1TeamsIdActivity.
+type GetApiV1TeamsIdActivityParams struct {
+	// Type Типы событий; по умолчанию все
+	Type *ActivityTypes `form:"type,omitempty" json:"type,omitempty"`
 
-// PostApiV1TasksJSONRequestBody defines body for PostApiV1Tasks for application/json ContentType.
-type PostApiV1TasksJSONRequestBody = CreateTaskRequest
+	// Cursor Значение next_cursor из предыдущего ответа
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
 
-// PutApiV1TasksIdJSONRequestBody defines body for PutApiV1TasksId for application/json ContentType.
-type PutApiV1TasksIdJSONRequestBody = UpdateTaskRequest
+// GetApiV1TeamsIdExportParams defines parameters for GetApiV1TeamsIdExport.
+type GetApiV1TeamsIdExportParams struct {
+	Format TaskExportFormat `form:"format" json:"format"`
+	Status *TaskStatus      `form:"status,omitempty" json:"status,omitempty"`
 
-// PostApiV1TasksIdCommentsJSONRequestBody defines body for PostApiV1TasksIdComments for application/json ContentType.
-type PostApiV1TasksIdCommentsJSONRequestBody = CreateCommentRequest
+	// AssigneeId Задачи, где назначен хотя бы один из перечисленных пользователей
+	AssigneeId *[]UUID `form:"assignee_id,omitempty" json:"assignee_id,omitempty"`
 
-// PutApiV1TasksIdCommentsCommentIdJSONRequestBody defines body for PutApiV1TasksIdCommentsCommentId for application/json ContentType.
-type PutApiV1TasksIdCommentsCommentIdJSONRequestBody = UpdateCommentRequest
+	// SprintId Задачи спринта
+	SprintId *UUID `form:"sprint_id,omitempty" json:"sprint_id,omitempty"`
 
-// PostApiV1TeamsJSONRequestBody defines body for PostApiV1Teams for application/json ContentType.
-type PostApiV1TeamsJSONRequestBody = CreateTeamRequest
+	// Archived Архивные задачи — include (по умолчанию), exclude или only
+	Archived *TaskArchivedFilter  `form:"archived,omitempty" json:"archived,omitempty"`
+	Include  *[]TaskExportInclude `form:"include,omitempty" json:"include,omitempty"`
+}
 
-// PostApiV1TeamsInvitesAcceptJSONRequestBody defines body for PostApiV1TeamsInvitesAccept for application/json ContentType.
-type PostApiV1TeamsInvitesAcceptJSONRequestBody = AcceptInviteRequest
+// GetApiV1TeamsIdInvitesParams defines parameters for GetApiV1TeamsIdInvites.
+type GetApiV1TeamsIdInvitesParams struct {
+	Page    *Page    `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *PerPage `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PostApiV1TeamsIdLeaveParams defines parameters for PostApiV1TeamsIdLeave.
+type PostApiV1TeamsIdLeaveParams struct {
+	// OpenTasks Что делать с открытыми задачами участника; по умолчанию unassign
+	OpenTasks *OpenTasks `form:"open_tasks,omitempty" json:"open_tasks,omitempty"`
+
+	// ReassignTo Участник команды, которому переходят задачи при open_tasks=reassign
+	ReassignTo *ReassignTo `form:"reassign_to,omitempty" json:"reassign_to,omitempty"`
+}
+
+// GetApiV1TeamsIdMembersParams defines parameters for GetApiV1TeamsIdMembers.
+type GetApiV1TeamsIdMembersParams struct {
+	// Email Префикс email без учета регистра
+	Email   *string  `form:"email,omitempty" json:"email,omitempty"`
+	Page    *Page    `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *PerPage `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// DeleteApiV1TeamsIdMembersUserIdParams defines parameters for DeleteApiV1TeamsIdMembersUserId.
+type DeleteApiV1TeamsIdMembersUserIdParams struct {
+	// OpenTasks Что делать с открытыми задачами участника; по умолчанию unassign
+	OpenTasks *OpenTasks `form:"open_tasks,omitempty" json:"open_tasks,omitempty"`
+
+	// ReassignTo Участник команды, которому переходят задачи при open_tasks=reassign
+	ReassignTo *ReassignTo `form:"reassign_to,omitempty" json:"reassign_to,omitempty"`
+}
+
+// GetApiV1TeamsIdSprintsParams defines parameters for GetApiV1TeamsIdSprints.
+type GetApiV1TeamsIdSprintsParams struct {
+	State *SprintState `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// PostApiV1TeamsIdTaskImportsMultipartBody defines parameters for PostApiV1TeamsIdTaskImports.
+type PostApiV1TeamsIdTaskImportsMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// PostApiV1TeamsIdTaskImportsParams defines parameters for PostApiV1TeamsIdTaskImports.
+type PostApiV1TeamsIdTaskImportsParams struct {
+	Format  TaskImportFormat `form:"format" json:"format"`
+	DryRun  *bool            `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+	Mapping *string          `form:"mapping,omitempty" json:"mapping,omitempty"`
+}
+
+// PostApiV1LoginJSONRequestBody defines body for PostApiV1Login for application/json ContentType.
+type PostApiV1LoginJSONRequestBody = LoginRequest
+
+// PutApiV1MeNotificationsJSONRequestBody defines body for PutApiV1MeNotifications for application/json ContentType.
+type PutApiV1MeNotificationsJSONRequestBody = UpdateNotificationSettingsRequest
+
+// PostApiV1RegisterJSONRequestBody defines body for PostApiV1Register for application/json ContentType.
+type PostApiV1RegisterJSONRequestBody = RegisterRequest
+
+// PutApiV1SprintsSprintIdJSONRequestBody defines body for PutApiV1SprintsSprintId for application/json ContentType.
+type PutApiV1SprintsSprintIdJSONRequestBody = UpdateSprintRequest
+
+// PostApiV1SprintsSprintIdCloseJSONRequestBody defines body for PostApiV1SprintsSprintIdClose for application/json ContentType.
+type PostApiV1SprintsSprintIdCloseJSONRequestBody = CloseSprintRequest
+
+// PostApiV1SprintsSprintIdTasksJSONRequestBody defines body for PostApiV1SprintsSprintIdTasks for application/json ContentType.
+type PostApiV1SprintsSprintIdTasksJSONRequestBody = SprintTasksRequest
+
+// PostApiV1TasksJSONRequestBody defines body for PostApiV1Tasks for application/json ContentType.
+type PostApiV1TasksJSONRequestBody = CreateTaskRequest
+
+// PutApiV1TasksIdJSONRequestBody defines body for PutApiV1TasksId for application/json ContentType.
+type PutApiV1TasksIdJSONRequestBody = UpdateTaskRequest
+
+// PostApiV1TasksIdAttachmentsMultipartRequestBody defines body for PostApiV1TasksIdAttachments for multipart/form-data ContentType.
+type PostApiV1TasksIdAttachmentsMultipartRequestBody PostApiV1TasksIdAttachmentsMultipartBody
+
+// PostApiV1TasksIdCloneJSONRequestBody defines body for PostApiV1TasksIdClone for application/json ContentType.
+type PostApiV1TasksIdCloneJSONRequestBody = CloneTaskRequest
+
+// PostApiV1TasksIdCommentsJSONRequestBody defines body for PostApiV1TasksIdComments for application/json ContentType.
+type PostApiV1TasksIdCommentsJSONRequestBody = CreateCommentRequest
+
+// PutApiV1TasksIdCommentsCommentIdJSONRequestBody defines body for PutApiV1TasksIdCommentsCommentId for application/json ContentType.
+type PutApiV1TasksIdCommentsCommentIdJSONRequestBody = UpdateCommentRequest
+
+// PostApiV1TasksIdMoveJSONRequestBody defines body for PostApiV1TasksIdMove for application/json ContentType.
+type PostApiV1TasksIdMoveJSONRequestBody = MoveTaskRequest
+
+// PostApiV1TasksIdTimerStopJSONRequestBody defines body for PostApiV1TasksIdTimerStop for application/json ContentType.
+type PostApiV1TasksIdTimerStopJSONRequestBody = StopTimerRequest
+
+// PostApiV1TasksIdWorklogsJSONRequestBody defines body for PostApiV1TasksIdWorklogs for application/json ContentType.
+type PostApiV1TasksIdWorklogsJSONRequestBody = CreateWorklogRequest
+
+// PutApiV1TasksIdWorklogsWorklogIdJSONRequestBody defines body for PutApiV1TasksIdWorklogsWorklogId for application/json ContentType.
+type PutApiV1TasksIdWorklogsWorklogIdJSONRequestBody = UpdateWorklogRequest
+
+// PostApiV1TeamsJSONRequestBody defines body for PostApiV1Teams for application/json ContentType.
+type PostApiV1TeamsJSONRequestBody = CreateTeamRequest
+
+// PostApiV1TeamsInvitesAcceptJSONRequestBody defines body for PostApiV1TeamsInvitesAccept for application/json ContentType.
+type PostApiV1TeamsInvitesAcceptJSONRequestBody = AcceptInviteRequest
+
+// PatchApiV1TeamsIdJSONRequestBody defines body for PatchApiV1TeamsId for application/json ContentType.
+type PatchApiV1TeamsIdJSONRequestBody = UpdateTeamRequest
+
+// PutApiV1TeamsIdArchivePolicyJSONRequestBody defines body for PutApiV1TeamsIdArchivePolicy for application/json ContentType.
+type PutApiV1TeamsIdArchivePolicyJSONRequestBody = UpdateTeamArchivePolicyRequest
+
+// PostApiV1TeamsIdInvalidAssigneesRepairJSONRequestBody defines body for PostApiV1TeamsIdInvalidAssigneesRepair for application/json ContentType.
+type PostApiV1TeamsIdInvalidAssigneesRepairJSONRequestBody = RepairAssigneesRequest
 
 // PostApiV1TeamsIdInviteJSONRequestBody defines body for PostApiV1TeamsIdInvite for application/json ContentType.
 type PostApiV1TeamsIdInviteJSONRequestBody = InviteRequest
 
+// PatchApiV1TeamsIdMembersUserIdJSONRequestBody defines body for PatchApiV1TeamsIdMembersUserId for application/json ContentType.
+type PatchApiV1TeamsIdMembersUserIdJSONRequestBody = UpdateMemberRoleRequest
+
+// PostApiV1TeamsIdSprintsJSONRequestBody defines body for PostApiV1TeamsIdSprints for application/json ContentType.
+type PostApiV1TeamsIdSprintsJSONRequestBody = CreateSprintRequest
+
+// PostApiV1TeamsIdTaskImportsMultipartRequestBody defines body for PostApiV1TeamsIdTaskImports for multipart/form-data ContentType.
+type PostApiV1TeamsIdTaskImportsMultipartRequestBody PostApiV1TeamsIdTaskImportsMultipartBody
+
+// PutApiV1TeamsIdTaskPolicyJSONRequestBody defines body for PutApiV1TeamsIdTaskPolicy for application/json ContentType.
+type PutApiV1TeamsIdTaskPolicyJSONRequestBody = UpdateTeamTaskPolicyRequest
+
+// PostApiV1TeamsIdTransferJSONRequestBody defines body for PostApiV1TeamsIdTransfer for application/json ContentType.
+type PostApiV1TeamsIdTransferJSONRequestBody = TransferTeamRequest
+
+// PostApiV1TeamsIdViewsJSONRequestBody defines body for PostApiV1TeamsIdViews for application/json ContentType.
+type PostApiV1TeamsIdViewsJSONRequestBody = CreateTaskViewRequest
+
+// PutApiV1ViewsViewIdJSONRequestBody defines body for PutApiV1ViewsViewId for application/json ContentType.
+type PutApiV1ViewsViewIdJSONRequestBody = UpdateTaskViewRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// iCalendar-лента задач со сроками
+	// (GET /api/v1/calendar/{token}/tasks.ics)
+	GetApiV1CalendarTokenTasksIcs(c *gin.Context, token string, params GetApiV1CalendarTokenTasksIcsParams)
 	// Вход и получение JWT
 	// (POST /api/v1/login)
 	PostApiV1Login(c *gin.Context)
+	// Отозвать календарную ленту
+	// (DELETE /api/v1/me/calendar-feed)
+	DeleteApiV1MeCalendarFeed(c *gin.Context)
+	// Состояние календарной ленты текущего пользователя
+	// (GET /api/v1/me/calendar-feed)
+	GetApiV1MeCalendarFeed(c *gin.Context)
+	// Создать или перевыпустить токен календарной ленты
+	// (POST /api/v1/me/calendar-feed)
+	PostApiV1MeCalendarFeed(c *gin.Context)
+	// Настройки уведомлений текущего пользователя
+	// (GET /api/v1/me/notifications)
+	GetApiV1MeNotifications(c *gin.Context)
+	// Изменить настройки уведомлений
+	// (PUT /api/v1/me/notifications)
+	PutApiV1MeNotifications(c *gin.Context)
+	// Задачи текущего пользователя во всех его командах
+	// (GET /api/v1/me/tasks)
+	GetApiV1MeTasks(c *gin.Context, params GetApiV1MeTasksParams)
 	// Регистрация пользователя
 	// (POST /api/v1/register)
 	PostApiV1Register(c *gin.Context)
@@ -314,9 +1304,33 @@ type ServerInterface interface {
 	// Отчет по командам (кол-во участников, done за 7 дней)
 	// (GET /api/v1/reports/team-summary)
 	GetApiV1ReportsTeamSummary(c *gin.Context)
+	// Табель учета времени по пользователям и командам
+	// (GET /api/v1/reports/timesheet)
+	GetApiV1ReportsTimesheet(c *gin.Context, params GetApiV1ReportsTimesheetParams)
 	// Топ-3 пользователей по созданным задачам за месяц
 	// (GET /api/v1/reports/top-creators)
 	GetApiV1ReportsTopCreators(c *gin.Context, params GetApiV1ReportsTopCreatorsParams)
+	// Скорость команды в story points по неделям или спринтам
+	// (GET /api/v1/reports/velocity)
+	GetApiV1ReportsVelocity(c *gin.Context, params GetApiV1ReportsVelocityParams)
+	// Получить спринт
+	// (GET /api/v1/sprints/{sprint_id})
+	GetApiV1SprintsSprintId(c *gin.Context, sprintId SprintId)
+	// Изменить спринт
+	// (PUT /api/v1/sprints/{sprint_id})
+	PutApiV1SprintsSprintId(c *gin.Context, sprintId SprintId)
+	// Закрыть спринт
+	// (POST /api/v1/sprints/{sprint_id}/close)
+	PostApiV1SprintsSprintIdClose(c *gin.Context, sprintId SprintId)
+	// Начать спринт
+	// (POST /api/v1/sprints/{sprint_id}/start)
+	PostApiV1SprintsSprintIdStart(c *gin.Context, sprintId SprintId)
+	// Добавить задачи в спринт
+	// (POST /api/v1/sprints/{sprint_id}/tasks)
+	PostApiV1SprintsSprintIdTasks(c *gin.Context, sprintId SprintId)
+	// Вернуть задачу из спринта в бэклог
+	// (DELETE /api/v1/sprints/{sprint_id}/tasks/{task_id})
+	DeleteApiV1SprintsSprintIdTasksTaskId(c *gin.Context, sprintId SprintId, taskId UUID)
 	// Список задач с фильтрами и пагинацией
 	// (GET /api/v1/tasks)
 	GetApiV1Tasks(c *gin.Context, params GetApiV1TasksParams)
@@ -326,6 +1340,24 @@ type ServerInterface interface {
 	// Обновить задачу (проверка прав)
 	// (PUT /api/v1/tasks/{id})
 	PutApiV1TasksId(c *gin.Context, id TaskId)
+	// Лента активности задачи
+	// (GET /api/v1/tasks/{id}/activity)
+	GetApiV1TasksIdActivity(c *gin.Context, id TaskId, params GetApiV1TasksIdActivityParams)
+	// Список вложений задачи и ее комментариев
+	// (GET /api/v1/tasks/{id}/attachments)
+	GetApiV1TasksIdAttachments(c *gin.Context, id TaskId)
+	// Загрузить вложение к задаче или комментарию
+	// (POST /api/v1/tasks/{id}/attachments)
+	PostApiV1TasksIdAttachments(c *gin.Context, id TaskId, params PostApiV1TasksIdAttachmentsParams)
+	// Удалить вложение (только автор)
+	// (DELETE /api/v1/tasks/{id}/attachments/{attachment_id})
+	DeleteApiV1TasksIdAttachmentsAttachmentId(c *gin.Context, id TaskId, attachmentId AttachmentId)
+	// Скачать вложение
+	// (GET /api/v1/tasks/{id}/attachments/{attachment_id})
+	GetApiV1TasksIdAttachmentsAttachmentId(c *gin.Context, id TaskId, attachmentId AttachmentId)
+	// Клонировать задачу
+	// (POST /api/v1/tasks/{id}/clone)
+	PostApiV1TasksIdClone(c *gin.Context, id TaskId)
 	// Список комментариев задачи
 	// (GET /api/v1/tasks/{id}/comments)
 	GetApiV1TasksIdComments(c *gin.Context, id TaskId, params GetApiV1TasksIdCommentsParams)
@@ -338,9 +1370,45 @@ type ServerInterface interface {
 	// Обновить комментарий
 	// (PUT /api/v1/tasks/{id}/comments/{comment_id})
 	PutApiV1TasksIdCommentsCommentId(c *gin.Context, id TaskId, commentId CommentId)
-	// История изменений задачи
+	// История изменений задачи с фильтрами и пагинацией
 	// (GET /api/v1/tasks/{id}/history)
-	GetApiV1TasksIdHistory(c *gin.Context, id TaskId)
+	GetApiV1TasksIdHistory(c *gin.Context, id TaskId, params GetApiV1TasksIdHistoryParams)
+	// Откатить изменение из истории
+	// (POST /api/v1/tasks/{id}/history/{history_id}/revert)
+	PostApiV1TasksIdHistoryHistoryIdRevert(c *gin.Context, id TaskId, historyId HistoryId)
+	// Связи задачи
+	// (GET /api/v1/tasks/{id}/links)
+	GetApiV1TasksIdLinks(c *gin.Context, id TaskId)
+	// Перенести задачу в другую команду или переместить на доске
+	// (POST /api/v1/tasks/{id}/move)
+	PostApiV1TasksIdMove(c *gin.Context, id TaskId)
+	// Запустить таймер по задаче
+	// (POST /api/v1/tasks/{id}/timer/start)
+	PostApiV1TasksIdTimerStart(c *gin.Context, id TaskId)
+	// Остановить таймер по задаче
+	// (POST /api/v1/tasks/{id}/timer/stop)
+	PostApiV1TasksIdTimerStop(c *gin.Context, id TaskId)
+	// Отписаться от задачи
+	// (DELETE /api/v1/tasks/{id}/watch)
+	DeleteApiV1TasksIdWatch(c *gin.Context, id TaskId)
+	// Подписаться на задачу
+	// (POST /api/v1/tasks/{id}/watch)
+	PostApiV1TasksIdWatch(c *gin.Context, id TaskId)
+	// Список наблюдателей задачи
+	// (GET /api/v1/tasks/{id}/watchers)
+	GetApiV1TasksIdWatchers(c *gin.Context, id TaskId)
+	// Список записей о затраченном времени
+	// (GET /api/v1/tasks/{id}/worklogs)
+	GetApiV1TasksIdWorklogs(c *gin.Context, id TaskId)
+	// Добавить запись о затраченном времени
+	// (POST /api/v1/tasks/{id}/worklogs)
+	PostApiV1TasksIdWorklogs(c *gin.Context, id TaskId)
+	// Удалить запись о затраченном времени
+	// (DELETE /api/v1/tasks/{id}/worklogs/{worklog_id})
+	DeleteApiV1TasksIdWorklogsWorklogId(c *gin.Context, id TaskId, worklogId WorklogId)
+	// Обновить запись о затраченном времени
+	// (PUT /api/v1/tasks/{id}/worklogs/{worklog_id})
+	PutApiV1TasksIdWorklogsWorklogId(c *gin.Context, id TaskId, worklogId WorklogId)
 	// Список команд, где состоит пользователь
 	// (GET /api/v1/teams)
 	GetApiV1Teams(c *gin.Context)
@@ -350,9 +1418,93 @@ type ServerInterface interface {
 	// Принять приглашение по коду
 	// (POST /api/v1/teams/invites/accept)
 	PostApiV1TeamsInvitesAccept(c *gin.Context)
+	// Удалить команду (только owner)
+	// (DELETE /api/v1/teams/{id})
+	DeleteApiV1TeamsId(c *gin.Context, id TeamId)
+	// Команда (только участник)
+	// (GET /api/v1/teams/{id})
+	GetApiV1TeamsId(c *gin.Context, id TeamId)
+	// Переименовать команду (owner/admin)
+	// (PATCH /api/v1/teams/{id})
+	PatchApiV1TeamsId(c *gin.Context, id TeamId)
+	// Лента активности команды
+	// (GET /api/v1/teams/{id}/activity)
+	GetApiV1TeamsIdActivity(c *gin.Context, id TeamId, params GetApiV1TeamsIdActivityParams)
+	// Политика архивации завершенных задач команды
+	// (GET /api/v1/teams/{id}/archive-policy)
+	GetApiV1TeamsIdArchivePolicy(c *gin.Context, id TeamId)
+	// Изменить политику архивации (owner/admin)
+	// (PUT /api/v1/teams/{id}/archive-policy)
+	PutApiV1TeamsIdArchivePolicy(c *gin.Context, id TeamId)
+	// Потоковая выгрузка задач команды
+	// (GET /api/v1/teams/{id}/export)
+	GetApiV1TeamsIdExport(c *gin.Context, id TeamId, params GetApiV1TeamsIdExportParams)
+	// Исправить исполнителей, не состоящих в команде (owner/admin)
+	// (POST /api/v1/teams/{id}/invalid-assignees/repair)
+	PostApiV1TeamsIdInvalidAssigneesRepair(c *gin.Context, id TeamId)
 	// Пригласить пользователя в команду (owner/admin)
 	// (POST /api/v1/teams/{id}/invite)
 	PostApiV1TeamsIdInvite(c *gin.Context, id TeamId)
+	// Действующие приглашения команды (owner/admin)
+	// (GET /api/v1/teams/{id}/invites)
+	GetApiV1TeamsIdInvites(c *gin.Context, id TeamId, params GetApiV1TeamsIdInvitesParams)
+	// Отозвать приглашение (owner/admin)
+	// (DELETE /api/v1/teams/{id}/invites/{invite_id})
+	DeleteApiV1TeamsIdInvitesInviteId(c *gin.Context, id TeamId, inviteId InviteId)
+	// Отправить приглашение повторно (owner/admin)
+	// (POST /api/v1/teams/{id}/invites/{invite_id}/resend)
+	PostApiV1TeamsIdInvitesInviteIdResend(c *gin.Context, id TeamId, inviteId InviteId)
+	// Покинуть команду
+	// (POST /api/v1/teams/{id}/leave)
+	PostApiV1TeamsIdLeave(c *gin.Context, id TeamId, params PostApiV1TeamsIdLeaveParams)
+	// Участники команды
+	// (GET /api/v1/teams/{id}/members)
+	GetApiV1TeamsIdMembers(c *gin.Context, id TeamId, params GetApiV1TeamsIdMembersParams)
+	// Исключить участника (owner/admin)
+	// (DELETE /api/v1/teams/{id}/members/{user_id})
+	DeleteApiV1TeamsIdMembersUserId(c *gin.Context, id TeamId, userId MemberUserId, params DeleteApiV1TeamsIdMembersUserIdParams)
+	// Изменить роль участника (owner/admin)
+	// (PATCH /api/v1/teams/{id}/members/{user_id})
+	PatchApiV1TeamsIdMembersUserId(c *gin.Context, id TeamId, userId MemberUserId)
+	// Восстановить удаленную команду до окончательного удаления (только owner)
+	// (POST /api/v1/teams/{id}/restore)
+	PostApiV1TeamsIdRestore(c *gin.Context, id TeamId)
+	// Спринты команды
+	// (GET /api/v1/teams/{id}/sprints)
+	GetApiV1TeamsIdSprints(c *gin.Context, id TeamId, params GetApiV1TeamsIdSprintsParams)
+	// Создать спринт
+	// (POST /api/v1/teams/{id}/sprints)
+	PostApiV1TeamsIdSprints(c *gin.Context, id TeamId)
+	// Импортировать задачи из CSV или NDJSON
+	// (POST /api/v1/teams/{id}/task-imports)
+	PostApiV1TeamsIdTaskImports(c *gin.Context, id TeamId, params PostApiV1TeamsIdTaskImportsParams)
+	// Статус и отчет задания импорта
+	// (GET /api/v1/teams/{id}/task-imports/{import_id})
+	GetApiV1TeamsIdTaskImportsImportId(c *gin.Context, id TeamId, importId ImportId)
+	// Политика редактирования задач команды
+	// (GET /api/v1/teams/{id}/task-policy)
+	GetApiV1TeamsIdTaskPolicy(c *gin.Context, id TeamId)
+	// Изменить политику редактирования задач (owner/admin)
+	// (PUT /api/v1/teams/{id}/task-policy)
+	PutApiV1TeamsIdTaskPolicy(c *gin.Context, id TeamId)
+	// Передать владение командой другому участнику (только owner)
+	// (POST /api/v1/teams/{id}/transfer)
+	PostApiV1TeamsIdTransfer(c *gin.Context, id TeamId)
+	// Представления команды, доступные пользователю
+	// (GET /api/v1/teams/{id}/views)
+	GetApiV1TeamsIdViews(c *gin.Context, id TeamId)
+	// Сохранить представление списка задач
+	// (POST /api/v1/teams/{id}/views)
+	PostApiV1TeamsIdViews(c *gin.Context, id TeamId)
+	// Удалить представление
+	// (DELETE /api/v1/views/{view_id})
+	DeleteApiV1ViewsViewId(c *gin.Context, viewId ViewId)
+	// Получить представление
+	// (GET /api/v1/views/{view_id})
+	GetApiV1ViewsViewId(c *gin.Context, viewId ViewId)
+	// Изменить представление
+	// (PUT /api/v1/views/{view_id})
+	PutApiV1ViewsViewId(c *gin.Context, viewId ViewId)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -364,6 +1516,41 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// GetApiV1CalendarTokenTasksIcs operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1CalendarTokenTasksIcs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Param("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1CalendarTokenTasksIcsParams
+
+	// ------------- Optional query parameter "component" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "component", c.Request.URL.Query(), &params.Component)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter component: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1CalendarTokenTasksIcs(c, token, params)
+}
+
 // PostApiV1Login operation middleware
 func (siw *ServerInterfaceWrapper) PostApiV1Login(c *gin.Context) {
 
@@ -377,6 +1564,141 @@ func (siw *ServerInterfaceWrapper) PostApiV1Login(c *gin.Context) {
 	siw.Handler.PostApiV1Login(c)
 }
 
+// DeleteApiV1MeCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1MeCalendarFeed(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1MeCalendarFeed(c)
+}
+
+// GetApiV1MeCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1MeCalendarFeed(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1MeCalendarFeed(c)
+}
+
+// PostApiV1MeCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1MeCalendarFeed(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1MeCalendarFeed(c)
+}
+
+// GetApiV1MeNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1MeNotifications(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1MeNotifications(c)
+}
+
+// PutApiV1MeNotifications operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1MeNotifications(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1MeNotifications(c)
+}
+
+// GetApiV1MeTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1MeTasks(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1MeTasksParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", c.Request.URL.Query(), &params.Archived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter archived: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1MeTasks(c, params)
+}
+
 // PostApiV1Register operation middleware
 func (siw *ServerInterfaceWrapper) PostApiV1Register(c *gin.Context) {
 
@@ -402,13 +1724,1736 @@ func (siw *ServerInterfaceWrapper) GetApiV1ReportsInvalidAssignees(c *gin.Contex
 		}
 	}
 
-	siw.Handler.GetApiV1ReportsInvalidAssignees(c)
-}
-
-// GetApiV1ReportsTeamSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1ReportsTeamSummary(c *gin.Context) {
+	siw.Handler.GetApiV1ReportsInvalidAssignees(c)
+}
+
+// GetApiV1ReportsTeamSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ReportsTeamSummary(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1ReportsTeamSummary(c)
+}
+
+// GetApiV1ReportsTimesheet operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ReportsTimesheet(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1ReportsTimesheetParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "team_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_id", c.Request.URL.Query(), &params.TeamId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1ReportsTimesheet(c, params)
+}
+
+// GetApiV1ReportsTopCreators operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ReportsTopCreators(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1ReportsTopCreatorsParams
+
+	// ------------- Optional query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "month", c.Request.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1ReportsTopCreators(c, params)
+}
+
+// GetApiV1ReportsVelocity operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ReportsVelocity(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1ReportsVelocityParams
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", c.Request.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter group_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "team_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_id", c.Request.URL.Query(), &params.TeamId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1ReportsVelocity(c, params)
+}
+
+// GetApiV1SprintsSprintId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1SprintsSprintId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sprint_id" -------------
+	var sprintId SprintId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprint_id", c.Param("sprint_id"), &sprintId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sprint_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1SprintsSprintId(c, sprintId)
+}
+
+// PutApiV1SprintsSprintId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1SprintsSprintId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sprint_id" -------------
+	var sprintId SprintId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprint_id", c.Param("sprint_id"), &sprintId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sprint_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1SprintsSprintId(c, sprintId)
+}
+
+// PostApiV1SprintsSprintIdClose operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1SprintsSprintIdClose(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sprint_id" -------------
+	var sprintId SprintId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprint_id", c.Param("sprint_id"), &sprintId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sprint_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1SprintsSprintIdClose(c, sprintId)
+}
+
+// PostApiV1SprintsSprintIdStart operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1SprintsSprintIdStart(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sprint_id" -------------
+	var sprintId SprintId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprint_id", c.Param("sprint_id"), &sprintId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sprint_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1SprintsSprintIdStart(c, sprintId)
+}
+
+// PostApiV1SprintsSprintIdTasks operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1SprintsSprintIdTasks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sprint_id" -------------
+	var sprintId SprintId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprint_id", c.Param("sprint_id"), &sprintId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sprint_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1SprintsSprintIdTasks(c, sprintId)
+}
+
+// DeleteApiV1SprintsSprintIdTasksTaskId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1SprintsSprintIdTasksTaskId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sprint_id" -------------
+	var sprintId SprintId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprint_id", c.Param("sprint_id"), &sprintId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sprint_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "task_id" -------------
+	var taskId UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task_id", c.Param("task_id"), &taskId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter task_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1SprintsSprintIdTasksTaskId(c, sprintId, taskId)
+}
+
+// GetApiV1Tasks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Tasks(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1TasksParams
+
+	// ------------- Optional query parameter "team_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_id", c.Request.URL.Query(), &params.TeamId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "view_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view_id", c.Request.URL.Query(), &params.ViewId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter view_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "assignee_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assignee_id", c.Request.URL.Query(), &params.AssigneeId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assignee_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sprint_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sprint_id", c.Request.URL.Query(), &params.SprintId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sprint_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", c.Request.URL.Query(), &params.Archived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter archived: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Tasks(c, params)
+}
+
+// PostApiV1Tasks operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Tasks(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1Tasks(c)
+}
+
+// PutApiV1TasksId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1TasksId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1TasksId(c, id)
+}
+
+// GetApiV1TasksIdActivity operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TasksIdActivity(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1TasksIdActivityParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TasksIdActivity(c, id, params)
+}
+
+// GetApiV1TasksIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TasksIdAttachments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TasksIdAttachments(c, id)
+}
+
+// PostApiV1TasksIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TasksIdAttachments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiV1TasksIdAttachmentsParams
+
+	// ------------- Optional query parameter "comment_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "comment_id", c.Request.URL.Query(), &params.CommentId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter comment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TasksIdAttachments(c, id, params)
+}
+
+// DeleteApiV1TasksIdAttachmentsAttachmentId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1TasksIdAttachmentsAttachmentId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId AttachmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", c.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attachment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1TasksIdAttachmentsAttachmentId(c, id, attachmentId)
+}
+
+// GetApiV1TasksIdAttachmentsAttachmentId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TasksIdAttachmentsAttachmentId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId AttachmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", c.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attachment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TasksIdAttachmentsAttachmentId(c, id, attachmentId)
+}
+
+// PostApiV1TasksIdClone operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TasksIdClone(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TasksIdClone(c, id)
+}
+
+// GetApiV1TasksIdComments operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TasksIdComments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1TasksIdCommentsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TasksIdComments(c, id, params)
+}
+
+// PostApiV1TasksIdComments operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TasksIdComments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TasksIdComments(c, id)
+}
+
+// DeleteApiV1TasksIdCommentsCommentId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1TasksIdCommentsCommentId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId CommentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", c.Param("comment_id"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter comment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1TasksIdCommentsCommentId(c, id, commentId)
+}
+
+// PutApiV1TasksIdCommentsCommentId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1TasksIdCommentsCommentId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId CommentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", c.Param("comment_id"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter comment_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1TasksIdCommentsCommentId(c, id, commentId)
+}
+
+// GetApiV1TasksIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TasksIdHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1TasksIdHistoryParams
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", c.Request.URL.Query(), &params.Field)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter field: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "changed_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "changed_by", c.Request.URL.Query(), &params.ChangedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter changed_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TasksIdHistory(c, id, params)
+}
+
+// PostApiV1TasksIdHistoryHistoryIdRevert operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TasksIdHistoryHistoryIdRevert(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "history_id" -------------
+	var historyId HistoryId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "history_id", c.Param("history_id"), &historyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter history_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TasksIdHistoryHistoryIdRevert(c, id, historyId)
+}
+
+// GetApiV1TasksIdLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TasksIdLinks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TasksIdLinks(c, id)
+}
+
+// PostApiV1TasksIdMove operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TasksIdMove(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TasksIdMove(c, id)
+}
+
+// PostApiV1TasksIdTimerStart operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TasksIdTimerStart(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TasksIdTimerStart(c, id)
+}
+
+// PostApiV1TasksIdTimerStop operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TasksIdTimerStop(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TasksIdTimerStop(c, id)
+}
+
+// DeleteApiV1TasksIdWatch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1TasksIdWatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1TasksIdWatch(c, id)
+}
+
+// PostApiV1TasksIdWatch operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TasksIdWatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TasksIdWatch(c, id)
+}
+
+// GetApiV1TasksIdWatchers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TasksIdWatchers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TasksIdWatchers(c, id)
+}
+
+// GetApiV1TasksIdWorklogs operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TasksIdWorklogs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TasksIdWorklogs(c, id)
+}
+
+// PostApiV1TasksIdWorklogs operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TasksIdWorklogs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TasksIdWorklogs(c, id)
+}
+
+// DeleteApiV1TasksIdWorklogsWorklogId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1TasksIdWorklogsWorklogId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "worklog_id" -------------
+	var worklogId WorklogId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worklog_id", c.Param("worklog_id"), &worklogId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter worklog_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1TasksIdWorklogsWorklogId(c, id, worklogId)
+}
+
+// PutApiV1TasksIdWorklogsWorklogId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1TasksIdWorklogsWorklogId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "worklog_id" -------------
+	var worklogId WorklogId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worklog_id", c.Param("worklog_id"), &worklogId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter worklog_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1TasksIdWorklogsWorklogId(c, id, worklogId)
+}
+
+// GetApiV1Teams operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Teams(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1Teams(c)
+}
+
+// PostApiV1Teams operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Teams(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1Teams(c)
+}
+
+// PostApiV1TeamsInvitesAccept operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsInvitesAccept(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TeamsInvitesAccept(c)
+}
+
+// DeleteApiV1TeamsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1TeamsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1TeamsId(c, id)
+}
+
+// GetApiV1TeamsId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TeamsId(c, id)
+}
+
+// PatchApiV1TeamsId operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiV1TeamsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchApiV1TeamsId(c, id)
+}
+
+// GetApiV1TeamsIdActivity operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsIdActivity(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1TeamsIdActivityParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TeamsIdActivity(c, id, params)
+}
+
+// GetApiV1TeamsIdArchivePolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsIdArchivePolicy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TeamsIdArchivePolicy(c, id)
+}
+
+// PutApiV1TeamsIdArchivePolicy operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1TeamsIdArchivePolicy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1TeamsIdArchivePolicy(c, id)
+}
+
+// GetApiV1TeamsIdExport operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsIdExport(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1TeamsIdExportParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := c.Query("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument format is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "assignee_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assignee_id", c.Request.URL.Query(), &params.AssigneeId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assignee_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sprint_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sprint_id", c.Request.URL.Query(), &params.SprintId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sprint_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", c.Request.URL.Query(), &params.Archived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter archived: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", c.Request.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TeamsIdExport(c, id, params)
+}
+
+// PostApiV1TeamsIdInvalidAssigneesRepair operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdInvalidAssigneesRepair(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TeamsIdInvalidAssigneesRepair(c, id)
+}
+
+// PostApiV1TeamsIdInvite operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdInvite(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TeamsIdInvite(c, id)
+}
+
+// GetApiV1TeamsIdInvites operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsIdInvites(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1TeamsIdInvitesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiV1TeamsIdInvites(c, id, params)
+}
+
+// DeleteApiV1TeamsIdInvitesInviteId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1TeamsIdInvitesInviteId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "invite_id" -------------
+	var inviteId InviteId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invite_id", c.Param("invite_id"), &inviteId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter invite_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiV1TeamsIdInvitesInviteId(c, id, inviteId)
+}
+
+// PostApiV1TeamsIdInvitesInviteIdResend operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdInvitesInviteIdResend(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "invite_id" -------------
+	var inviteId InviteId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invite_id", c.Param("invite_id"), &inviteId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter invite_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiV1TeamsIdInvitesInviteIdResend(c, id, inviteId)
+}
+
+// PostApiV1TeamsIdLeave operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdLeave(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiV1TeamsIdLeaveParams
+
+	// ------------- Optional query parameter "open_tasks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "open_tasks", c.Request.URL.Query(), &params.OpenTasks)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter open_tasks: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "reassign_to" -------------
 
-	c.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "reassign_to", c.Request.URL.Query(), &params.ReassignTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reassign_to: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -417,24 +3462,49 @@ func (siw *ServerInterfaceWrapper) GetApiV1ReportsTeamSummary(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1ReportsTeamSummary(c)
+	siw.Handler.PostApiV1TeamsIdLeave(c, id, params)
 }
 
-// GetApiV1ReportsTopCreators operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1ReportsTopCreators(c *gin.Context) {
+// GetApiV1TeamsIdMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsIdMembers(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiV1ReportsTopCreatorsParams
+	var params GetApiV1TeamsIdMembersParams
 
-	// ------------- Optional query parameter "month" -------------
+	// ------------- Optional query parameter "email" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "month", c.Request.URL.Query(), &params.Month)
+	err = runtime.BindQueryParameter("form", true, false, "email", c.Request.URL.Query(), &params.Email)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter email: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -445,66 +3515,114 @@ func (siw *ServerInterfaceWrapper) GetApiV1ReportsTopCreators(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1ReportsTopCreators(c, params)
+	siw.Handler.GetApiV1TeamsIdMembers(c, id, params)
 }
 
-// GetApiV1Tasks operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1Tasks(c *gin.Context) {
+// DeleteApiV1TeamsIdMembersUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1TeamsIdMembersUserId(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiV1TasksParams
+	// ------------- Path parameter "id" -------------
+	var id TeamId
 
-	// ------------- Required query parameter "team_id" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	if paramValue := c.Query("team_id"); paramValue != "" {
+	// ------------- Path parameter "user_id" -------------
+	var userId MemberUserId
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument team_id is required, but not found"), http.StatusBadRequest)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_id", c.Request.URL.Query(), &params.TeamId)
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteApiV1TeamsIdMembersUserIdParams
+
+	// ------------- Optional query parameter "open_tasks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "open_tasks", c.Request.URL.Query(), &params.OpenTasks)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter open_tasks: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Optional query parameter "reassign_to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "reassign_to", c.Request.URL.Query(), &params.ReassignTo)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reassign_to: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "assignee_id" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "assignee_id", c.Request.URL.Query(), &params.AssigneeId)
+	siw.Handler.DeleteApiV1TeamsIdMembersUserId(c, id, userId, params)
+}
+
+// PatchApiV1TeamsIdMembersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiV1TeamsIdMembersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter assignee_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "user_id" -------------
+	var userId MemberUserId
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchApiV1TeamsIdMembersUserId(c, id, userId)
+}
+
+// PostApiV1TeamsIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdRestore(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -512,14 +3630,36 @@ func (siw *ServerInterfaceWrapper) GetApiV1Tasks(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1Tasks(c, params)
+	siw.Handler.PostApiV1TeamsIdRestore(c, id)
 }
 
-// PostApiV1Tasks operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1Tasks(c *gin.Context) {
+// GetApiV1TeamsIdSprints operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsIdSprints(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1TeamsIdSprintsParams
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -527,16 +3667,16 @@ func (siw *ServerInterfaceWrapper) PostApiV1Tasks(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiV1Tasks(c)
+	siw.Handler.GetApiV1TeamsIdSprints(c, id, params)
 }
 
-// PutApiV1TasksId operation middleware
-func (siw *ServerInterfaceWrapper) PutApiV1TasksId(c *gin.Context) {
+// PostApiV1TeamsIdSprints operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdSprints(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TaskId
+	var id TeamId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -553,16 +3693,16 @@ func (siw *ServerInterfaceWrapper) PutApiV1TasksId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutApiV1TasksId(c, id)
+	siw.Handler.PostApiV1TeamsIdSprints(c, id)
 }
 
-// GetApiV1TasksIdComments operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1TasksIdComments(c *gin.Context) {
+// PostApiV1TeamsIdTaskImports operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdTaskImports(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TaskId
+	var id TeamId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -573,21 +3713,36 @@ func (siw *ServerInterfaceWrapper) GetApiV1TasksIdComments(c *gin.Context) {
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiV1TasksIdCommentsParams
+	var params PostApiV1TeamsIdTaskImportsParams
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Required query parameter "format" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if paramValue := c.Query("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument format is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", c.Request.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "per_page" -------------
+	// ------------- Optional query parameter "dry_run" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", c.Request.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dry_run: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "mapping" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mapping", c.Request.URL.Query(), &params.Mapping)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mapping: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -598,16 +3753,16 @@ func (siw *ServerInterfaceWrapper) GetApiV1TasksIdComments(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1TasksIdComments(c, id, params)
+	siw.Handler.PostApiV1TeamsIdTaskImports(c, id, params)
 }
 
-// PostApiV1TasksIdComments operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1TasksIdComments(c *gin.Context) {
+// GetApiV1TeamsIdTaskImportsImportId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsIdTaskImportsImportId(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TaskId
+	var id TeamId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -615,6 +3770,15 @@ func (siw *ServerInterfaceWrapper) PostApiV1TasksIdComments(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "import_id" -------------
+	var importId ImportId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "import_id", c.Param("import_id"), &importId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter import_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -624,16 +3788,16 @@ func (siw *ServerInterfaceWrapper) PostApiV1TasksIdComments(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiV1TasksIdComments(c, id)
+	siw.Handler.GetApiV1TeamsIdTaskImportsImportId(c, id, importId)
 }
 
-// DeleteApiV1TasksIdCommentsCommentId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiV1TasksIdCommentsCommentId(c *gin.Context) {
+// GetApiV1TeamsIdTaskPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsIdTaskPolicy(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TaskId
+	var id TeamId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -641,15 +3805,6 @@ func (siw *ServerInterfaceWrapper) DeleteApiV1TasksIdCommentsCommentId(c *gin.Co
 		return
 	}
 
-	// ------------- Path parameter "comment_id" -------------
-	var commentId CommentId
-
-	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", c.Param("comment_id"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter comment_id: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -659,16 +3814,16 @@ func (siw *ServerInterfaceWrapper) DeleteApiV1TasksIdCommentsCommentId(c *gin.Co
 		}
 	}
 
-	siw.Handler.DeleteApiV1TasksIdCommentsCommentId(c, id, commentId)
+	siw.Handler.GetApiV1TeamsIdTaskPolicy(c, id)
 }
 
-// PutApiV1TasksIdCommentsCommentId operation middleware
-func (siw *ServerInterfaceWrapper) PutApiV1TasksIdCommentsCommentId(c *gin.Context) {
+// PutApiV1TeamsIdTaskPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1TeamsIdTaskPolicy(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TaskId
+	var id TeamId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -676,12 +3831,29 @@ func (siw *ServerInterfaceWrapper) PutApiV1TasksIdCommentsCommentId(c *gin.Conte
 		return
 	}
 
-	// ------------- Path parameter "comment_id" -------------
-	var commentId CommentId
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", c.Param("comment_id"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiV1TeamsIdTaskPolicy(c, id)
+}
+
+// PostApiV1TeamsIdTransfer operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdTransfer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter comment_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -694,16 +3866,16 @@ func (siw *ServerInterfaceWrapper) PutApiV1TasksIdCommentsCommentId(c *gin.Conte
 		}
 	}
 
-	siw.Handler.PutApiV1TasksIdCommentsCommentId(c, id, commentId)
+	siw.Handler.PostApiV1TeamsIdTransfer(c, id)
 }
 
-// GetApiV1TasksIdHistory operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1TasksIdHistory(c *gin.Context) {
+// GetApiV1TeamsIdViews operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1TeamsIdViews(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id TaskId
+	var id TeamId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -720,11 +3892,22 @@ func (siw *ServerInterfaceWrapper) GetApiV1TasksIdHistory(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1TasksIdHistory(c, id)
+	siw.Handler.GetApiV1TeamsIdViews(c, id)
 }
 
-// GetApiV1Teams operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1Teams(c *gin.Context) {
+// PostApiV1TeamsIdViews operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdViews(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TeamId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -735,11 +3918,22 @@ func (siw *ServerInterfaceWrapper) GetApiV1Teams(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetApiV1Teams(c)
+	siw.Handler.PostApiV1TeamsIdViews(c, id)
 }
 
-// PostApiV1Teams operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1Teams(c *gin.Context) {
+// DeleteApiV1ViewsViewId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1ViewsViewId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "view_id" -------------
+	var viewId ViewId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "view_id", c.Param("view_id"), &viewId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter view_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -750,11 +3944,22 @@ func (siw *ServerInterfaceWrapper) PostApiV1Teams(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiV1Teams(c)
+	siw.Handler.DeleteApiV1ViewsViewId(c, viewId)
 }
 
-// PostApiV1TeamsInvitesAccept operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1TeamsInvitesAccept(c *gin.Context) {
+// GetApiV1ViewsViewId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ViewsViewId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "view_id" -------------
+	var viewId ViewId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "view_id", c.Param("view_id"), &viewId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter view_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -765,20 +3970,20 @@ func (siw *ServerInterfaceWrapper) PostApiV1TeamsInvitesAccept(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiV1TeamsInvitesAccept(c)
+	siw.Handler.GetApiV1ViewsViewId(c, viewId)
 }
 
-// PostApiV1TeamsIdInvite operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdInvite(c *gin.Context) {
+// PutApiV1ViewsViewId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1ViewsViewId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id TeamId
+	// ------------- Path parameter "view_id" -------------
+	var viewId ViewId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "view_id", c.Param("view_id"), &viewId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter view_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -791,7 +3996,7 @@ func (siw *ServerInterfaceWrapper) PostApiV1TeamsIdInvite(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostApiV1TeamsIdInvite(c, id)
+	siw.Handler.PutApiV1ViewsViewId(c, viewId)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -821,21 +4026,82 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/api/v1/calendar/:token/tasks.ics", wrapper.GetApiV1CalendarTokenTasksIcs)
 	router.POST(options.BaseURL+"/api/v1/login", wrapper.PostApiV1Login)
+	router.DELETE(options.BaseURL+"/api/v1/me/calendar-feed", wrapper.DeleteApiV1MeCalendarFeed)
+	router.GET(options.BaseURL+"/api/v1/me/calendar-feed", wrapper.GetApiV1MeCalendarFeed)
+	router.POST(options.BaseURL+"/api/v1/me/calendar-feed", wrapper.PostApiV1MeCalendarFeed)
+	router.GET(options.BaseURL+"/api/v1/me/notifications", wrapper.GetApiV1MeNotifications)
+	router.PUT(options.BaseURL+"/api/v1/me/notifications", wrapper.PutApiV1MeNotifications)
+	router.GET(options.BaseURL+"/api/v1/me/tasks", wrapper.GetApiV1MeTasks)
 	router.POST(options.BaseURL+"/api/v1/register", wrapper.PostApiV1Register)
 	router.GET(options.BaseURL+"/api/v1/reports/invalid-assignees", wrapper.GetApiV1ReportsInvalidAssignees)
 	router.GET(options.BaseURL+"/api/v1/reports/team-summary", wrapper.GetApiV1ReportsTeamSummary)
+	router.GET(options.BaseURL+"/api/v1/reports/timesheet", wrapper.GetApiV1ReportsTimesheet)
 	router.GET(options.BaseURL+"/api/v1/reports/top-creators", wrapper.GetApiV1ReportsTopCreators)
+	router.GET(options.BaseURL+"/api/v1/reports/velocity", wrapper.GetApiV1ReportsVelocity)
+	router.GET(options.BaseURL+"/api/v1/sprints/:sprint_id", wrapper.GetApiV1SprintsSprintId)
+	router.PUT(options.BaseURL+"/api/v1/sprints/:sprint_id", wrapper.PutApiV1SprintsSprintId)
+	router.POST(options.BaseURL+"/api/v1/sprints/:sprint_id/close", wrapper.PostApiV1SprintsSprintIdClose)
+	router.POST(options.BaseURL+"/api/v1/sprints/:sprint_id/start", wrapper.PostApiV1SprintsSprintIdStart)
+	router.POST(options.BaseURL+"/api/v1/sprints/:sprint_id/tasks", wrapper.PostApiV1SprintsSprintIdTasks)
+	router.DELETE(options.BaseURL+"/api/v1/sprints/:sprint_id/tasks/:task_id", wrapper.DeleteApiV1SprintsSprintIdTasksTaskId)
 	router.GET(options.BaseURL+"/api/v1/tasks", wrapper.GetApiV1Tasks)
 	router.POST(options.BaseURL+"/api/v1/tasks", wrapper.PostApiV1Tasks)
 	router.PUT(options.BaseURL+"/api/v1/tasks/:id", wrapper.PutApiV1TasksId)
+	router.GET(options.BaseURL+"/api/v1/tasks/:id/activity", wrapper.GetApiV1TasksIdActivity)
+	router.GET(options.BaseURL+"/api/v1/tasks/:id/attachments", wrapper.GetApiV1TasksIdAttachments)
+	router.POST(options.BaseURL+"/api/v1/tasks/:id/attachments", wrapper.PostApiV1TasksIdAttachments)
+	router.DELETE(options.BaseURL+"/api/v1/tasks/:id/attachments/:attachment_id", wrapper.DeleteApiV1TasksIdAttachmentsAttachmentId)
+	router.GET(options.BaseURL+"/api/v1/tasks/:id/attachments/:attachment_id", wrapper.GetApiV1TasksIdAttachmentsAttachmentId)
+	router.POST(options.BaseURL+"/api/v1/tasks/:id/clone", wrapper.PostApiV1TasksIdClone)
 	router.GET(options.BaseURL+"/api/v1/tasks/:id/comments", wrapper.GetApiV1TasksIdComments)
 	router.POST(options.BaseURL+"/api/v1/tasks/:id/comments", wrapper.PostApiV1TasksIdComments)
 	router.DELETE(options.BaseURL+"/api/v1/tasks/:id/comments/:comment_id", wrapper.DeleteApiV1TasksIdCommentsCommentId)
 	router.PUT(options.BaseURL+"/api/v1/tasks/:id/comments/:comment_id", wrapper.PutApiV1TasksIdCommentsCommentId)
 	router.GET(options.BaseURL+"/api/v1/tasks/:id/history", wrapper.GetApiV1TasksIdHistory)
+	router.POST(options.BaseURL+"/api/v1/tasks/:id/history/:history_id/revert", wrapper.PostApiV1TasksIdHistoryHistoryIdRevert)
+	router.GET(options.BaseURL+"/api/v1/tasks/:id/links", wrapper.GetApiV1TasksIdLinks)
+	router.POST(options.BaseURL+"/api/v1/tasks/:id/move", wrapper.PostApiV1TasksIdMove)
+	router.POST(options.BaseURL+"/api/v1/tasks/:id/timer/start", wrapper.PostApiV1TasksIdTimerStart)
+	router.POST(options.BaseURL+"/api/v1/tasks/:id/timer/stop", wrapper.PostApiV1TasksIdTimerStop)
+	router.DELETE(options.BaseURL+"/api/v1/tasks/:id/watch", wrapper.DeleteApiV1TasksIdWatch)
+	router.POST(options.BaseURL+"/api/v1/tasks/:id/watch", wrapper.PostApiV1TasksIdWatch)
+	router.GET(options.BaseURL+"/api/v1/tasks/:id/watchers", wrapper.GetApiV1TasksIdWatchers)
+	router.GET(options.BaseURL+"/api/v1/tasks/:id/worklogs", wrapper.GetApiV1TasksIdWorklogs)
+	router.POST(options.BaseURL+"/api/v1/tasks/:id/worklogs", wrapper.PostApiV1TasksIdWorklogs)
+	router.DELETE(options.BaseURL+"/api/v1/tasks/:id/worklogs/:worklog_id", wrapper.DeleteApiV1TasksIdWorklogsWorklogId)
+	router.PUT(options.BaseURL+"/api/v1/tasks/:id/worklogs/:worklog_id", wrapper.PutApiV1TasksIdWorklogsWorklogId)
 	router.GET(options.BaseURL+"/api/v1/teams", wrapper.GetApiV1Teams)
 	router.POST(options.BaseURL+"/api/v1/teams", wrapper.PostApiV1Teams)
 	router.POST(options.BaseURL+"/api/v1/teams/invites/accept", wrapper.PostApiV1TeamsInvitesAccept)
+	router.DELETE(options.BaseURL+"/api/v1/teams/:id", wrapper.DeleteApiV1TeamsId)
+	router.GET(options.BaseURL+"/api/v1/teams/:id", wrapper.GetApiV1TeamsId)
+	router.PATCH(options.BaseURL+"/api/v1/teams/:id", wrapper.PatchApiV1TeamsId)
+	router.GET(options.BaseURL+"/api/v1/teams/:id/activity", wrapper.GetApiV1TeamsIdActivity)
+	router.GET(options.BaseURL+"/api/v1/teams/:id/archive-policy", wrapper.GetApiV1TeamsIdArchivePolicy)
+	router.PUT(options.BaseURL+"/api/v1/teams/:id/archive-policy", wrapper.PutApiV1TeamsIdArchivePolicy)
+	router.GET(options.BaseURL+"/api/v1/teams/:id/export", wrapper.GetApiV1TeamsIdExport)
+	router.POST(options.BaseURL+"/api/v1/teams/:id/invalid-assignees/repair", wrapper.PostApiV1TeamsIdInvalidAssigneesRepair)
 	router.POST(options.BaseURL+"/api/v1/teams/:id/invite", wrapper.PostApiV1TeamsIdInvite)
+	router.GET(options.BaseURL+"/api/v1/teams/:id/invites", wrapper.GetApiV1TeamsIdInvites)
+	router.DELETE(options.BaseURL+"/api/v1/teams/:id/invites/:invite_id", wrapper.DeleteApiV1TeamsIdInvitesInviteId)
+	router.POST(options.BaseURL+"/api/v1/teams/:id/invites/:invite_id/resend", wrapper.PostApiV1TeamsIdInvitesInviteIdResend)
+	router.POST(options.BaseURL+"/api/v1/teams/:id/leave", wrapper.PostApiV1TeamsIdLeave)
+	router.GET(options.BaseURL+"/api/v1/teams/:id/members", wrapper.GetApiV1TeamsIdMembers)
+	router.DELETE(options.BaseURL+"/api/v1/teams/:id/members/:user_id", wrapper.DeleteApiV1TeamsIdMembersUserId)
+	router.PATCH(options.BaseURL+"/api/v1/teams/:id/members/:user_id", wrapper.PatchApiV1TeamsIdMembersUserId)
+	router.POST(options.BaseURL+"/api/v1/teams/:id/restore", wrapper.PostApiV1TeamsIdRestore)
+	router.GET(options.BaseURL+"/api/v1/teams/:id/sprints", wrapper.GetApiV1TeamsIdSprints)
+	router.POST(options.BaseURL+"/api/v1/teams/:id/sprints", wrapper.PostApiV1TeamsIdSprints)
+	router.POST(options.BaseURL+"/api/v1/teams/:id/task-imports", wrapper.PostApiV1TeamsIdTaskImports)
+	router.GET(options.BaseURL+"/api/v1/teams/:id/task-imports/:import_id", wrapper.GetApiV1TeamsIdTaskImportsImportId)
+	router.GET(options.BaseURL+"/api/v1/teams/:id/task-policy", wrapper.GetApiV1TeamsIdTaskPolicy)
+	router.PUT(options.BaseURL+"/api/v1/teams/:id/task-policy", wrapper.PutApiV1TeamsIdTaskPolicy)
+	router.POST(options.BaseURL+"/api/v1/teams/:id/transfer", wrapper.PostApiV1TeamsIdTransfer)
+	router.GET(options.BaseURL+"/api/v1/teams/:id/views", wrapper.GetApiV1TeamsIdViews)
+	router.POST(options.BaseURL+"/api/v1/teams/:id/views", wrapper.PostApiV1TeamsIdViews)
+	router.DELETE(options.BaseURL+"/api/v1/views/:view_id", wrapper.DeleteApiV1ViewsViewId)
+	router.GET(options.BaseURL+"/api/v1/views/:view_id", wrapper.GetApiV1ViewsViewId)
+	router.PUT(options.BaseURL+"/api/v1/views/:view_id", wrapper.PutApiV1ViewsViewId)
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pressly/goose/v3"
@@ -13,18 +14,30 @@ import (
 	appmw "github.com/Seraf-seraf/mkk_test/internal/app/middlewares"
 	"github.com/Seraf-seraf/mkk_test/internal/config"
 	"github.com/Seraf-seraf/mkk_test/internal/handler"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/blobstore"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/breaker"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/cache"
 	httpserver "github.com/Seraf-seraf/mkk_test/internal/pkg/http"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/mailer"
 	mysqlpkg "github.com/Seraf-seraf/mkk_test/internal/pkg/mysql"
 	redispkg "github.com/Seraf-seraf/mkk_test/internal/pkg/redis"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/worker"
 	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/service/activity"
+	"github.com/Seraf-seraf/mkk_test/internal/service/attachments"
 	"github.com/Seraf-seraf/mkk_test/internal/service/auth"
+	"github.com/Seraf-seraf/mkk_test/internal/service/calendar"
 	"github.com/Seraf-seraf/mkk_test/internal/service/comments"
+	"github.com/Seraf-seraf/mkk_test/internal/service/export"
+	"github.com/Seraf-seraf/mkk_test/internal/service/imports"
+	"github.com/Seraf-seraf/mkk_test/internal/service/reminders"
 	"github.com/Seraf-seraf/mkk_test/internal/service/reports"
+	"github.com/Seraf-seraf/mkk_test/internal/service/sprints"
 	"github.com/Seraf-seraf/mkk_test/internal/service/tasks"
 	"github.com/Seraf-seraf/mkk_test/internal/service/teams"
+	"github.com/Seraf-seraf/mkk_test/internal/service/views"
+	"github.com/Seraf-seraf/mkk_test/internal/service/watchers"
+	"github.com/Seraf-seraf/mkk_test/internal/service/worklogs"
 )
 
 const (
@@ -32,6 +45,13 @@ const (
 	dataMigrationsDir     = "internal/migrations/data"
 	schemaMigrationsTable = "goose_db_version"
 	dataMigrationsTable   = "goose_db_version_data"
+
+	defaultAttachmentsSweepInterval = 5 * time.Minute
+	taskImportsPollInterval         = 2 * time.Second
+	defaultRemindersInterval        = 5 * time.Minute
+	defaultArchiveInterval          = time.Hour
+	defaultTeamPurgeInterval        = time.Hour
+	defaultInviteCleanupInterval    = time.Hour
 )
 
 // ShutdownFunc вызывается при graceful shutdown.
@@ -82,10 +102,23 @@ func New(cfg *config.Config) (*http.Server, ShutdownFunc, error) {
 	teamsRepo := repomysql.NewTeamsRepo(db)
 	membersRepo := repomysql.NewTeamMembersRepo(db)
 	invitesRepo := repomysql.NewTeamInvitesRepo(db)
+	memberEventsRepo := repomysql.NewTeamMemberEventsRepo(db)
 	tasksRepo := repomysql.NewTasksRepo(db)
+	assigneesRepo := repomysql.NewTaskAssigneesRepo(db)
 	historyRepo := repomysql.NewTaskHistoryRepo(db)
+	watchersRepo := repomysql.NewTaskWatchersRepo(db)
 	commentsRepo := repomysql.NewCommentsRepo(db)
+	attachmentsRepo := repomysql.NewTaskAttachmentsRepo(db)
+	worklogsRepo := repomysql.NewTaskWorklogsRepo(db)
 	reportsRepo := repomysql.NewReportsRepo(db)
+	activityRepo := repomysql.NewActivityRepo(db)
+	importsRepo := repomysql.NewTaskImportsRepo(db)
+	viewsRepo := repomysql.NewTaskViewsRepo(db)
+	sprintsRepo := repomysql.NewSprintsRepo(db)
+	remindersRepo := repomysql.NewTaskRemindersRepo(db)
+	notificationSettingsRepo := repomysql.NewNotificationSettingsRepo(db)
+	calendarFeedsRepo := repomysql.NewCalendarFeedsRepo(db)
+	linksRepo := repomysql.NewTaskLinksRepo(db)
 
 	cb, err := breaker.New("mailer")
 	if err != nil {
@@ -103,35 +136,198 @@ func New(cfg *config.Config) (*http.Server, ShutdownFunc, error) {
 		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	teamsSvc, err := teams.NewService(db, teamsRepo, membersRepo, invitesRepo, usersRepo, mailerSvc, cb)
+	tasksSvc, err := tasks.NewService(db, tasksRepo, assigneesRepo, membersRepo, teamsRepo, historyRepo, watchersRepo, viewsRepo, linksRepo, attachmentsRepo, tasksCache)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	teamsSvc, err := teams.NewService(db, teamsRepo, membersRepo, invitesRepo, usersRepo, memberEventsRepo, tasksSvc, mailerSvc, cb, cfg.Teams)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	watchersSvc, err := watchers.NewService(watchersRepo, tasksRepo, membersRepo)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	commentsSvc, err := comments.NewService(db, commentsRepo, tasksRepo, membersRepo, watchersRepo)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	store, err := blobstore.New(cfg.Storage)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	attachmentsSvc, err := attachments.NewService(attachmentsRepo, tasksRepo, membersRepo, commentsRepo, store, cfg.Attachments)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	sweepInterval := time.Duration(cfg.Attachments.SweepIntervalSeconds) * time.Second
+	if sweepInterval <= 0 {
+		sweepInterval = defaultAttachmentsSweepInterval
+	}
+	attachmentsSweeper, err := worker.New("attachments-sweeper", sweepInterval, func(ctx context.Context) error {
+		_, err := attachmentsSvc.PurgeOrphans(ctx)
+		return err
+	})
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	worklogsSvc, err := worklogs.NewService(worklogsRepo, tasksRepo, membersRepo)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	reportsSvc, err := reports.NewService(reportsRepo, membersRepo)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	activitySvc, err := activity.NewService(activityRepo, tasksRepo, membersRepo)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	importsSvc, err := imports.NewService(importsRepo, tasksSvc, membersRepo, usersRepo, cfg.Imports)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	importsWorker, err := worker.New("task-imports", taskImportsPollInterval, importsSvc.ProcessPending)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	exportSvc, err := export.NewService(tasksRepo, assigneesRepo, commentsRepo, historyRepo, membersRepo)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	viewsSvc, err := views.NewService(viewsRepo, membersRepo)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	sprintsSvc, err := sprints.NewService(db, sprintsRepo, tasksRepo, historyRepo, membersRepo, tasksCache)
 	if err != nil {
 		_ = redisClient.Close()
 		_ = db.Close()
 		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	tasksSvc, err := tasks.NewService(db, tasksRepo, membersRepo, historyRepo, tasksCache)
+	remindersSvc, err := reminders.NewService(remindersRepo, notificationSettingsRepo, mailerSvc, cb, cfg.Reminders)
 	if err != nil {
 		_ = redisClient.Close()
 		_ = db.Close()
 		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	commentsSvc, err := comments.NewService(commentsRepo, tasksRepo, membersRepo)
+	calendarSvc, err := calendar.NewService(calendarFeedsRepo, cfg.Calendar)
 	if err != nil {
 		_ = redisClient.Close()
 		_ = db.Close()
 		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	reportsSvc, err := reports.NewService(reportsRepo)
+	remindersInterval := time.Duration(cfg.Reminders.IntervalSeconds) * time.Second
+	if remindersInterval <= 0 {
+		remindersInterval = defaultRemindersInterval
+	}
+	remindersWorker, err := worker.New("task-reminders", remindersInterval, remindersSvc.ProcessDue)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	archiver, err := tasks.NewArchiver(teamsRepo, tasksRepo, tasksCache, cfg.Archive.BatchSize)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	archiveInterval := time.Duration(cfg.Archive.IntervalSeconds) * time.Second
+	if archiveInterval <= 0 {
+		archiveInterval = defaultArchiveInterval
+	}
+	archiveWorker, err := worker.New("task-archive", archiveInterval, archiver.Run)
 	if err != nil {
 		_ = redisClient.Close()
 		_ = db.Close()
 		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	handlerSvc, err := handler.New(authSvc, teamsSvc, tasksSvc, commentsSvc, reportsSvc)
+	purger, err := teams.NewPurger(teamsRepo, tasksCache, cfg.Teams)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	purgeInterval := time.Duration(cfg.Teams.PurgeIntervalSeconds) * time.Second
+	if purgeInterval <= 0 {
+		purgeInterval = defaultTeamPurgeInterval
+	}
+	purgeWorker, err := worker.New("team-purge", purgeInterval, purger.Run)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	inviteCleaner, err := teams.NewInviteCleaner(invitesRepo)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	inviteCleanupInterval := time.Duration(cfg.Teams.InviteCleanupIntervalSeconds) * time.Second
+	if inviteCleanupInterval <= 0 {
+		inviteCleanupInterval = defaultInviteCleanupInterval
+	}
+	inviteCleanupWorker, err := worker.New("invite-cleanup", inviteCleanupInterval, inviteCleaner.Run)
+	if err != nil {
+		_ = redisClient.Close()
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	handlerSvc, err := handler.New(authSvc, teamsSvc, tasksSvc, watchersSvc, commentsSvc, attachmentsSvc, worklogsSvc, reportsSvc, activitySvc, importsSvc, exportSvc, viewsSvc, sprintsSvc, remindersSvc, calendarSvc)
 	if err != nil {
 		_ = redisClient.Close()
 		_ = db.Close()
@@ -153,26 +349,87 @@ func New(cfg *config.Config) (*http.Server, ShutdownFunc, error) {
 		RegisterPublic: func(group *gin.RouterGroup) error {
 			group.POST("/login", wrapper.PostApiV1Login)
 			group.POST("/register", wrapper.PostApiV1Register)
+			group.GET("/calendar/:token/tasks.ics", wrapper.GetApiV1CalendarTokenTasksIcs)
 			return nil
 		},
 		RegisterAPI: func(group *gin.RouterGroup) error {
 			group.GET("/teams", wrapper.GetApiV1Teams)
 			group.POST("/teams", wrapper.PostApiV1Teams)
 			group.POST("/teams/invites/accept", wrapper.PostApiV1TeamsInvitesAccept)
+			group.GET("/teams/:id", wrapper.GetApiV1TeamsId)
+			group.PATCH("/teams/:id", appmw.RBAC("owner", "admin"), wrapper.PatchApiV1TeamsId)
+			group.DELETE("/teams/:id", appmw.RBAC("owner"), wrapper.DeleteApiV1TeamsId)
+			group.POST("/teams/:id/restore", appmw.RBAC("owner"), wrapper.PostApiV1TeamsIdRestore)
+			group.POST("/teams/:id/transfer", appmw.RBAC("owner"), wrapper.PostApiV1TeamsIdTransfer)
+			group.GET("/teams/:id/members", wrapper.GetApiV1TeamsIdMembers)
+			group.PATCH("/teams/:id/members/:user_id", appmw.RBAC("owner", "admin"), wrapper.PatchApiV1TeamsIdMembersUserId)
+			group.DELETE("/teams/:id/members/:user_id", appmw.RBAC("owner", "admin"), wrapper.DeleteApiV1TeamsIdMembersUserId)
+			group.POST("/teams/:id/leave", wrapper.PostApiV1TeamsIdLeave)
 			group.POST("/teams/:id/invite", appmw.RBAC("owner", "admin"), wrapper.PostApiV1TeamsIdInvite)
+			group.GET("/teams/:id/invites", appmw.RBAC("owner", "admin"), wrapper.GetApiV1TeamsIdInvites)
+			group.DELETE("/teams/:id/invites/:invite_id", appmw.RBAC("owner", "admin"), wrapper.DeleteApiV1TeamsIdInvitesInviteId)
+			group.POST("/teams/:id/invites/:invite_id/resend", appmw.RBAC("owner", "admin"), wrapper.PostApiV1TeamsIdInvitesInviteIdResend)
+			group.GET("/teams/:id/task-policy", wrapper.GetApiV1TeamsIdTaskPolicy)
+			group.PUT("/teams/:id/task-policy", appmw.RBAC("owner", "admin"), wrapper.PutApiV1TeamsIdTaskPolicy)
+			group.GET("/teams/:id/archive-policy", wrapper.GetApiV1TeamsIdArchivePolicy)
+			group.PUT("/teams/:id/archive-policy", appmw.RBAC("owner", "admin"), wrapper.PutApiV1TeamsIdArchivePolicy)
+			group.GET("/teams/:id/activity", wrapper.GetApiV1TeamsIdActivity)
+			group.POST("/teams/:id/task-imports", wrapper.PostApiV1TeamsIdTaskImports)
+			group.GET("/teams/:id/task-imports/:import_id", wrapper.GetApiV1TeamsIdTaskImportsImportId)
+			group.GET("/teams/:id/export", wrapper.GetApiV1TeamsIdExport)
+			group.GET("/teams/:id/views", wrapper.GetApiV1TeamsIdViews)
+			group.POST("/teams/:id/views", wrapper.PostApiV1TeamsIdViews)
+			group.GET("/views/:view_id", wrapper.GetApiV1ViewsViewId)
+			group.PUT("/views/:view_id", wrapper.PutApiV1ViewsViewId)
+			group.DELETE("/views/:view_id", wrapper.DeleteApiV1ViewsViewId)
+			group.GET("/teams/:id/sprints", wrapper.GetApiV1TeamsIdSprints)
+			group.POST("/teams/:id/sprints", wrapper.PostApiV1TeamsIdSprints)
+			group.GET("/sprints/:sprint_id", wrapper.GetApiV1SprintsSprintId)
+			group.PUT("/sprints/:sprint_id", wrapper.PutApiV1SprintsSprintId)
+			group.POST("/sprints/:sprint_id/start", wrapper.PostApiV1SprintsSprintIdStart)
+			group.POST("/sprints/:sprint_id/close", wrapper.PostApiV1SprintsSprintIdClose)
+			group.POST("/sprints/:sprint_id/tasks", wrapper.PostApiV1SprintsSprintIdTasks)
+			group.DELETE("/sprints/:sprint_id/tasks/:task_id", wrapper.DeleteApiV1SprintsSprintIdTasksTaskId)
+			group.GET("/me/tasks", wrapper.GetApiV1MeTasks)
+			group.GET("/me/notifications", wrapper.GetApiV1MeNotifications)
+			group.PUT("/me/notifications", wrapper.PutApiV1MeNotifications)
+			group.GET("/me/calendar-feed", wrapper.GetApiV1MeCalendarFeed)
+			group.POST("/me/calendar-feed", wrapper.PostApiV1MeCalendarFeed)
+			group.DELETE("/me/calendar-feed", wrapper.DeleteApiV1MeCalendarFeed)
 
 			group.GET("/tasks", wrapper.GetApiV1Tasks)
 			group.POST("/tasks", appmw.RBAC("member", "admin", "owner"), wrapper.PostApiV1Tasks)
 			group.PUT("/tasks/:id", wrapper.PutApiV1TasksId)
+			group.POST("/tasks/:id/move", wrapper.PostApiV1TasksIdMove)
+			group.POST("/tasks/:id/clone", wrapper.PostApiV1TasksIdClone)
+			group.GET("/tasks/:id/links", wrapper.GetApiV1TasksIdLinks)
 			group.GET("/tasks/:id/history", wrapper.GetApiV1TasksIdHistory)
+			group.POST("/tasks/:id/history/:history_id/revert", wrapper.PostApiV1TasksIdHistoryHistoryIdRevert)
+			group.GET("/tasks/:id/activity", wrapper.GetApiV1TasksIdActivity)
+			group.GET("/tasks/:id/watchers", wrapper.GetApiV1TasksIdWatchers)
+			group.POST("/tasks/:id/watch", wrapper.PostApiV1TasksIdWatch)
+			group.DELETE("/tasks/:id/watch", wrapper.DeleteApiV1TasksIdWatch)
 			group.GET("/tasks/:id/comments", wrapper.GetApiV1TasksIdComments)
 			group.POST("/tasks/:id/comments", wrapper.PostApiV1TasksIdComments)
 			group.PUT("/tasks/:id/comments/:comment_id", wrapper.PutApiV1TasksIdCommentsCommentId)
 			group.DELETE("/tasks/:id/comments/:comment_id", wrapper.DeleteApiV1TasksIdCommentsCommentId)
+			group.GET("/tasks/:id/attachments", wrapper.GetApiV1TasksIdAttachments)
+			group.POST("/tasks/:id/attachments", wrapper.PostApiV1TasksIdAttachments)
+			group.GET("/tasks/:id/attachments/:attachment_id", wrapper.GetApiV1TasksIdAttachmentsAttachmentId)
+			group.DELETE("/tasks/:id/attachments/:attachment_id", wrapper.DeleteApiV1TasksIdAttachmentsAttachmentId)
+			group.GET("/tasks/:id/worklogs", wrapper.GetApiV1TasksIdWorklogs)
+			group.POST("/tasks/:id/worklogs", wrapper.PostApiV1TasksIdWorklogs)
+			group.PUT("/tasks/:id/worklogs/:worklog_id", wrapper.PutApiV1TasksIdWorklogsWorklogId)
+			group.DELETE("/tasks/:id/worklogs/:worklog_id", wrapper.DeleteApiV1TasksIdWorklogsWorklogId)
+			group.POST("/tasks/:id/timer/start", wrapper.PostApiV1TasksIdTimerStart)
+			group.POST("/tasks/:id/timer/stop", wrapper.PostApiV1TasksIdTimerStop)
 
 			group.GET("/reports/team-summary", wrapper.GetApiV1ReportsTeamSummary)
 			group.GET("/reports/top-creators", wrapper.GetApiV1ReportsTopCreators)
 			group.GET("/reports/invalid-assignees", wrapper.GetApiV1ReportsInvalidAssignees)
+			group.POST("/teams/:id/invalid-assignees/repair", appmw.RBAC("owner", "admin"), wrapper.PostApiV1TeamsIdInvalidAssigneesRepair)
+			group.GET("/reports/timesheet", wrapper.GetApiV1ReportsTimesheet)
+			group.GET("/reports/velocity", wrapper.GetApiV1ReportsVelocity)
 
 			return nil
 		},
@@ -183,11 +440,36 @@ func New(cfg *config.Config) (*http.Server, ShutdownFunc, error) {
 		return nil, nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
+	attachmentsSweeper.Start()
+	importsWorker.Start()
+	remindersWorker.Start()
+	archiveWorker.Start()
+	purgeWorker.Start()
+	inviteCleanupWorker.Start()
+
 	shutdown := func(ctx context.Context) error {
 		var shutdownErr error
 		if err := server.Shutdown(ctx); err != nil {
 			shutdownErr = err
 		}
+		if err := attachmentsSweeper.Stop(ctx); err != nil && shutdownErr == nil {
+			shutdownErr = fmt.Errorf("ошибка остановки очистки вложений: %w", err)
+		}
+		if err := importsWorker.Stop(ctx); err != nil && shutdownErr == nil {
+			shutdownErr = fmt.Errorf("ошибка остановки импорта задач: %w", err)
+		}
+		if err := remindersWorker.Stop(ctx); err != nil && shutdownErr == nil {
+			shutdownErr = fmt.Errorf("ошибка остановки напоминаний: %w", err)
+		}
+		if err := archiveWorker.Stop(ctx); err != nil && shutdownErr == nil {
+			shutdownErr = fmt.Errorf("ошибка остановки архивации задач: %w", err)
+		}
+		if err := purgeWorker.Stop(ctx); err != nil && shutdownErr == nil {
+			shutdownErr = fmt.Errorf("ошибка остановки удаления команд: %w", err)
+		}
+		if err := inviteCleanupWorker.Stop(ctx); err != nil && shutdownErr == nil {
+			shutdownErr = fmt.Errorf("ошибка остановки очистки приглашений: %w", err)
+		}
 		if err := redisClient.Close(); err != nil && shutdownErr == nil {
 			shutdownErr = fmt.Errorf("ошибка закрытия Redis: %w", err)
 		}
@@ -3,6 +3,7 @@ package middlewares
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
@@ -10,6 +11,10 @@ import (
 	ginmiddleware "github.com/oapi-codegen/gin-middleware"
 )
 
+// streamBodyExtension помечает операции, тело которых читается обработчиком потоком.
+// Для них валидатор проверяет только параметры, не буферизуя тело в память.
+const streamBodyExtension = "x-stream-body"
+
 // OapiRequestValidator создает middleware для валидации запросов по OpenAPI.
 func OapiRequestValidator(specPath string) (gin.HandlerFunc, error) {
 	const methodCtx = "middlewares.OapiRequestValidator"
@@ -38,5 +43,56 @@ func OapiRequestValidator(specPath string) (gin.HandlerFunc, error) {
 		SilenceServersWarning: true,
 	})
 
-	return validator, nil
+	streamRoutes := collectStreamRoutes(swagger)
+	if len(streamRoutes) == 0 {
+		return validator, nil
+	}
+
+	streamValidator := ginmiddleware.OapiRequestValidatorWithOptions(swagger, &ginmiddleware.Options{
+		Options: openapi3filter.Options{
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			ExcludeRequestBody: true,
+		},
+		SilenceServersWarning: true,
+	})
+
+	return func(c *gin.Context) {
+		if _, ok := streamRoutes[c.Request.Method+" "+c.FullPath()]; ok {
+			streamValidator(c)
+			return
+		}
+		validator(c)
+	}, nil
+}
+
+// collectStreamRoutes возвращает операции с x-stream-body в формате "METHOD /gin/:path".
+func collectStreamRoutes(swagger *openapi3.T) map[string]struct{} {
+	routes := map[string]struct{}{}
+	if swagger.Paths == nil {
+		return routes
+	}
+
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			if op == nil {
+				continue
+			}
+			if enabled, ok := op.Extensions[streamBodyExtension].(bool); !ok || !enabled {
+				continue
+			}
+			routes[strings.ToUpper(method)+" "+toGinPath(path)] = struct{}{}
+		}
+	}
+
+	return routes
+}
+
+func toGinPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = ":" + strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+		}
+	}
+	return strings.Join(segments, "/")
 }
@@ -0,0 +1,136 @@
+package middlewares
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+const testValidationSpec = `
+openapi: 3.0.3
+info:
+  title: test
+  version: 1.0.0
+paths:
+  /items/{id}:
+    post:
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+            format: uuid
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [name]
+              properties:
+                name:
+                  type: string
+      responses:
+        '200':
+          description: ok
+  /items/{id}/upload:
+    post:
+      x-stream-body: true
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+            format: uuid
+        - name: mode
+          in: query
+          required: false
+          schema:
+            type: string
+            enum: [keep, replace]
+      requestBody:
+        required: true
+        content:
+          multipart/form-data:
+            schema:
+              type: object
+              properties:
+                file:
+                  type: string
+                  format: binary
+      responses:
+        '200':
+          description: ok
+`
+
+func newValidationRouter(t *testing.T) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	specPath := filepath.Join(t.TempDir(), "openapi.yml")
+	require.NoError(t, os.WriteFile(specPath, []byte(testValidationSpec), 0o600))
+
+	validator, err := OapiRequestValidator(specPath)
+	require.NoError(t, err)
+
+	echo := func(c *gin.Context) {
+		data, err := io.ReadAll(c.Request.Body)
+		require.NoError(t, err)
+		c.String(http.StatusOK, string(data))
+	}
+
+	router := gin.New()
+	router.Use(validator)
+	router.POST("/items/:id", echo)
+	router.POST("/items/:id/upload", echo)
+	return router
+}
+
+func TestRequestValidatorChecksJSONBody(t *testing.T) {
+	const methodCtx = "middlewares.TestRequestValidatorChecksJSONBody"
+
+	router := newValidationRouter(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/items/7c9e6679-7425-40de-944b-e07fc1f90ae7", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusBadRequest, resp.Code, methodCtx)
+}
+
+func TestRequestValidatorSkipsStreamBody(t *testing.T) {
+	const methodCtx = "middlewares.TestRequestValidatorSkipsStreamBody"
+
+	router := newValidationRouter(t)
+
+	body := "--b\r\nContent-Disposition: form-data; name=\"file\"; filename=\"a.txt\"\r\n\r\nhello\r\n--b--\r\n"
+	req := httptest.NewRequest(http.MethodPost, "/items/7c9e6679-7425-40de-944b-e07fc1f90ae7/upload", strings.NewReader(body))
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=b")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code, methodCtx)
+	require.Equal(t, body, resp.Body.String(), methodCtx)
+}
+
+func TestRequestValidatorChecksStreamRouteParams(t *testing.T) {
+	const methodCtx = "middlewares.TestRequestValidatorChecksStreamRouteParams"
+
+	router := newValidationRouter(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/items/7c9e6679-7425-40de-944b-e07fc1f90ae7/upload?mode=unknown", strings.NewReader("data"))
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=b")
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusBadRequest, resp.Code, methodCtx)
+}
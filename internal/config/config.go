@@ -11,14 +11,21 @@ const DefaultPath = "configs/config.yml"
 
 // Config описывает конфигурацию приложения.
 type Config struct {
-	Server     ServerConfig     `yaml:"server"`
-	MySQL      MySQLConfig      `yaml:"mysql"`
-	Redis      RedisConfig      `yaml:"redis"`
-	Metrics    MetricsConfig    `yaml:"metrics"`
-	Slogger    SloggerConfig    `yaml:"slogger"`
-	Migrations MigrationsConfig `yaml:"migrations"`
-	Auth       AuthConfig       `yaml:"auth"`
-	Mailer     MailerConfig     `yaml:"mailer"`
+	Server      ServerConfig      `yaml:"server"`
+	MySQL       MySQLConfig       `yaml:"mysql"`
+	Redis       RedisConfig       `yaml:"redis"`
+	Metrics     MetricsConfig     `yaml:"metrics"`
+	Slogger     SloggerConfig     `yaml:"slogger"`
+	Migrations  MigrationsConfig  `yaml:"migrations"`
+	Auth        AuthConfig        `yaml:"auth"`
+	Mailer      MailerConfig      `yaml:"mailer"`
+	Storage     StorageConfig     `yaml:"storage"`
+	Attachments AttachmentsConfig `yaml:"attachments"`
+	Reminders   RemindersConfig   `yaml:"reminders"`
+	Archive     ArchiveConfig     `yaml:"archive"`
+	Imports     ImportsConfig     `yaml:"imports"`
+	Calendar    CalendarConfig    `yaml:"calendar"`
+	Teams       TeamsConfig       `yaml:"teams"`
 }
 
 type ServerConfig struct {
@@ -88,6 +95,73 @@ type MailerConfig struct {
 	TimeoutSeconds int    `yaml:"timeout_seconds"`
 }
 
+type StorageConfig struct {
+	Driver string             `yaml:"driver"`
+	Local  LocalStorageConfig `yaml:"local"`
+	S3     S3Config           `yaml:"s3"`
+}
+
+type LocalStorageConfig struct {
+	Path string `yaml:"path"`
+}
+
+type S3Config struct {
+	Endpoint       string `yaml:"endpoint"`
+	Region         string `yaml:"region"`
+	Bucket         string `yaml:"bucket"`
+	AccessKey      string `yaml:"access_key"`
+	SecretKey      string `yaml:"secret_key"`
+	PathStyle      bool   `yaml:"path_style"`
+	TimeoutSeconds int    `yaml:"timeout_seconds"`
+}
+
+type AttachmentsConfig struct {
+	MaxSizeBytes         int64    `yaml:"max_size_bytes"`
+	AllowedMIMETypes     []string `yaml:"allowed_mime_types"`
+	SweepIntervalSeconds int      `yaml:"sweep_interval_seconds"`
+}
+
+// RemindersConfig задает пороги напоминаний о сроках задач.
+// Нулевые значения заменяются значениями по умолчанию.
+type RemindersConfig struct {
+	IntervalSeconds    int `yaml:"interval_seconds"`
+	DueSoonHours       int `yaml:"due_soon_hours"`
+	EscalateAfterHours int `yaml:"escalate_after_hours"`
+	BatchSize          int `yaml:"batch_size"`
+}
+
+// ArchiveConfig задает период запуска архивации завершенных задач и размер пачки.
+// Нулевые значения заменяются значениями по умолчанию.
+type ArchiveConfig struct {
+	IntervalSeconds int `yaml:"interval_seconds"`
+	BatchSize       int `yaml:"batch_size"`
+}
+
+// ImportsConfig задает, через сколько секунд без обновления прогресса выполняющееся задание импорта считается прерванным.
+// Нулевое значение заменяется значением по умолчанию.
+type ImportsConfig struct {
+	StaleAfterSeconds int `yaml:"stale_after_seconds"`
+}
+
+// CalendarConfig задает параметры календарных лент.
+// PublicURL — внешний адрес API для ссылки на ленту, TaskURL — шаблон ссылки на задачу с {id}.
+// Пустой TaskURL отключает ссылки на задачи; нулевой PastDays заменяется значением по умолчанию.
+type CalendarConfig struct {
+	PublicURL string `yaml:"public_url"`
+	TaskURL   string `yaml:"task_url"`
+	PastDays  int    `yaml:"past_days"`
+}
+
+// TeamsConfig задает срок, в течение которого удаленную команду можно восстановить,
+// и период запуска окончательного удаления, а также срок действия приглашений и период их очистки.
+// Нулевые значения заменяются значениями по умолчанию.
+type TeamsConfig struct {
+	DeleteGraceHours             int `yaml:"delete_grace_hours"`
+	PurgeIntervalSeconds         int `yaml:"purge_interval_seconds"`
+	InviteTTLHours               int `yaml:"invite_ttl_hours"`
+	InviteCleanupIntervalSeconds int `yaml:"invite_cleanup_interval_seconds"`
+}
+
 // Load читает и парсит YAML конфигурацию. Если путь пустой, используется DefaultPath.
 func Load(path string) (*Config, error) {
 	const methodCtx = "config.Load"
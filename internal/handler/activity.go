@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// GetApiV1TasksIdActivity возвращает ленту активности задачи.
+func (h *Handler) GetApiV1TasksIdActivity(c *gin.Context, id api.TaskId, params api.GetApiV1TasksIdActivityParams) {
+	const methodCtx = "handler.GetApiV1TasksIdActivity"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	types, cursor, limit := activityParams(params.Type, params.Cursor, params.Limit)
+	resp, err := h.activity.TaskFeed(c.Request.Context(), userID, id, types, cursor, limit)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApiV1TeamsIdActivity возвращает ленту активности команды.
+func (h *Handler) GetApiV1TeamsIdActivity(c *gin.Context, id api.TeamId, params api.GetApiV1TeamsIdActivityParams) {
+	const methodCtx = "handler.GetApiV1TeamsIdActivity"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	types, cursor, limit := activityParams(params.Type, params.Cursor, params.Limit)
+	resp, err := h.activity.TeamFeed(c.Request.Context(), userID, id, types, cursor, limit)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func activityParams(typesParam *api.ActivityTypes, cursorParam *api.Cursor, limitParam *api.Limit) ([]api.ActivityType, string, int) {
+	var types []api.ActivityType
+	if typesParam != nil {
+		types = *typesParam
+	}
+	cursor := ""
+	if cursorParam != nil {
+		cursor = *cursorParam
+	}
+	limit := 0
+	if limitParam != nil {
+		limit = *limitParam
+	}
+	return types, cursor, limit
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+const attachmentFormField = "file"
+
+// GetApiV1TasksIdAttachments возвращает вложения задачи.
+func (h *Handler) GetApiV1TasksIdAttachments(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.GetApiV1TasksIdAttachments"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.attachments.List(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1TasksIdAttachments потоково загружает вложение без буферизации тела в памяти.
+func (h *Handler) PostApiV1TasksIdAttachments(c *gin.Context, id api.TaskId, params api.PostApiV1TasksIdAttachmentsParams) {
+	const methodCtx = "handler.PostApiV1TasksIdAttachments"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: fmt.Sprintf("%s: ожидается multipart/form-data", methodCtx)})
+		return
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: fmt.Sprintf("%s: файл не передан", methodCtx)})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: fmt.Sprintf("%s: ошибка разбора запроса", methodCtx)})
+			return
+		}
+		if part.FormName() != attachmentFormField {
+			_ = part.Close()
+			continue
+		}
+
+		resp, err := h.attachments.Upload(c.Request.Context(), userID, id, params.CommentId, part.FileName(), part)
+		_ = part.Close()
+		if err != nil {
+			writeError(c, err, methodCtx)
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+		return
+	}
+}
+
+// GetApiV1TasksIdAttachmentsAttachmentId отдает содержимое вложения.
+func (h *Handler) GetApiV1TasksIdAttachmentsAttachmentId(c *gin.Context, id api.TaskId, attachmentId api.AttachmentId) {
+	const methodCtx = "handler.GetApiV1TasksIdAttachmentsAttachmentId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	meta, content, err := h.attachments.Download(c.Request.Context(), userID, id, attachmentId)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+	defer content.Close()
+
+	c.Header("Content-Type", meta.ContentType)
+	c.Header("Content-Length", strconv.FormatInt(meta.SizeBytes, 10))
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": meta.FileName}))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+
+	if _, err := io.Copy(c.Writer, content); err != nil {
+		slog.Error("ошибка отправки вложения", slog.String("context", methodCtx), slog.String("error", err.Error()))
+	}
+}
+
+// DeleteApiV1TasksIdAttachmentsAttachmentId удаляет вложение.
+func (h *Handler) DeleteApiV1TasksIdAttachmentsAttachmentId(c *gin.Context, id api.TaskId, attachmentId api.AttachmentId) {
+	const methodCtx = "handler.DeleteApiV1TasksIdAttachmentsAttachmentId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.attachments.Delete(c.Request.Context(), userID, id, attachmentId); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// GetApiV1MeCalendarFeed возвращает состояние календарной ленты текущего пользователя.
+func (h *Handler) GetApiV1MeCalendarFeed(c *gin.Context) {
+	const methodCtx = "handler.GetApiV1MeCalendarFeed"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.calendar.Feed(c.Request.Context(), userID)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1MeCalendarFeed выпускает новый токен календарной ленты.
+func (h *Handler) PostApiV1MeCalendarFeed(c *gin.Context) {
+	const methodCtx = "handler.PostApiV1MeCalendarFeed"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.calendar.Rotate(c.Request.Context(), userID)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// DeleteApiV1MeCalendarFeed отзывает календарную ленту.
+func (h *Handler) DeleteApiV1MeCalendarFeed(c *gin.Context) {
+	const methodCtx = "handler.DeleteApiV1MeCalendarFeed"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.calendar.Revoke(c.Request.Context(), userID); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetApiV1CalendarTokenTasksIcs отдает ленту задач в формате iCalendar. Доступ определяется только токеном ленты.
+func (h *Handler) GetApiV1CalendarTokenTasksIcs(c *gin.Context, token string, params api.GetApiV1CalendarTokenTasksIcsParams) {
+	const methodCtx = "handler.GetApiV1CalendarTokenTasksIcs"
+
+	write, err := h.calendar.Render(c.Request.Context(), token, params.Component)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Header("Content-Type", "text/calendar; charset=utf-8")
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+
+	if err := write(c.Writer); err != nil {
+		slog.Error("ошибка выдачи календарной ленты", slog.String("context", methodCtx), slog.String("error", err.Error()))
+		c.Abort()
+	}
+}
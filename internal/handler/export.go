@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// exportContentTypes сопоставляет формат выгрузки с типом содержимого ответа.
+var exportContentTypes = map[api.TaskExportFormat]string{
+	api.TaskExportFormatCsv:    "text/csv; charset=utf-8",
+	api.TaskExportFormatNdjson: "application/x-ndjson",
+	api.TaskExportFormatJson:   "application/json; charset=utf-8",
+}
+
+// GetApiV1TeamsIdExport потоково выгружает задачи команды.
+func (h *Handler) GetApiV1TeamsIdExport(c *gin.Context, id api.TeamId, params api.GetApiV1TeamsIdExportParams) {
+	const methodCtx = "handler.GetApiV1TeamsIdExport"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	write, err := h.export.Export(c.Request.Context(), userID, id, params)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	fileName := "tasks-" + id.String() + "." + string(params.Format)
+	c.Header("Content-Type", exportContentTypes[params.Format])
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+
+	// Заголовки уже отправлены: при ошибке посреди выгрузки остается только оборвать ответ.
+	if err := write(c.Writer); err != nil {
+		slog.Error("ошибка выгрузки задач", slog.String("context", methodCtx), slog.String("error", err.Error()))
+		c.Abort()
+	}
+}
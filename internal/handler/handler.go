@@ -3,7 +3,9 @@ package handler
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -22,14 +24,43 @@ type TeamsService interface {
 	ListTeams(ctx context.Context, userID uuid.UUID) (api.TeamsListResponse, error)
 	Invite(ctx context.Context, inviterID uuid.UUID, teamID uuid.UUID, req api.InviteRequest) (api.Invite, error)
 	AcceptInvite(ctx context.Context, userID uuid.UUID, req api.AcceptInviteRequest) (api.TeamMember, error)
+	ListInvites(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.GetApiV1TeamsIdInvitesParams) (api.InvitesListResponse, error)
+	RevokeInvite(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, inviteID uuid.UUID) error
+	ResendInvite(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, inviteID uuid.UUID) (api.Invite, error)
+	GetTaskPolicy(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.TeamTaskPolicy, error)
+	UpdateTaskPolicy(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.UpdateTeamTaskPolicyRequest) (api.TeamTaskPolicy, error)
+	GetArchivePolicy(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.TeamArchivePolicy, error)
+	UpdateArchivePolicy(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.UpdateTeamArchivePolicyRequest) (api.TeamArchivePolicy, error)
+	GetTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.Team, error)
+	UpdateTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.UpdateTeamRequest) (api.Team, error)
+	DeleteTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.TeamDeletion, error)
+	RestoreTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.Team, error)
+	TransferOwnership(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.TransferTeamRequest) (api.TeamMember, error)
+	ListMembers(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.GetApiV1TeamsIdMembersParams) (api.TeamMembersListResponse, error)
+	UpdateMemberRole(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, memberID uuid.UUID, req api.UpdateMemberRoleRequest) (api.TeamMember, error)
+	RemoveMember(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, memberID uuid.UUID, params api.DeleteApiV1TeamsIdMembersUserIdParams) error
+	LeaveTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.PostApiV1TeamsIdLeaveParams) error
 }
 
 // TasksService описывает методы сервиса задач.
 type TasksService interface {
 	Create(ctx context.Context, userID uuid.UUID, req api.CreateTaskRequest) (api.Task, error)
-	List(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, status *api.TaskStatus, assigneeID *uuid.UUID, page int, perPage int) (api.TasksListResponse, error)
+	List(ctx context.Context, userID uuid.UUID, params api.GetApiV1TasksParams) (api.TasksListResponse, error)
+	ListMine(ctx context.Context, userID uuid.UUID, params api.GetApiV1MeTasksParams) (api.MyTasksListResponse, error)
+	RepairAssignees(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.RepairAssigneesRequest) (api.RepairAssigneesResult, error)
 	Update(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, req api.UpdateTaskRequest) (api.Task, error)
-	History(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.TaskHistoryListResponse, error)
+	Move(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, req api.MoveTaskRequest) (api.Task, error)
+	Clone(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, req api.CloneTaskRequest) (api.Task, error)
+	Links(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.TaskLinksListResponse, error)
+	History(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, params api.GetApiV1TasksIdHistoryParams) (api.TaskHistoryListResponse, error)
+	Revert(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, historyID uuid.UUID) (api.Task, error)
+}
+
+// WatchersService описывает методы сервиса наблюдателей.
+type WatchersService interface {
+	Watch(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.TaskWatcher, error)
+	Unwatch(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) error
+	List(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.TaskWatchersListResponse, error)
 }
 
 // CommentsService описывает методы сервиса комментариев.
@@ -40,24 +71,106 @@ type CommentsService interface {
 	Delete(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, commentID uuid.UUID) error
 }
 
+// WorklogsService описывает методы сервиса учета времени.
+type WorklogsService interface {
+	Create(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, req api.CreateWorklogRequest) (api.Worklog, error)
+	List(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.WorklogsListResponse, error)
+	Update(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, worklogID uuid.UUID, req api.UpdateWorklogRequest) (api.Worklog, error)
+	Delete(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, worklogID uuid.UUID) error
+	StartTimer(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.Worklog, error)
+	StopTimer(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, note *string) (api.Worklog, error)
+}
+
+// AttachmentsService описывает методы сервиса вложений.
+type AttachmentsService interface {
+	Upload(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, commentID *uuid.UUID, fileName string, body io.Reader) (api.Attachment, error)
+	List(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.AttachmentsListResponse, error)
+	Download(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, attachmentID uuid.UUID) (api.Attachment, io.ReadCloser, error)
+	Delete(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, attachmentID uuid.UUID) error
+}
+
 // ReportsService описывает методы сервиса отчетов.
 type ReportsService interface {
 	TeamSummary(ctx context.Context, userID uuid.UUID) ([]api.TeamSummary, error)
 	TopCreators(ctx context.Context, userID uuid.UUID, month string) ([]api.TeamTopCreators, error)
 	InvalidAssignees(ctx context.Context, userID uuid.UUID) ([]api.InvalidAssignee, error)
+	Timesheet(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time, teamID *uuid.UUID, targetUserID *uuid.UUID) (api.TimesheetReport, error)
+	Velocity(ctx context.Context, userID uuid.UUID, groupBy api.VelocityGroupBy, from time.Time, to time.Time, teamID *uuid.UUID) (api.VelocityReport, error)
+}
+
+// ActivityService описывает методы сервиса ленты активности.
+type ActivityService interface {
+	TaskFeed(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, types []api.ActivityType, cursor string, limit int) (api.ActivityFeedResponse, error)
+	TeamFeed(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, types []api.ActivityType, cursor string, limit int) (api.ActivityFeedResponse, error)
+}
+
+// ImportsService описывает методы сервиса импорта задач.
+type ImportsService interface {
+	Submit(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, format api.TaskImportFormat, dryRun bool, mapping string, body io.Reader) (api.TaskImport, error)
+	Get(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, importID uuid.UUID) (api.TaskImport, error)
+}
+
+// ExportService описывает методы сервиса выгрузки задач.
+type ExportService interface {
+	Export(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.GetApiV1TeamsIdExportParams) (func(w io.Writer) error, error)
+}
+
+// ViewsService описывает методы сервиса сохраненных представлений.
+type ViewsService interface {
+	List(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.TaskViewsListResponse, error)
+	Create(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.CreateTaskViewRequest) (api.TaskView, error)
+	Get(ctx context.Context, userID uuid.UUID, viewID uuid.UUID) (api.TaskView, error)
+	Update(ctx context.Context, userID uuid.UUID, viewID uuid.UUID, req api.UpdateTaskViewRequest) (api.TaskView, error)
+	Delete(ctx context.Context, userID uuid.UUID, viewID uuid.UUID) error
+}
+
+// SprintsService описывает методы сервиса спринтов.
+type SprintsService interface {
+	List(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.GetApiV1TeamsIdSprintsParams) (api.SprintsListResponse, error)
+	Create(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.CreateSprintRequest) (api.Sprint, error)
+	Get(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID) (api.Sprint, error)
+	Update(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID, req api.UpdateSprintRequest) (api.Sprint, error)
+	Start(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID) (api.Sprint, error)
+	Close(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID, req api.CloseSprintRequest) (api.CloseSprintResponse, error)
+	AddTasks(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID, req api.SprintTasksRequest) error
+	RemoveTask(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID, taskID uuid.UUID) error
+}
+
+// RemindersService описывает методы настроек напоминаний о сроках задач.
+type RemindersService interface {
+	Settings(ctx context.Context, userID uuid.UUID) (api.NotificationSettings, error)
+	UpdateSettings(ctx context.Context, userID uuid.UUID, req api.UpdateNotificationSettingsRequest) (api.NotificationSettings, error)
+}
+
+// CalendarService описывает методы календарной ленты задач.
+type CalendarService interface {
+	Feed(ctx context.Context, userID uuid.UUID) (api.CalendarFeed, error)
+	Rotate(ctx context.Context, userID uuid.UUID) (api.CalendarFeedToken, error)
+	Revoke(ctx context.Context, userID uuid.UUID) error
+	Render(ctx context.Context, token string, component *api.CalendarComponent) (func(w io.Writer) error, error)
 }
 
 // Handler реализует HTTP-обработчики по контракту OpenAPI.
 type Handler struct {
-	auth     AuthService
-	teams    TeamsService
-	tasks    TasksService
-	comments CommentsService
-	reports  ReportsService
+	auth        AuthService
+	teams       TeamsService
+	tasks       TasksService
+	watchers    WatchersService
+	comments    CommentsService
+	attachments AttachmentsService
+	worklogs    WorklogsService
+	reports     ReportsService
+	activity    ActivityService
+	imports     ImportsService
+	export      ExportService
+	views       ViewsService
+	sprints     SprintsService
+	reminders   RemindersService
+	calendar    CalendarService
 }
 
 // New создает новый набор обработчиков.
-func New(auth AuthService, teams TeamsService, tasks TasksService, comments CommentsService, reports ReportsService) (*Handler, error) {
+func New(auth AuthService, teams TeamsService, tasks TasksService, watchers WatchersService, comments CommentsService, attachments AttachmentsService, worklogs WorklogsService, reports ReportsService, activity ActivityService, imports ImportsService, export ExportService, views ViewsService, sprints SprintsService, reminders RemindersService, calendar CalendarService) (*Handler, error) {
 	const methodCtx = "handler.New"
 
 	slog.Debug("инициализация HTTP-обработчиков", slog.String("context", methodCtx))
@@ -71,12 +184,42 @@ func New(auth AuthService, teams TeamsService, tasks TasksService, comments Comm
 	if tasks == nil {
 		return nil, fmt.Errorf("%s: tasks сервис не задан", methodCtx)
 	}
+	if watchers == nil {
+		return nil, fmt.Errorf("%s: watchers сервис не задан", methodCtx)
+	}
 	if comments == nil {
 		return nil, fmt.Errorf("%s: comments сервис не задан", methodCtx)
 	}
+	if attachments == nil {
+		return nil, fmt.Errorf("%s: attachments сервис не задан", methodCtx)
+	}
+	if worklogs == nil {
+		return nil, fmt.Errorf("%s: worklogs сервис не задан", methodCtx)
+	}
 	if reports == nil {
 		return nil, fmt.Errorf("%s: reports сервис не задан", methodCtx)
 	}
+	if activity == nil {
+		return nil, fmt.Errorf("%s: activity сервис не задан", methodCtx)
+	}
+	if imports == nil {
+		return nil, fmt.Errorf("%s: imports сервис не задан", methodCtx)
+	}
+	if export == nil {
+		return nil, fmt.Errorf("%s: export сервис не задан", methodCtx)
+	}
+	if views == nil {
+		return nil, fmt.Errorf("%s: views сервис не задан", methodCtx)
+	}
+	if sprints == nil {
+		return nil, fmt.Errorf("%s: sprints сервис не задан", methodCtx)
+	}
+	if reminders == nil {
+		return nil, fmt.Errorf("%s: reminders сервис не задан", methodCtx)
+	}
+	if calendar == nil {
+		return nil, fmt.Errorf("%s: calendar сервис не задан", methodCtx)
+	}
 
-	return &Handler{auth: auth, teams: teams, tasks: tasks, comments: comments, reports: reports}, nil
+	return &Handler{auth: auth, teams: teams, tasks: tasks, watchers: watchers, comments: comments, attachments: attachments, worklogs: worklogs, reports: reports, activity: activity, imports: imports, export: export, views: views, sprints: sprints, reminders: reminders, calendar: calendar}, nil
 }
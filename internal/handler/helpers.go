@@ -11,10 +11,20 @@ import (
 
 	"github.com/Seraf-seraf/mkk_test/internal/api"
 	appmw "github.com/Seraf-seraf/mkk_test/internal/app/middlewares"
+	"github.com/Seraf-seraf/mkk_test/internal/service/activity"
+	"github.com/Seraf-seraf/mkk_test/internal/service/attachments"
 	"github.com/Seraf-seraf/mkk_test/internal/service/auth"
+	"github.com/Seraf-seraf/mkk_test/internal/service/calendar"
 	"github.com/Seraf-seraf/mkk_test/internal/service/comments"
+	"github.com/Seraf-seraf/mkk_test/internal/service/export"
+	"github.com/Seraf-seraf/mkk_test/internal/service/imports"
+	"github.com/Seraf-seraf/mkk_test/internal/service/reports"
+	"github.com/Seraf-seraf/mkk_test/internal/service/sprints"
 	"github.com/Seraf-seraf/mkk_test/internal/service/tasks"
 	"github.com/Seraf-seraf/mkk_test/internal/service/teams"
+	"github.com/Seraf-seraf/mkk_test/internal/service/views"
+	"github.com/Seraf-seraf/mkk_test/internal/service/watchers"
+	"github.com/Seraf-seraf/mkk_test/internal/service/worklogs"
 )
 
 func getUserID(c *gin.Context) (uuid.UUID, error) {
@@ -56,12 +66,87 @@ func mapError(err error) (int, api.ErrorResponse) {
 		return http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()}
 	case errors.Is(err, auth.ErrUserExists):
 		return http.StatusBadRequest, api.ErrorResponse{Error: err.Error()}
-	case errors.Is(err, teams.ErrForbidden), errors.Is(err, tasks.ErrForbidden), errors.Is(err, comments.ErrForbidden), errors.Is(err, teams.ErrInviteEmailMismatch):
+	case errors.Is(err, teams.ErrForbidden),
+		errors.Is(err, tasks.ErrForbidden),
+		errors.Is(err, comments.ErrForbidden),
+		errors.Is(err, watchers.ErrForbidden),
+		errors.Is(err, attachments.ErrForbidden),
+		errors.Is(err, worklogs.ErrForbidden),
+		errors.Is(err, reports.ErrForbidden),
+		errors.Is(err, activity.ErrForbidden),
+		errors.Is(err, imports.ErrForbidden),
+		errors.Is(err, export.ErrForbidden),
+		errors.Is(err, views.ErrForbidden),
+		errors.Is(err, sprints.ErrForbidden),
+		errors.Is(err, teams.ErrInviteEmailMismatch):
 		return http.StatusForbidden, api.ErrorResponse{Error: err.Error()}
-	case errors.Is(err, teams.ErrNotFound), errors.Is(err, tasks.ErrNotFound), errors.Is(err, comments.ErrNotFound), errors.Is(err, teams.ErrInviteNotFound):
+	case errors.Is(err, teams.ErrNotFound),
+		errors.Is(err, tasks.ErrNotFound),
+		errors.Is(err, comments.ErrNotFound),
+		errors.Is(err, watchers.ErrNotFound),
+		errors.Is(err, attachments.ErrNotFound),
+		errors.Is(err, worklogs.ErrNotFound),
+		errors.Is(err, activity.ErrNotFound),
+		errors.Is(err, imports.ErrNotFound),
+		errors.Is(err, views.ErrNotFound),
+		errors.Is(err, sprints.ErrNotFound),
+		errors.Is(err, calendar.ErrNotFound),
+		errors.Is(err, teams.ErrInviteNotFound),
+		errors.Is(err, teams.ErrMemberNotFound):
 		return http.StatusNotFound, api.ErrorResponse{Error: err.Error()}
-	case errors.Is(err, teams.ErrAlreadyMember), errors.Is(err, tasks.ErrInvalidAssignee):
+	case errors.Is(err, teams.ErrAlreadyMember),
+		errors.Is(err, teams.ErrInvalidPolicy),
+		errors.Is(err, teams.ErrInvalidArchiveDays),
+		errors.Is(err, teams.ErrInvalidName),
+		errors.Is(err, teams.ErrInvalidTransfer),
+		errors.Is(err, teams.ErrInvalidRole),
+		errors.Is(err, teams.ErrInvalidStrategy),
+		errors.Is(err, teams.ErrInvalidReassign),
+		errors.Is(err, tasks.ErrAssigneeRequired),
+		errors.Is(err, tasks.ErrInvalidAssignee),
+		errors.Is(err, tasks.ErrInvalidEstimate),
+		errors.Is(err, tasks.ErrInvalidTitle),
+		errors.Is(err, tasks.ErrInvalidStoryPoints),
+		errors.Is(err, tasks.ErrSameTeam),
+		errors.Is(err, tasks.ErrRevertInvalid),
+		errors.Is(err, tasks.ErrInvalidHistoryFilter),
+		errors.Is(err, tasks.ErrTeamRequired),
+		errors.Is(err, tasks.ErrViewTeamMismatch),
+		errors.Is(err, tasks.ErrInvalidMove),
+		errors.Is(err, attachments.ErrEmptyFile),
+		errors.Is(err, worklogs.ErrInvalidDuration),
+		errors.Is(err, reports.ErrInvalidPeriod),
+		errors.Is(err, reports.ErrInvalidGroupBy),
+		errors.Is(err, activity.ErrInvalidCursor),
+		errors.Is(err, activity.ErrInvalidType),
+		errors.Is(err, imports.ErrInvalidFormat),
+		errors.Is(err, imports.ErrInvalidMapping),
+		errors.Is(err, imports.ErrEmptyFile),
+		errors.Is(err, export.ErrInvalidFormat),
+		errors.Is(err, export.ErrInvalidInclude),
+		errors.Is(err, views.ErrInvalidName),
+		errors.Is(err, views.ErrInvalidSort),
+		errors.Is(err, sprints.ErrInvalidName),
+		errors.Is(err, sprints.ErrInvalidDates),
+		errors.Is(err, sprints.ErrInvalidTask),
+		errors.Is(err, sprints.ErrInvalidNext),
+		errors.Is(err, calendar.ErrInvalidComponent):
 		return http.StatusBadRequest, api.ErrorResponse{Error: err.Error()}
+	case errors.Is(err, worklogs.ErrTimerRunning),
+		errors.Is(err, worklogs.ErrTimerNotRunning),
+		errors.Is(err, tasks.ErrRevertConflict),
+		errors.Is(err, views.ErrViewExists),
+		errors.Is(err, sprints.ErrSprintClosed),
+		errors.Is(err, sprints.ErrInvalidState),
+		errors.Is(err, sprints.ErrActiveExists),
+		errors.Is(err, teams.ErrLastOwner),
+		errors.Is(err, teams.ErrOpenTasks):
+		return http.StatusConflict, api.ErrorResponse{Error: err.Error()}
+	case errors.Is(err, attachments.ErrTooLarge),
+		errors.Is(err, imports.ErrTooLarge):
+		return http.StatusRequestEntityTooLarge, api.ErrorResponse{Error: err.Error()}
+	case errors.Is(err, attachments.ErrUnsupportedType):
+		return http.StatusUnsupportedMediaType, api.ErrorResponse{Error: err.Error()}
 	default:
 		return http.StatusInternalServerError, api.ErrorResponse{Error: "внутренняя ошибка сервера"}
 	}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+func (s *HTTPSuite) TestTaskEditPolicyAuthz() {
+	const methodCtx = "handler.HTTPSuite.TestTaskEditPolicyAuthz"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	ownerID := s.CreateUser("owner-authz@example.com")
+	creatorID := s.CreateUser("creator-authz@example.com")
+	assigneeID := s.CreateUser("assignee-authz@example.com")
+	memberID := s.CreateUser("member-authz@example.com")
+
+	teamID := s.CreateTeam("Authz Team", ownerID)
+	s.AddTeamMember(teamID, ownerID, "owner")
+	s.AddTeamMember(teamID, creatorID, "member")
+	s.AddTeamMember(teamID, assigneeID, "member")
+	s.AddTeamMember(teamID, memberID, "member")
+
+	taskID := s.CreateTask(teamID, creatorID, &assigneeID, "todo", "Authz Task", "")
+	taskPath := fmt.Sprintf("/api/v1/tasks/%s", taskID.String())
+
+	ownerToken := s.buildToken(ownerID.String(), "owner")
+	assigneeToken := s.buildToken(assigneeID.String(), "member")
+	memberToken := s.buildToken(memberID.String(), "member")
+
+	inProgress := api.TaskStatus("in_progress")
+	resp, _ := s.doJSON(http.MethodPut, taskPath, assigneeToken, api.UpdateTaskRequest{Status: &inProgress})
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+
+	title := "renamed"
+	resp, _ = s.doJSON(http.MethodPut, taskPath, assigneeToken, api.UpdateTaskRequest{Title: &title})
+	require.Equal(s.T(), http.StatusForbidden, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodPut, taskPath, memberToken, api.UpdateTaskRequest{Status: &inProgress})
+	require.Equal(s.T(), http.StatusForbidden, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodPut, taskPath, ownerToken, api.UpdateTaskRequest{Title: &title})
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+
+	policyPath := fmt.Sprintf("/api/v1/teams/%s/task-policy", teamID.String())
+	policyReq := api.UpdateTeamTaskPolicyRequest{TaskEditPolicy: api.AssigneeFull}
+
+	resp, _ = s.doJSON(http.MethodPut, policyPath, memberToken, policyReq)
+	require.Equal(s.T(), http.StatusForbidden, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodPut, policyPath, ownerToken, policyReq)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+
+	resp, body := s.doJSON(http.MethodGet, policyPath, memberToken, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+
+	var policy api.TeamTaskPolicy
+	require.NoError(s.T(), json.Unmarshal(body, &policy), methodCtx)
+	require.Equal(s.T(), api.AssigneeFull, policy.TaskEditPolicy, methodCtx)
+
+	assigneeTitle := "assignee title"
+	resp, _ = s.doJSON(http.MethodPut, taskPath, assigneeToken, api.UpdateTaskRequest{Title: &assigneeTitle})
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+}
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,21 +19,35 @@ import (
 
 	"github.com/Seraf-seraf/mkk_test/internal/api"
 	appmw "github.com/Seraf-seraf/mkk_test/internal/app/middlewares"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/blobstore"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/breaker"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/cache"
 	httpserver "github.com/Seraf-seraf/mkk_test/internal/pkg/http"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/mailer"
 	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/service/activity"
+	"github.com/Seraf-seraf/mkk_test/internal/service/attachments"
 	"github.com/Seraf-seraf/mkk_test/internal/service/auth"
+	"github.com/Seraf-seraf/mkk_test/internal/service/calendar"
 	"github.com/Seraf-seraf/mkk_test/internal/service/comments"
+	"github.com/Seraf-seraf/mkk_test/internal/service/export"
+	"github.com/Seraf-seraf/mkk_test/internal/service/imports"
+	"github.com/Seraf-seraf/mkk_test/internal/service/reminders"
 	"github.com/Seraf-seraf/mkk_test/internal/service/reports"
+	"github.com/Seraf-seraf/mkk_test/internal/service/sprints"
 	"github.com/Seraf-seraf/mkk_test/internal/service/tasks"
 	"github.com/Seraf-seraf/mkk_test/internal/service/teams"
+	"github.com/Seraf-seraf/mkk_test/internal/service/views"
+	"github.com/Seraf-seraf/mkk_test/internal/service/watchers"
+	"github.com/Seraf-seraf/mkk_test/internal/service/worklogs"
 	"github.com/Seraf-seraf/mkk_test/internal/tests"
 )
 
 type HTTPSuite struct {
 	tests.IntegrationSuite
+	imports *imports.Service
+	mailer  *mailer.MockMailer
 }
 
 func TestHTTPSuite(t *testing.T) {
@@ -61,32 +77,83 @@ func (s *HTTPSuite) startServer() {
 	teamsRepo := repomysql.NewTeamsRepo(s.DB)
 	membersRepo := repomysql.NewTeamMembersRepo(s.DB)
 	invitesRepo := repomysql.NewTeamInvitesRepo(s.DB)
+	memberEventsRepo := repomysql.NewTeamMemberEventsRepo(s.DB)
 	tasksRepo := repomysql.NewTasksRepo(s.DB)
+	assigneesRepo := repomysql.NewTaskAssigneesRepo(s.DB)
 	historyRepo := repomysql.NewTaskHistoryRepo(s.DB)
+	watchersRepo := repomysql.NewTaskWatchersRepo(s.DB)
 	commentsRepo := repomysql.NewCommentsRepo(s.DB)
+	attachmentsRepo := repomysql.NewTaskAttachmentsRepo(s.DB)
+	worklogsRepo := repomysql.NewTaskWorklogsRepo(s.DB)
 	reportsRepo := repomysql.NewReportsRepo(s.DB)
+	activityRepo := repomysql.NewActivityRepo(s.DB)
+	importsRepo := repomysql.NewTaskImportsRepo(s.DB)
+	viewsRepo := repomysql.NewTaskViewsRepo(s.DB)
+	sprintsRepo := repomysql.NewSprintsRepo(s.DB)
+	remindersRepo := repomysql.NewTaskRemindersRepo(s.DB)
+	notificationSettingsRepo := repomysql.NewNotificationSettingsRepo(s.DB)
+	calendarFeedsRepo := repomysql.NewCalendarFeedsRepo(s.DB)
+	linksRepo := repomysql.NewTaskLinksRepo(s.DB)
 
 	cb, err := breaker.New("mailer")
 	require.NoError(s.T(), err, methodCtx)
 
 	mailerSvc := mailer.NewMockMailer()
+	s.mailer = mailerSvc
 
 	authSvc, err := auth.NewService(usersRepo, membersRepo, mailerSvc, cb, s.Config.Auth.JWT)
 	require.NoError(s.T(), err, methodCtx)
 
-	teamsSvc, err := teams.NewService(s.DB, teamsRepo, membersRepo, invitesRepo, usersRepo, mailerSvc, cb)
+	tasksSvc, err := tasks.NewService(s.DB, tasksRepo, assigneesRepo, membersRepo, teamsRepo, historyRepo, watchersRepo, viewsRepo, linksRepo, attachmentsRepo, tasksCache)
 	require.NoError(s.T(), err, methodCtx)
 
-	tasksSvc, err := tasks.NewService(s.DB, tasksRepo, membersRepo, historyRepo, tasksCache)
+	teamsSvc, err := teams.NewService(s.DB, teamsRepo, membersRepo, invitesRepo, usersRepo, memberEventsRepo, tasksSvc, mailerSvc, cb, config.TeamsConfig{})
 	require.NoError(s.T(), err, methodCtx)
 
-	commentsSvc, err := comments.NewService(commentsRepo, tasksRepo, membersRepo)
+	watchersSvc, err := watchers.NewService(watchersRepo, tasksRepo, membersRepo)
 	require.NoError(s.T(), err, methodCtx)
 
-	reportsSvc, err := reports.NewService(reportsRepo)
+	commentsSvc, err := comments.NewService(s.DB, commentsRepo, tasksRepo, membersRepo, watchersRepo)
 	require.NoError(s.T(), err, methodCtx)
 
-	handlerSvc, err := New(authSvc, teamsSvc, tasksSvc, commentsSvc, reportsSvc)
+	store, err := blobstore.NewLocalStore(s.T().TempDir())
+	require.NoError(s.T(), err, methodCtx)
+
+	attachmentsSvc, err := attachments.NewService(attachmentsRepo, tasksRepo, membersRepo, commentsRepo, store, config.AttachmentsConfig{
+		MaxSizeBytes:     1024,
+		AllowedMIMETypes: []string{"text/plain", "image/png"},
+	})
+	require.NoError(s.T(), err, methodCtx)
+
+	worklogsSvc, err := worklogs.NewService(worklogsRepo, tasksRepo, membersRepo)
+	require.NoError(s.T(), err, methodCtx)
+
+	reportsSvc, err := reports.NewService(reportsRepo, membersRepo)
+	require.NoError(s.T(), err, methodCtx)
+
+	activitySvc, err := activity.NewService(activityRepo, tasksRepo, membersRepo)
+	require.NoError(s.T(), err, methodCtx)
+
+	importsSvc, err := imports.NewService(importsRepo, tasksSvc, membersRepo, usersRepo, s.Config.Imports)
+	require.NoError(s.T(), err, methodCtx)
+	s.imports = importsSvc
+
+	exportSvc, err := export.NewService(tasksRepo, assigneesRepo, commentsRepo, historyRepo, membersRepo)
+	require.NoError(s.T(), err, methodCtx)
+
+	viewsSvc, err := views.NewService(viewsRepo, membersRepo)
+	require.NoError(s.T(), err, methodCtx)
+
+	sprintsSvc, err := sprints.NewService(s.DB, sprintsRepo, tasksRepo, historyRepo, membersRepo, tasksCache)
+	require.NoError(s.T(), err, methodCtx)
+
+	remindersSvc, err := reminders.NewService(remindersRepo, notificationSettingsRepo, mailerSvc, cb, s.Config.Reminders)
+	require.NoError(s.T(), err, methodCtx)
+
+	calendarSvc, err := calendar.NewService(calendarFeedsRepo, s.Config.Calendar)
+	require.NoError(s.T(), err, methodCtx)
+
+	handlerSvc, err := New(authSvc, teamsSvc, tasksSvc, watchersSvc, commentsSvc, attachmentsSvc, worklogsSvc, reportsSvc, activitySvc, importsSvc, exportSvc, viewsSvc, sprintsSvc, remindersSvc, calendarSvc)
 	require.NoError(s.T(), err, methodCtx)
 
 	validator, err := appmw.OapiRequestValidator("api/openapi.yml")
@@ -113,26 +180,88 @@ func (s *HTTPSuite) startServer() {
 		RegisterPublic: func(group *gin.RouterGroup) error {
 			group.POST("/login", wrapper.PostApiV1Login)
 			group.POST("/register", wrapper.PostApiV1Register)
+			group.GET("/calendar/:token/tasks.ics", wrapper.GetApiV1CalendarTokenTasksIcs)
 			return nil
 		},
 		RegisterAPI: func(group *gin.RouterGroup) error {
 			group.GET("/teams", wrapper.GetApiV1Teams)
 			group.POST("/teams", wrapper.PostApiV1Teams)
 			group.POST("/teams/invites/accept", wrapper.PostApiV1TeamsInvitesAccept)
+			group.GET("/teams/:id", wrapper.GetApiV1TeamsId)
+			group.PATCH("/teams/:id", appmw.RBAC("owner", "admin"), wrapper.PatchApiV1TeamsId)
+			group.DELETE("/teams/:id", appmw.RBAC("owner"), wrapper.DeleteApiV1TeamsId)
+			group.POST("/teams/:id/restore", appmw.RBAC("owner"), wrapper.PostApiV1TeamsIdRestore)
+			group.POST("/teams/:id/transfer", appmw.RBAC("owner"), wrapper.PostApiV1TeamsIdTransfer)
+			group.GET("/teams/:id/members", wrapper.GetApiV1TeamsIdMembers)
+			group.PATCH("/teams/:id/members/:user_id", appmw.RBAC("owner", "admin"), wrapper.PatchApiV1TeamsIdMembersUserId)
+			group.DELETE("/teams/:id/members/:user_id", appmw.RBAC("owner", "admin"), wrapper.DeleteApiV1TeamsIdMembersUserId)
+			group.POST("/teams/:id/leave", wrapper.PostApiV1TeamsIdLeave)
 			group.POST("/teams/:id/invite", appmw.RBAC("owner", "admin"), wrapper.PostApiV1TeamsIdInvite)
+			group.GET("/teams/:id/invites", appmw.RBAC("owner", "admin"), wrapper.GetApiV1TeamsIdInvites)
+			group.DELETE("/teams/:id/invites/:invite_id", appmw.RBAC("owner", "admin"), wrapper.DeleteApiV1TeamsIdInvitesInviteId)
+			group.POST("/teams/:id/invites/:invite_id/resend", appmw.RBAC("owner", "admin"), wrapper.PostApiV1TeamsIdInvitesInviteIdResend)
+			group.GET("/teams/:id/task-policy", wrapper.GetApiV1TeamsIdTaskPolicy)
+			group.PUT("/teams/:id/task-policy", appmw.RBAC("owner", "admin"), wrapper.PutApiV1TeamsIdTaskPolicy)
+			group.GET("/teams/:id/archive-policy", wrapper.GetApiV1TeamsIdArchivePolicy)
+			group.PUT("/teams/:id/archive-policy", appmw.RBAC("owner", "admin"), wrapper.PutApiV1TeamsIdArchivePolicy)
+			group.GET("/teams/:id/activity", wrapper.GetApiV1TeamsIdActivity)
+			group.POST("/teams/:id/task-imports", wrapper.PostApiV1TeamsIdTaskImports)
+			group.GET("/teams/:id/task-imports/:import_id", wrapper.GetApiV1TeamsIdTaskImportsImportId)
+			group.GET("/teams/:id/export", wrapper.GetApiV1TeamsIdExport)
+			group.GET("/teams/:id/views", wrapper.GetApiV1TeamsIdViews)
+			group.POST("/teams/:id/views", wrapper.PostApiV1TeamsIdViews)
+			group.GET("/views/:view_id", wrapper.GetApiV1ViewsViewId)
+			group.PUT("/views/:view_id", wrapper.PutApiV1ViewsViewId)
+			group.DELETE("/views/:view_id", wrapper.DeleteApiV1ViewsViewId)
+			group.GET("/teams/:id/sprints", wrapper.GetApiV1TeamsIdSprints)
+			group.POST("/teams/:id/sprints", wrapper.PostApiV1TeamsIdSprints)
+			group.GET("/sprints/:sprint_id", wrapper.GetApiV1SprintsSprintId)
+			group.PUT("/sprints/:sprint_id", wrapper.PutApiV1SprintsSprintId)
+			group.POST("/sprints/:sprint_id/start", wrapper.PostApiV1SprintsSprintIdStart)
+			group.POST("/sprints/:sprint_id/close", wrapper.PostApiV1SprintsSprintIdClose)
+			group.POST("/sprints/:sprint_id/tasks", wrapper.PostApiV1SprintsSprintIdTasks)
+			group.DELETE("/sprints/:sprint_id/tasks/:task_id", wrapper.DeleteApiV1SprintsSprintIdTasksTaskId)
+			group.GET("/me/tasks", wrapper.GetApiV1MeTasks)
+			group.GET("/me/notifications", wrapper.GetApiV1MeNotifications)
+			group.PUT("/me/notifications", wrapper.PutApiV1MeNotifications)
+			group.GET("/me/calendar-feed", wrapper.GetApiV1MeCalendarFeed)
+			group.POST("/me/calendar-feed", wrapper.PostApiV1MeCalendarFeed)
+			group.DELETE("/me/calendar-feed", wrapper.DeleteApiV1MeCalendarFeed)
 
 			group.GET("/tasks", wrapper.GetApiV1Tasks)
 			group.POST("/tasks", appmw.RBAC("member", "admin", "owner"), wrapper.PostApiV1Tasks)
 			group.PUT("/tasks/:id", wrapper.PutApiV1TasksId)
+			group.POST("/tasks/:id/move", wrapper.PostApiV1TasksIdMove)
+			group.POST("/tasks/:id/clone", wrapper.PostApiV1TasksIdClone)
+			group.GET("/tasks/:id/links", wrapper.GetApiV1TasksIdLinks)
 			group.GET("/tasks/:id/history", wrapper.GetApiV1TasksIdHistory)
+			group.POST("/tasks/:id/history/:history_id/revert", wrapper.PostApiV1TasksIdHistoryHistoryIdRevert)
+			group.GET("/tasks/:id/activity", wrapper.GetApiV1TasksIdActivity)
+			group.GET("/tasks/:id/watchers", wrapper.GetApiV1TasksIdWatchers)
+			group.POST("/tasks/:id/watch", wrapper.PostApiV1TasksIdWatch)
+			group.DELETE("/tasks/:id/watch", wrapper.DeleteApiV1TasksIdWatch)
 			group.GET("/tasks/:id/comments", wrapper.GetApiV1TasksIdComments)
 			group.POST("/tasks/:id/comments", wrapper.PostApiV1TasksIdComments)
 			group.PUT("/tasks/:id/comments/:comment_id", wrapper.PutApiV1TasksIdCommentsCommentId)
 			group.DELETE("/tasks/:id/comments/:comment_id", wrapper.DeleteApiV1TasksIdCommentsCommentId)
+			group.GET("/tasks/:id/attachments", wrapper.GetApiV1TasksIdAttachments)
+			group.POST("/tasks/:id/attachments", wrapper.PostApiV1TasksIdAttachments)
+			group.GET("/tasks/:id/attachments/:attachment_id", wrapper.GetApiV1TasksIdAttachmentsAttachmentId)
+			group.DELETE("/tasks/:id/attachments/:attachment_id", wrapper.DeleteApiV1TasksIdAttachmentsAttachmentId)
+			group.GET("/tasks/:id/worklogs", wrapper.GetApiV1TasksIdWorklogs)
+			group.POST("/tasks/:id/worklogs", wrapper.PostApiV1TasksIdWorklogs)
+			group.PUT("/tasks/:id/worklogs/:worklog_id", wrapper.PutApiV1TasksIdWorklogsWorklogId)
+			group.DELETE("/tasks/:id/worklogs/:worklog_id", wrapper.DeleteApiV1TasksIdWorklogsWorklogId)
+			group.POST("/tasks/:id/timer/start", wrapper.PostApiV1TasksIdTimerStart)
+			group.POST("/tasks/:id/timer/stop", wrapper.PostApiV1TasksIdTimerStop)
 
 			group.GET("/reports/team-summary", wrapper.GetApiV1ReportsTeamSummary)
 			group.GET("/reports/top-creators", wrapper.GetApiV1ReportsTopCreators)
 			group.GET("/reports/invalid-assignees", wrapper.GetApiV1ReportsInvalidAssignees)
+			group.POST("/teams/:id/invalid-assignees/repair", appmw.RBAC("owner", "admin"), wrapper.PostApiV1TeamsIdInvalidAssigneesRepair)
+			group.POST("/teams/:id/invalid-assignees/repair", appmw.RBAC("owner", "admin"), wrapper.PostApiV1TeamsIdInvalidAssigneesRepair)
+			group.GET("/reports/timesheet", wrapper.GetApiV1ReportsTimesheet)
+			group.GET("/reports/velocity", wrapper.GetApiV1ReportsVelocity)
 			return nil
 		},
 	}
@@ -180,6 +309,32 @@ func (s *HTTPSuite) doJSON(method string, path string, token string, body interf
 	return resp, data
 }
 
+func (s *HTTPSuite) doUpload(path string, token string, fileName string, content []byte) (*http.Response, []byte) {
+	const methodCtx = "handler.HTTPSuite.doUpload"
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile("file", fileName)
+	require.NoError(s.T(), err, methodCtx)
+	_, err = part.Write(content)
+	require.NoError(s.T(), err, methodCtx)
+	require.NoError(s.T(), writer.Close(), methodCtx)
+
+	req, err := http.NewRequest(http.MethodPost, s.ServerURL+path, &buf)
+	require.NoError(s.T(), err, methodCtx)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(s.T(), err, methodCtx)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(s.T(), err, methodCtx)
+
+	return resp, data
+}
+
 func (s *HTTPSuite) buildToken(subject string, role string) string {
 	const methodCtx = "handler.HTTPSuite.buildToken"
 
@@ -225,6 +380,10 @@ func (s *HTTPSuite) TestTasksAndCommentsFlow() {
 	const methodCtx = "handler.HTTPSuite.TestTasksAndCommentsFlow"
 
 	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -274,6 +433,10 @@ func (s *HTTPSuite) TestTeamsCreateAndList() {
 	const methodCtx = "handler.HTTPSuite.TestTeamsCreateAndList"
 
 	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -314,6 +477,10 @@ func (s *HTTPSuite) TestInviteAcceptFlow() {
 	const methodCtx = "handler.HTTPSuite.TestInviteAcceptFlow"
 
 	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -335,11 +502,33 @@ func (s *HTTPSuite) TestInviteAcceptFlow() {
 
 	var invite api.Invite
 	require.NoError(s.T(), json.Unmarshal(body, &invite), methodCtx)
-	require.NotEmpty(s.T(), invite.Code, methodCtx)
+	require.NotContains(s.T(), string(body), `"code"`, "код приглашения не возвращается")
+
+	listPath := fmt.Sprintf("/api/v1/teams/%s/invites", teamID.String())
+	resp, body = s.doJSON(http.MethodGet, listPath, ownerToken, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+
+	var invites api.InvitesListResponse
+	require.NoError(s.T(), json.Unmarshal(body, &invites), methodCtx)
+	require.Equal(s.T(), 1, invites.Total, methodCtx)
+	require.Equal(s.T(), invite.Id, invites.Items[0].Id, methodCtx)
+
+	resendPath := fmt.Sprintf("/api/v1/teams/%s/invites/%s/resend", teamID.String(), invite.Id.String())
+	resp, _ = s.doJSON(http.MethodPost, resendPath, ownerToken, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+
+	var code string
+	for _, msg := range s.mailer.Messages() {
+		if msg.To == "invitee@example.com" {
+			line, _, _ := strings.Cut(msg.Body, "\n")
+			code = strings.TrimPrefix(line, "Ваш код приглашения: ")
+		}
+	}
+	require.NotEmpty(s.T(), code, methodCtx)
 
 	inviteeID := s.CreateUser("invitee@example.com")
 	inviteeToken := s.buildToken(inviteeID.String(), "member")
-	acceptReq := api.AcceptInviteRequest{Code: invite.Code}
+	acceptReq := api.AcceptInviteRequest{Code: code}
 	resp, body = s.doJSON(http.MethodPost, "/api/v1/teams/invites/accept", inviteeToken, acceptReq)
 	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
 
@@ -350,15 +539,30 @@ func (s *HTTPSuite) TestInviteAcceptFlow() {
 	require.Equal(s.T(), api.TeamMemberRole("member"), member.Role, methodCtx)
 
 	var count int
-	err := s.DB.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM team_invites WHERE code = ?", invite.Code).Scan(&count)
+	err := s.DB.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM team_invites WHERE id = ?", invite.Id.String()).Scan(&count)
 	require.NoError(s.T(), err, methodCtx)
 	require.Equal(s.T(), 0, count, methodCtx)
+
+	revokeID, _ := s.CreateInvite(teamID, ownerID, "revoked@example.com", "")
+	revokePath := fmt.Sprintf("/api/v1/teams/%s/invites/%s", teamID.String(), revokeID.String())
+	resp, _ = s.doJSON(http.MethodDelete, revokePath, inviteeToken, nil)
+	require.Equal(s.T(), http.StatusForbidden, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodDelete, revokePath, ownerToken, nil)
+	require.Equal(s.T(), http.StatusNoContent, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodDelete, revokePath, ownerToken, nil)
+	require.Equal(s.T(), http.StatusNotFound, resp.StatusCode, methodCtx)
 }
 
 func (s *HTTPSuite) TestTaskUpdateAndHistory() {
 	const methodCtx = "handler.HTTPSuite.TestTaskUpdateAndHistory"
 
 	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -399,13 +603,81 @@ func (s *HTTPSuite) TestTaskUpdateAndHistory() {
 
 	var history api.TaskHistoryListResponse
 	require.NoError(s.T(), json.Unmarshal(body, &history), methodCtx)
-	require.NotEmpty(s.T(), history.Items, methodCtx)
+	require.Equal(s.T(), 2, history.Total, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, historyPath+"?field=status&per_page=1", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.NoError(s.T(), json.Unmarshal(body, &history), methodCtx)
+	require.Len(s.T(), history.Items, 1, methodCtx)
+	require.Equal(s.T(), 2, history.Total, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodGet, historyPath+"?field=unknown", token, nil)
+	require.Equal(s.T(), http.StatusBadRequest, resp.StatusCode, methodCtx)
+}
+
+func (s *HTTPSuite) TestTaskWatchersFlow() {
+	const methodCtx = "handler.HTTPSuite.TestTaskWatchersFlow"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	ownerID := s.CreateUser("watch-owner@example.com")
+	memberID := s.CreateUser("watch-member@example.com")
+	teamID := s.CreateTeam("Watch Team", ownerID)
+	s.AddTeamMember(teamID, ownerID, "owner")
+	s.AddTeamMember(teamID, memberID, "member")
+
+	ownerToken := s.buildToken(ownerID.String(), "owner")
+	memberToken := s.buildToken(memberID.String(), "member")
+
+	taskReq := api.CreateTaskRequest{TeamId: api.UUID(teamID), Title: "Watched"}
+	resp, body := s.doJSON(http.MethodPost, "/api/v1/tasks", ownerToken, taskReq)
+	require.Equal(s.T(), http.StatusCreated, resp.StatusCode, methodCtx)
+
+	var task api.Task
+	require.NoError(s.T(), json.Unmarshal(body, &task), methodCtx)
+
+	watchPath := fmt.Sprintf("/api/v1/tasks/%s/watch", task.Id.String())
+	resp, _ = s.doJSON(http.MethodPost, watchPath, memberToken, nil)
+	require.Equal(s.T(), http.StatusCreated, resp.StatusCode, methodCtx)
+
+	watchersPath := fmt.Sprintf("/api/v1/tasks/%s/watchers", task.Id.String())
+	resp, body = s.doJSON(http.MethodGet, watchersPath, memberToken, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+
+	var list api.TaskWatchersListResponse
+	require.NoError(s.T(), json.Unmarshal(body, &list), methodCtx)
+	require.Len(s.T(), list.Items, 2, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodDelete, watchPath, memberToken, nil)
+	require.Equal(s.T(), http.StatusNoContent, resp.StatusCode, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, watchersPath, ownerToken, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.NoError(s.T(), json.Unmarshal(body, &list), methodCtx)
+	require.Len(s.T(), list.Items, 1, methodCtx)
+	require.Equal(s.T(), ownerID, list.Items[0].UserId, methodCtx)
 }
 
 func (s *HTTPSuite) TestCommentsCRUD() {
 	const methodCtx = "handler.HTTPSuite.TestCommentsCRUD"
 
 	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -465,6 +737,10 @@ func (s *HTTPSuite) TestReportsEndpoints() {
 	const methodCtx = "handler.HTTPSuite.TestReportsEndpoints"
 
 	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -508,6 +784,10 @@ func (s *HTTPSuite) TestErrorMappingInvalidAssignee() {
 	const methodCtx = "handler.HTTPSuite.TestErrorMappingInvalidAssignee"
 
 	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -549,6 +829,10 @@ func (s *HTTPSuite) TestInviteRBAC() {
 	const methodCtx = "handler.HTTPSuite.TestInviteRBAC"
 
 	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -580,3 +864,541 @@ func (s *HTTPSuite) TestProtectedRequiresAuth() {
 	resp, _ := s.doJSON(http.MethodGet, "/api/v1/teams", "", nil)
 	require.Equal(s.T(), http.StatusUnauthorized, resp.StatusCode, methodCtx)
 }
+
+func (s *HTTPSuite) TestAttachmentsFlow() {
+	const methodCtx = "handler.HTTPSuite.TestAttachmentsFlow"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	ownerID := s.CreateUser("attach-owner@example.com")
+	outsiderID := s.CreateUser("attach-outsider@example.com")
+	teamID := s.CreateTeam("Attach Team", ownerID)
+	s.AddTeamMember(teamID, ownerID, "owner")
+	taskID := s.CreateTask(teamID, ownerID, nil, "todo", "Task", "")
+
+	token := s.buildToken(ownerID.String(), "owner")
+	attachmentsPath := fmt.Sprintf("/api/v1/tasks/%s/attachments", taskID.String())
+
+	resp, body := s.doUpload(attachmentsPath, token, "notes.txt", []byte("hello attachments"))
+	require.Equal(s.T(), http.StatusCreated, resp.StatusCode, methodCtx)
+
+	var attachment api.Attachment
+	require.NoError(s.T(), json.Unmarshal(body, &attachment), methodCtx)
+	require.Equal(s.T(), "notes.txt", attachment.FileName, methodCtx)
+	require.Equal(s.T(), "text/plain", attachment.ContentType, methodCtx)
+	require.Equal(s.T(), int64(len("hello attachments")), attachment.SizeBytes, methodCtx)
+
+	downloadPath := fmt.Sprintf("%s/%s", attachmentsPath, attachment.Id.String())
+	resp, body = s.doJSON(http.MethodGet, downloadPath, token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.Equal(s.T(), "hello attachments", string(body), methodCtx)
+	require.Contains(s.T(), resp.Header.Get("Content-Disposition"), "notes.txt", methodCtx)
+
+	resp, _ = s.doUpload(attachmentsPath, token, "big.txt", bytes.Repeat([]byte("a"), 2048))
+	require.Equal(s.T(), http.StatusRequestEntityTooLarge, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doUpload(attachmentsPath, token, "doc.pdf", []byte("%PDF-1.4 test"))
+	require.Equal(s.T(), http.StatusUnsupportedMediaType, resp.StatusCode, methodCtx)
+
+	outsiderToken := s.buildToken(outsiderID.String(), "member")
+	resp, _ = s.doJSON(http.MethodGet, attachmentsPath, outsiderToken, nil)
+	require.Equal(s.T(), http.StatusForbidden, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodDelete, downloadPath, token, nil)
+	require.Equal(s.T(), http.StatusNoContent, resp.StatusCode, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, attachmentsPath, token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var list api.AttachmentsListResponse
+	require.NoError(s.T(), json.Unmarshal(body, &list), methodCtx)
+	require.Len(s.T(), list.Items, 0, methodCtx)
+}
+
+func (s *HTTPSuite) TestWorklogsAndTimesheetFlow() {
+	const methodCtx = "handler.HTTPSuite.TestWorklogsAndTimesheetFlow"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	userID := s.CreateUser("worklog-http@example.com")
+	teamID := s.CreateTeam("Worklog Team", userID)
+	s.AddTeamMember(teamID, userID, "owner")
+	taskID := s.CreateTask(teamID, userID, nil, "todo", "Task", "")
+
+	token := s.buildToken(userID.String(), "owner")
+	worklogsPath := fmt.Sprintf("/api/v1/tasks/%s/worklogs", taskID.String())
+
+	createReq := api.CreateWorklogRequest{StartedAt: time.Now().UTC().Add(-time.Hour), DurationMinutes: 40}
+	resp, _ := s.doJSON(http.MethodPost, worklogsPath, token, createReq)
+	require.Equal(s.T(), http.StatusCreated, resp.StatusCode, methodCtx)
+
+	timerPath := fmt.Sprintf("/api/v1/tasks/%s/timer", taskID.String())
+	resp, _ = s.doJSON(http.MethodPost, timerPath+"/start", token, nil)
+	require.Equal(s.T(), http.StatusCreated, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodPost, timerPath+"/start", token, nil)
+	require.Equal(s.T(), http.StatusConflict, resp.StatusCode, methodCtx)
+
+	resp, body := s.doJSON(http.MethodPost, timerPath+"/stop", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var stopped api.Worklog
+	require.NoError(s.T(), json.Unmarshal(body, &stopped), methodCtx)
+	require.False(s.T(), stopped.Running, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, worklogsPath, token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var list api.WorklogsListResponse
+	require.NoError(s.T(), json.Unmarshal(body, &list), methodCtx)
+	require.Len(s.T(), list.Items, 2, methodCtx)
+	require.Equal(s.T(), 41, list.TotalMinutes, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, "/api/v1/reports/timesheet?team_id="+teamID.String(), token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var report api.TimesheetReport
+	require.NoError(s.T(), json.Unmarshal(body, &report), methodCtx)
+	require.Len(s.T(), report.Items, 1, methodCtx)
+	require.Equal(s.T(), 41, report.Items[0].TotalMinutes, methodCtx)
+}
+
+func (s *HTTPSuite) TestActivityFeed() {
+	const methodCtx = "handler.HTTPSuite.TestActivityFeed"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_member_events",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	userID := s.CreateUser("activity-http@example.com")
+	teamID := s.CreateTeam("Activity Team", userID)
+	s.AddTeamMember(teamID, userID, "owner")
+	s.CreateMemberEvent(teamID, &userID, userID, repomysql.TeamMemberEventJoined, "", "", "owner")
+	taskID := s.CreateTask(teamID, userID, nil, "todo", "Task", "")
+	s.CreateComment(taskID, userID, "first")
+	s.CreateComment(taskID, userID, "second")
+
+	token := s.buildToken(userID.String(), "owner")
+	taskPath := fmt.Sprintf("/api/v1/tasks/%s/activity", taskID.String())
+
+	resp, body := s.doJSON(http.MethodGet, taskPath+"?type=comment&limit=1", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var feed api.ActivityFeedResponse
+	require.NoError(s.T(), json.Unmarshal(body, &feed), methodCtx)
+	require.Len(s.T(), feed.Items, 1, methodCtx)
+	require.NotNil(s.T(), feed.NextCursor, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, taskPath+"?type=comment&limit=1&cursor="+*feed.NextCursor, token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.NoError(s.T(), json.Unmarshal(body, &feed), methodCtx)
+	require.Len(s.T(), feed.Items, 1, methodCtx)
+	require.Nil(s.T(), feed.NextCursor, methodCtx)
+
+	teamPath := fmt.Sprintf("/api/v1/teams/%s/activity", teamID.String())
+	resp, body = s.doJSON(http.MethodGet, teamPath, token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.NoError(s.T(), json.Unmarshal(body, &feed), methodCtx)
+	require.Len(s.T(), feed.Items, 3, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodGet, teamPath+"?type=unknown", token, nil)
+	require.Equal(s.T(), http.StatusBadRequest, resp.StatusCode, methodCtx)
+}
+
+func (s *HTTPSuite) TestTaskImportFlow() {
+	const methodCtx = "handler.HTTPSuite.TestTaskImportFlow"
+
+	s.TruncateTables(
+		"task_imports",
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	userID := s.CreateUser("import-http@example.com")
+	teamID := s.CreateTeam("Import Team", userID)
+	s.AddTeamMember(teamID, userID, "owner")
+
+	token := s.buildToken(userID.String(), "owner")
+	importsPath := fmt.Sprintf("/api/v1/teams/%s/task-imports", teamID.String())
+
+	resp, body := s.doUpload(importsPath+"?format=csv", token, "tasks.csv", []byte("title,status\nImported,todo\n,todo\n"))
+	require.Equal(s.T(), http.StatusAccepted, resp.StatusCode, methodCtx)
+	var job api.TaskImport
+	require.NoError(s.T(), json.Unmarshal(body, &job), methodCtx)
+	require.Equal(s.T(), api.Pending, job.Status, methodCtx)
+
+	require.NoError(s.T(), s.imports.ProcessPending(context.Background()), methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, importsPath+"/"+job.Id.String(), token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.NoError(s.T(), json.Unmarshal(body, &job), methodCtx)
+	require.Equal(s.T(), api.Completed, job.Status, methodCtx)
+	require.Equal(s.T(), 1, job.CreatedRows, methodCtx)
+	require.Len(s.T(), job.Errors, 1, methodCtx)
+
+	resp, _ = s.doUpload(importsPath+"?format=xlsx", token, "tasks.xlsx", []byte("x"))
+	require.Equal(s.T(), http.StatusBadRequest, resp.StatusCode, methodCtx)
+}
+
+func (s *HTTPSuite) TestTaskExport() {
+	const methodCtx = "handler.HTTPSuite.TestTaskExport"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	userID := s.CreateUser("export-http@example.com")
+	outsiderID := s.CreateUser("export-outsider@example.com")
+	teamID := s.CreateTeam("Export Team", userID)
+	s.AddTeamMember(teamID, userID, "owner")
+	taskID := s.CreateTask(teamID, userID, nil, "todo", "Exported", "")
+	s.CreateComment(taskID, userID, "hello")
+
+	token := s.buildToken(userID.String(), "owner")
+	exportPath := fmt.Sprintf("/api/v1/teams/%s/export", teamID.String())
+
+	resp, body := s.doJSON(http.MethodGet, exportPath+"?format=json&include=comments", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.Contains(s.T(), resp.Header.Get("Content-Disposition"), "attachment", methodCtx)
+	var rows []api.TaskExportRow
+	require.NoError(s.T(), json.Unmarshal(body, &rows), methodCtx)
+	require.Len(s.T(), rows, 1, methodCtx)
+	require.NotNil(s.T(), rows[0].Comments, methodCtx)
+	require.Len(s.T(), *rows[0].Comments, 1, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, exportPath+"?format=csv&status=todo", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.Equal(s.T(), "text/csv; charset=utf-8", resp.Header.Get("Content-Type"), methodCtx)
+	require.Contains(s.T(), string(body), "Exported", methodCtx)
+
+	resp, _ = s.doJSON(http.MethodGet, exportPath+"?format=xlsx", token, nil)
+	require.Equal(s.T(), http.StatusBadRequest, resp.StatusCode, methodCtx)
+
+	outsiderToken := s.buildToken(outsiderID.String(), "member")
+	resp, _ = s.doJSON(http.MethodGet, exportPath+"?format=ndjson", outsiderToken, nil)
+	require.Equal(s.T(), http.StatusForbidden, resp.StatusCode, methodCtx)
+}
+
+func (s *HTTPSuite) TestTaskViews() {
+	const methodCtx = "handler.HTTPSuite.TestTaskViews"
+
+	s.TruncateTables(
+		"task_views",
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	userID := s.CreateUser("views-http@example.com")
+	teamID := s.CreateTeam("Views Team", userID)
+	s.AddTeamMember(teamID, userID, "owner")
+	s.CreateTask(teamID, userID, &userID, "todo", "Mine", "")
+	s.CreateTask(teamID, userID, nil, "done", "Closed", "")
+
+	token := s.buildToken(userID.String(), "owner")
+	viewsPath := fmt.Sprintf("/api/v1/teams/%s/views", teamID.String())
+
+	resp, body := s.doJSON(http.MethodPost, viewsPath, token, map[string]interface{}{
+		"name":         "My open",
+		"status":       "todo",
+		"assignee_ids": []string{userID.String()},
+		"sort":         "title_asc",
+	})
+	require.Equal(s.T(), http.StatusCreated, resp.StatusCode, methodCtx)
+	var view api.TaskView
+	require.NoError(s.T(), json.Unmarshal(body, &view), methodCtx)
+
+	resp, _ = s.doJSON(http.MethodPost, viewsPath, token, map[string]interface{}{"name": "My open"})
+	require.Equal(s.T(), http.StatusConflict, resp.StatusCode, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, "/api/v1/tasks?view_id="+view.Id.String(), token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var list api.TasksListResponse
+	require.NoError(s.T(), json.Unmarshal(body, &list), methodCtx)
+	require.Len(s.T(), list.Items, 1, methodCtx)
+	require.Equal(s.T(), "Mine", list.Items[0].Title, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodGet, "/api/v1/tasks", token, nil)
+	require.Equal(s.T(), http.StatusBadRequest, resp.StatusCode, methodCtx)
+
+	resp, body = s.doJSON(http.MethodPut, "/api/v1/views/"+view.Id.String(), token, map[string]interface{}{"shared": true})
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.NoError(s.T(), json.Unmarshal(body, &view), methodCtx)
+	require.True(s.T(), view.Shared, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, viewsPath, token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var views api.TaskViewsListResponse
+	require.NoError(s.T(), json.Unmarshal(body, &views), methodCtx)
+	require.Len(s.T(), views.Items, 1, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodDelete, "/api/v1/views/"+view.Id.String(), token, nil)
+	require.Equal(s.T(), http.StatusNoContent, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodGet, "/api/v1/views/"+view.Id.String(), token, nil)
+	require.Equal(s.T(), http.StatusNotFound, resp.StatusCode, methodCtx)
+}
+
+func (s *HTTPSuite) TestTaskBoardMove() {
+	const methodCtx = "handler.HTTPSuite.TestTaskBoardMove"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	userID := s.CreateUser("board-http@example.com")
+	teamID := s.CreateTeam("Board Team", userID)
+	s.AddTeamMember(teamID, userID, "owner")
+	token := s.buildToken(userID.String(), "owner")
+
+	var ids []string
+	for _, title := range []string{"A", "B"} {
+		resp, body := s.doJSON(http.MethodPost, "/api/v1/tasks", token, map[string]interface{}{
+			"team_id": teamID.String(),
+			"title":   title,
+		})
+		require.Equal(s.T(), http.StatusCreated, resp.StatusCode, methodCtx)
+		var task api.Task
+		require.NoError(s.T(), json.Unmarshal(body, &task), methodCtx)
+		require.NotEmpty(s.T(), task.Rank, methodCtx)
+		ids = append(ids, task.Id.String())
+	}
+
+	resp, _ := s.doJSON(http.MethodPost, "/api/v1/tasks/"+ids[1]+"/move", token, map[string]interface{}{"before_id": ids[0]})
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+
+	resp, body := s.doJSON(http.MethodGet, "/api/v1/tasks?team_id="+teamID.String()+"&sort=rank", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var list api.TasksListResponse
+	require.NoError(s.T(), json.Unmarshal(body, &list), methodCtx)
+	require.Len(s.T(), list.Items, 2, methodCtx)
+	require.Equal(s.T(), "B", list.Items[0].Title, methodCtx)
+	require.Equal(s.T(), "A", list.Items[1].Title, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodPost, "/api/v1/tasks/"+ids[1]+"/move", token, map[string]interface{}{
+		"status":   "done",
+		"after_id": ids[0],
+	})
+	require.Equal(s.T(), http.StatusBadRequest, resp.StatusCode, methodCtx)
+}
+
+func (s *HTTPSuite) TestSprintsFlow() {
+	const methodCtx = "handler.HTTPSuite.TestSprintsFlow"
+
+	s.TruncateTables(
+		"sprints",
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	userID := s.CreateUser("sprints-http@example.com")
+	teamID := s.CreateTeam("Sprints Team", userID)
+	s.AddTeamMember(teamID, userID, "owner")
+	openID := s.CreateTask(teamID, userID, nil, "todo", "Open", "")
+	s.CreateTask(teamID, userID, nil, "todo", "Backlog", "")
+
+	token := s.buildToken(userID.String(), "owner")
+	sprintsPath := fmt.Sprintf("/api/v1/teams/%s/sprints", teamID.String())
+
+	resp, _ := s.doJSON(http.MethodPost, sprintsPath, token, map[string]interface{}{
+		"name":       "Sprint 1",
+		"start_date": "2025-03-17",
+		"end_date":   "2025-03-03",
+	})
+	require.Equal(s.T(), http.StatusBadRequest, resp.StatusCode, methodCtx)
+
+	resp, body := s.doJSON(http.MethodPost, sprintsPath, token, map[string]interface{}{
+		"name":       "Sprint 1",
+		"goal":       "MVP",
+		"start_date": "2025-03-03",
+		"end_date":   "2025-03-17",
+	})
+	require.Equal(s.T(), http.StatusCreated, resp.StatusCode, methodCtx)
+	var sprint api.Sprint
+	require.NoError(s.T(), json.Unmarshal(body, &sprint), methodCtx)
+	require.Equal(s.T(), api.Planned, sprint.State, methodCtx)
+
+	sprintPath := "/api/v1/sprints/" + sprint.Id.String()
+	resp, _ = s.doJSON(http.MethodPost, sprintPath+"/tasks", token, map[string]interface{}{"task_ids": []string{openID.String()}})
+	require.Equal(s.T(), http.StatusNoContent, resp.StatusCode, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, "/api/v1/tasks?team_id="+teamID.String()+"&sprint_id="+sprint.Id.String(), token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var list api.TasksListResponse
+	require.NoError(s.T(), json.Unmarshal(body, &list), methodCtx)
+	require.Len(s.T(), list.Items, 1, methodCtx)
+	require.Equal(s.T(), "Open", list.Items[0].Title, methodCtx)
+	require.NotNil(s.T(), list.Items[0].SprintId, methodCtx)
+
+	resp, body = s.doJSON(http.MethodPost, sprintPath+"/start", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.NoError(s.T(), json.Unmarshal(body, &sprint), methodCtx)
+	require.Equal(s.T(), api.Active, sprint.State, methodCtx)
+
+	resp, body = s.doJSON(http.MethodPost, sprintPath+"/close", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var closed api.CloseSprintResponse
+	require.NoError(s.T(), json.Unmarshal(body, &closed), methodCtx)
+	require.Equal(s.T(), api.Closed, closed.Sprint.State, methodCtx)
+	require.Equal(s.T(), []api.UUID{openID}, closed.MovedTaskIds, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodPost, sprintPath+"/close", token, nil)
+	require.Equal(s.T(), http.StatusConflict, resp.StatusCode, methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, sprintsPath+"?state=closed", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var sprints api.SprintsListResponse
+	require.NoError(s.T(), json.Unmarshal(body, &sprints), methodCtx)
+	require.Len(s.T(), sprints.Items, 1, methodCtx)
+}
+
+func (s *HTTPSuite) TestNotificationSettings() {
+	const methodCtx = "handler.HTTPSuite.TestNotificationSettings"
+
+	s.TruncateTables("user_notification_settings", "users")
+
+	userID := s.CreateUser("notifications-http@example.com")
+	token := s.buildToken(userID.String(), "member")
+
+	resp, body := s.doJSON(http.MethodGet, "/api/v1/me/notifications", token, nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	var settings api.NotificationSettings
+	require.NoError(s.T(), json.Unmarshal(body, &settings), methodCtx)
+	require.True(s.T(), settings.DueReminders, methodCtx)
+
+	resp, body = s.doJSON(http.MethodPut, "/api/v1/me/notifications", token, map[string]interface{}{"due_reminders": false})
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.NoError(s.T(), json.Unmarshal(body, &settings), methodCtx)
+	require.False(s.T(), settings.DueReminders, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodPut, "/api/v1/me/notifications", token, map[string]interface{}{"due_reminders": "no"})
+	require.Equal(s.T(), http.StatusBadRequest, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodGet, "/api/v1/me/notifications", "", nil)
+	require.Equal(s.T(), http.StatusUnauthorized, resp.StatusCode, methodCtx)
+}
+
+func (s *HTTPSuite) TestCalendarFeed() {
+	const methodCtx = "handler.HTTPSuite.TestCalendarFeed"
+
+	s.TruncateTables(
+		"calendar_feeds",
+		"task_assignees",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	userID := s.CreateUser("calendar-http@example.com")
+	teamID := s.CreateTeam("Calendar Team", userID)
+	s.AddTeamMember(teamID, userID, "owner")
+	taskID := s.CreateTask(teamID, userID, &userID, "todo", "Due task", "")
+	_, err := s.DB.ExecContext(context.Background(), "UPDATE tasks SET due_at = ? WHERE id = ?", time.Now().UTC().Add(24*time.Hour), taskID.String())
+	require.NoError(s.T(), err, methodCtx)
+
+	token := s.buildToken(userID.String(), "member")
+
+	resp, body := s.doJSON(http.MethodPost, "/api/v1/me/calendar-feed", token, nil)
+	require.Equal(s.T(), http.StatusCreated, resp.StatusCode, methodCtx)
+	var feed api.CalendarFeedToken
+	require.NoError(s.T(), json.Unmarshal(body, &feed), methodCtx)
+	feedPath := "/api/v1/calendar/" + feed.Token + "/tasks.ics"
+	require.True(s.T(), strings.HasSuffix(feed.Url, feedPath), methodCtx)
+
+	resp, body = s.doJSON(http.MethodGet, feedPath+"?component=vtodo", "", nil)
+	require.Equal(s.T(), http.StatusOK, resp.StatusCode, methodCtx)
+	require.Equal(s.T(), "text/calendar; charset=utf-8", resp.Header.Get("Content-Type"), methodCtx)
+	require.Contains(s.T(), string(body), "UID:"+taskID.String()+"@mkk-tasks", methodCtx)
+	require.Contains(s.T(), string(body), "BEGIN:VTODO", methodCtx)
+
+	resp, _ = s.doJSON(http.MethodGet, feedPath+"?component=vjournal", "", nil)
+	require.Equal(s.T(), http.StatusBadRequest, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodDelete, "/api/v1/me/calendar-feed", token, nil)
+	require.Equal(s.T(), http.StatusNoContent, resp.StatusCode, methodCtx)
+
+	resp, _ = s.doJSON(http.MethodGet, feedPath, "", nil)
+	require.Equal(s.T(), http.StatusNotFound, resp.StatusCode, "отозванный токен не дает доступа")
+
+	resp, _ = s.doJSON(http.MethodGet, "/api/v1/me/calendar-feed", "", nil)
+	require.Equal(s.T(), http.StatusUnauthorized, resp.StatusCode, methodCtx)
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// PostApiV1TeamsIdTaskImports принимает файл импорта задач и ставит задание в очередь.
+func (h *Handler) PostApiV1TeamsIdTaskImports(c *gin.Context, id api.TeamId, params api.PostApiV1TeamsIdTaskImportsParams) {
+	const methodCtx = "handler.PostApiV1TeamsIdTaskImports"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: fmt.Sprintf("%s: ожидается multipart/form-data", methodCtx)})
+		return
+	}
+
+	dryRun := params.DryRun != nil && *params.DryRun
+	mapping := ""
+	if params.Mapping != nil {
+		mapping = *params.Mapping
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: fmt.Sprintf("%s: файл не передан", methodCtx)})
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: fmt.Sprintf("%s: ошибка разбора запроса", methodCtx)})
+			return
+		}
+		if part.FormName() != attachmentFormField {
+			_ = part.Close()
+			continue
+		}
+
+		resp, err := h.imports.Submit(c.Request.Context(), userID, id, params.Format, dryRun, mapping, part)
+		_ = part.Close()
+		if err != nil {
+			writeError(c, err, methodCtx)
+			return
+		}
+
+		c.JSON(http.StatusAccepted, resp)
+		return
+	}
+}
+
+// GetApiV1TeamsIdTaskImportsImportId возвращает статус и отчет задания импорта.
+func (h *Handler) GetApiV1TeamsIdTaskImportsImportId(c *gin.Context, id api.TeamId, importId api.ImportId) {
+	const methodCtx = "handler.GetApiV1TeamsIdTaskImportsImportId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.imports.Get(c.Request.Context(), userID, id, importId)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// GetApiV1MeNotifications возвращает настройки уведомлений текущего пользователя.
+func (h *Handler) GetApiV1MeNotifications(c *gin.Context) {
+	const methodCtx = "handler.GetApiV1MeNotifications"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.reminders.Settings(c.Request.Context(), userID)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PutApiV1MeNotifications изменяет настройки уведомлений текущего пользователя.
+func (h *Handler) PutApiV1MeNotifications(c *gin.Context) {
+	const methodCtx = "handler.PutApiV1MeNotifications"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.UpdateNotificationSettingsRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.reminders.UpdateSettings(c.Request.Context(), userID, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
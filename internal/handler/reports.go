@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"github.com/Seraf-seraf/mkk_test/internal/api"
 )
@@ -73,3 +74,109 @@ func (h *Handler) GetApiV1ReportsInvalidAssignees(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// PostApiV1TeamsIdInvalidAssigneesRepair исправляет исполнителей, не состоящих в команде.
+func (h *Handler) PostApiV1TeamsIdInvalidAssigneesRepair(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.PostApiV1TeamsIdInvalidAssigneesRepair"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.RepairAssigneesRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.tasks.RepairAssignees(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApiV1ReportsTimesheet возвращает табель учета времени.
+func (h *Handler) GetApiV1ReportsTimesheet(c *gin.Context, params api.GetApiV1ReportsTimesheetParams) {
+	const methodCtx = "handler.GetApiV1ReportsTimesheet"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if params.From != nil {
+		from = params.From.Time
+	}
+	to := now
+	if params.To != nil {
+		to = params.To.Time
+	}
+
+	var teamID *uuid.UUID
+	if params.TeamId != nil {
+		value := uuid.UUID(*params.TeamId)
+		teamID = &value
+	}
+	var targetUserID *uuid.UUID
+	if params.UserId != nil {
+		value := uuid.UUID(*params.UserId)
+		targetUserID = &value
+	}
+
+	resp, err := h.reports.Timesheet(c.Request.Context(), userID, from, to, teamID, targetUserID)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApiV1ReportsVelocity возвращает скорость команд в story points.
+func (h *Handler) GetApiV1ReportsVelocity(c *gin.Context, params api.GetApiV1ReportsVelocityParams) {
+	const methodCtx = "handler.GetApiV1ReportsVelocity"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	groupBy := api.VelocityGroupByWeek
+	if params.GroupBy != nil {
+		groupBy = *params.GroupBy
+	}
+
+	now := time.Now().UTC()
+	to := now
+	if params.To != nil {
+		to = params.To.Time
+	}
+	monday := now.AddDate(0, 0, -(int(now.Weekday())+6)%7)
+	from := time.Date(monday.Year(), monday.Month(), monday.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -7*11)
+	if params.From != nil {
+		from = params.From.Time
+	}
+
+	var teamID *uuid.UUID
+	if params.TeamId != nil {
+		value := uuid.UUID(*params.TeamId)
+		teamID = &value
+	}
+
+	resp, err := h.reports.Velocity(c.Request.Context(), userID, groupBy, from, to, teamID)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// GetApiV1TeamsIdSprints возвращает спринты команды.
+func (h *Handler) GetApiV1TeamsIdSprints(c *gin.Context, id api.TeamId, params api.GetApiV1TeamsIdSprintsParams) {
+	const methodCtx = "handler.GetApiV1TeamsIdSprints"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.sprints.List(c.Request.Context(), userID, id, params)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1TeamsIdSprints создает спринт команды.
+func (h *Handler) PostApiV1TeamsIdSprints(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.PostApiV1TeamsIdSprints"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.CreateSprintRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.sprints.Create(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetApiV1SprintsSprintId возвращает спринт.
+func (h *Handler) GetApiV1SprintsSprintId(c *gin.Context, sprintId api.SprintId) {
+	const methodCtx = "handler.GetApiV1SprintsSprintId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.sprints.Get(c.Request.Context(), userID, sprintId)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PutApiV1SprintsSprintId изменяет спринт.
+func (h *Handler) PutApiV1SprintsSprintId(c *gin.Context, sprintId api.SprintId) {
+	const methodCtx = "handler.PutApiV1SprintsSprintId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.UpdateSprintRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.sprints.Update(c.Request.Context(), userID, sprintId, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1SprintsSprintIdStart начинает спринт.
+func (h *Handler) PostApiV1SprintsSprintIdStart(c *gin.Context, sprintId api.SprintId) {
+	const methodCtx = "handler.PostApiV1SprintsSprintIdStart"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.sprints.Start(c.Request.Context(), userID, sprintId)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1SprintsSprintIdClose закрывает спринт. Тело запроса необязательно.
+func (h *Handler) PostApiV1SprintsSprintIdClose(c *gin.Context, sprintId api.SprintId) {
+	const methodCtx = "handler.PostApiV1SprintsSprintIdClose"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.CloseSprintRequest
+	if c.Request.ContentLength != 0 {
+		if err := bindJSON(c, &req, methodCtx); err != nil {
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.sprints.Close(c.Request.Context(), userID, sprintId, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1SprintsSprintIdTasks добавляет задачи в спринт.
+func (h *Handler) PostApiV1SprintsSprintIdTasks(c *gin.Context, sprintId api.SprintId) {
+	const methodCtx = "handler.PostApiV1SprintsSprintIdTasks"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.SprintTasksRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.sprints.AddTasks(c.Request.Context(), userID, sprintId, req); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteApiV1SprintsSprintIdTasksTaskId возвращает задачу из спринта в бэклог.
+func (h *Handler) DeleteApiV1SprintsSprintIdTasksTaskId(c *gin.Context, sprintId api.SprintId, taskId api.UUID) {
+	const methodCtx = "handler.DeleteApiV1SprintsSprintIdTasksTaskId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.sprints.RemoveTask(c.Request.Context(), userID, sprintId, taskId); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -4,7 +4,6 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 
 	"github.com/Seraf-seraf/mkk_test/internal/api"
 )
@@ -19,24 +18,26 @@ func (h *Handler) GetApiV1Tasks(c *gin.Context, params api.GetApiV1TasksParams)
 		return
 	}
 
-	teamID := params.TeamId
-
-	var assigneeID *uuid.UUID
-	if params.AssigneeId != nil {
-		value := *params.AssigneeId
-		assigneeID = &value
+	resp, err := h.tasks.List(c.Request.Context(), userID, params)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
 	}
 
-	page := 0
-	if params.Page != nil {
-		page = *params.Page
-	}
-	perPage := 0
-	if params.PerPage != nil {
-		perPage = *params.PerPage
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApiV1MeTasks возвращает задачи текущего пользователя во всех его командах.
+func (h *Handler) GetApiV1MeTasks(c *gin.Context, params api.GetApiV1MeTasksParams) {
+	const methodCtx = "handler.GetApiV1MeTasks"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
 	}
 
-	resp, err := h.tasks.List(c.Request.Context(), userID, teamID, params.Status, assigneeID, page, perPage)
+	resp, err := h.tasks.ListMine(c.Request.Context(), userID, params)
 	if err != nil {
 		writeError(c, err, methodCtx)
 		return
@@ -95,8 +96,79 @@ func (h *Handler) PutApiV1TasksId(c *gin.Context, id api.TaskId) {
 	c.JSON(http.StatusOK, resp)
 }
 
-// GetApiV1TasksIdHistory возвращает историю задачи.
-func (h *Handler) GetApiV1TasksIdHistory(c *gin.Context, id api.TaskId) {
+// PostApiV1TasksIdMove переносит задачу в другую команду.
+func (h *Handler) PostApiV1TasksIdMove(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.PostApiV1TasksIdMove"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.MoveTaskRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.tasks.Move(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1TasksIdClone создает копию задачи.
+func (h *Handler) PostApiV1TasksIdClone(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.PostApiV1TasksIdClone"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.CloneTaskRequest
+	if c.Request.ContentLength != 0 {
+		if err := bindJSON(c, &req, methodCtx); err != nil {
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.tasks.Clone(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetApiV1TasksIdLinks возвращает связи задачи.
+func (h *Handler) GetApiV1TasksIdLinks(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.GetApiV1TasksIdLinks"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.tasks.Links(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApiV1TasksIdHistory возвращает историю задачи с фильтрами и пагинацией.
+func (h *Handler) GetApiV1TasksIdHistory(c *gin.Context, id api.TaskId, params api.GetApiV1TasksIdHistoryParams) {
 	const methodCtx = "handler.GetApiV1TasksIdHistory"
 
 	userID, err := getUserID(c)
@@ -105,7 +177,26 @@ func (h *Handler) GetApiV1TasksIdHistory(c *gin.Context, id api.TaskId) {
 		return
 	}
 
-	resp, err := h.tasks.History(c.Request.Context(), userID, id)
+	resp, err := h.tasks.History(c.Request.Context(), userID, id, params)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1TasksIdHistoryHistoryIdRevert откатывает изменение задачи из истории.
+func (h *Handler) PostApiV1TasksIdHistoryHistoryIdRevert(c *gin.Context, id api.TaskId, historyId api.HistoryId) {
+	const methodCtx = "handler.PostApiV1TasksIdHistoryHistoryIdRevert"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.tasks.Revert(c.Request.Context(), userID, id, historyId)
 	if err != nil {
 		writeError(c, err, methodCtx)
 		return
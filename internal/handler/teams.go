@@ -77,6 +77,150 @@ func (h *Handler) PostApiV1TeamsIdInvite(c *gin.Context, id api.TeamId) {
 	c.JSON(http.StatusCreated, resp)
 }
 
+// GetApiV1TeamsIdInvites возвращает действующие приглашения команды.
+func (h *Handler) GetApiV1TeamsIdInvites(c *gin.Context, id api.TeamId, params api.GetApiV1TeamsIdInvitesParams) {
+	const methodCtx = "handler.GetApiV1TeamsIdInvites"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.ListInvites(c.Request.Context(), userID, id, params)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteApiV1TeamsIdInvitesInviteId отзывает приглашение.
+func (h *Handler) DeleteApiV1TeamsIdInvitesInviteId(c *gin.Context, id api.TeamId, inviteId api.InviteId) {
+	const methodCtx = "handler.DeleteApiV1TeamsIdInvitesInviteId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.teams.RevokeInvite(c.Request.Context(), userID, id, inviteId); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PostApiV1TeamsIdInvitesInviteIdResend повторно отправляет приглашение с новым кодом.
+func (h *Handler) PostApiV1TeamsIdInvitesInviteIdResend(c *gin.Context, id api.TeamId, inviteId api.InviteId) {
+	const methodCtx = "handler.PostApiV1TeamsIdInvitesInviteIdResend"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.ResendInvite(c.Request.Context(), userID, id, inviteId)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApiV1TeamsIdTaskPolicy возвращает политику редактирования задач команды.
+func (h *Handler) GetApiV1TeamsIdTaskPolicy(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.GetApiV1TeamsIdTaskPolicy"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.GetTaskPolicy(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PutApiV1TeamsIdTaskPolicy изменяет политику редактирования задач команды.
+func (h *Handler) PutApiV1TeamsIdTaskPolicy(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.PutApiV1TeamsIdTaskPolicy"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.UpdateTeamTaskPolicyRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.UpdateTaskPolicy(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApiV1TeamsIdArchivePolicy возвращает политику архивации завершенных задач команды.
+func (h *Handler) GetApiV1TeamsIdArchivePolicy(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.GetApiV1TeamsIdArchivePolicy"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.GetArchivePolicy(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PutApiV1TeamsIdArchivePolicy изменяет политику архивации завершенных задач команды.
+func (h *Handler) PutApiV1TeamsIdArchivePolicy(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.PutApiV1TeamsIdArchivePolicy"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.UpdateTeamArchivePolicyRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.UpdateArchivePolicy(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 // PostApiV1TeamsInvitesAccept принимает приглашение.
 func (h *Handler) PostApiV1TeamsInvitesAccept(c *gin.Context) {
 	const methodCtx = "handler.PostApiV1TeamsInvitesAccept"
@@ -101,3 +245,190 @@ func (h *Handler) PostApiV1TeamsInvitesAccept(c *gin.Context) {
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// GetApiV1TeamsId возвращает команду.
+func (h *Handler) GetApiV1TeamsId(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.GetApiV1TeamsId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.GetTeam(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PatchApiV1TeamsId переименовывает команду.
+func (h *Handler) PatchApiV1TeamsId(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.PatchApiV1TeamsId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.UpdateTeamRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.UpdateTeam(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteApiV1TeamsId помечает команду удаленной.
+func (h *Handler) DeleteApiV1TeamsId(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.DeleteApiV1TeamsId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.DeleteTeam(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1TeamsIdRestore восстанавливает удаленную команду.
+func (h *Handler) PostApiV1TeamsIdRestore(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.PostApiV1TeamsIdRestore"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.RestoreTeam(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1TeamsIdTransfer передает владение командой другому участнику.
+func (h *Handler) PostApiV1TeamsIdTransfer(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.PostApiV1TeamsIdTransfer"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.TransferTeamRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.TransferOwnership(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApiV1TeamsIdMembers возвращает участников команды.
+func (h *Handler) GetApiV1TeamsIdMembers(c *gin.Context, id api.TeamId, params api.GetApiV1TeamsIdMembersParams) {
+	const methodCtx = "handler.GetApiV1TeamsIdMembers"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.ListMembers(c.Request.Context(), userID, id, params)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PatchApiV1TeamsIdMembersUserId изменяет роль участника команды.
+func (h *Handler) PatchApiV1TeamsIdMembersUserId(c *gin.Context, id api.TeamId, userId api.MemberUserId) {
+	const methodCtx = "handler.PatchApiV1TeamsIdMembersUserId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.UpdateMemberRoleRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.teams.UpdateMemberRole(c.Request.Context(), userID, id, userId, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteApiV1TeamsIdMembersUserId исключает участника из команды.
+func (h *Handler) DeleteApiV1TeamsIdMembersUserId(c *gin.Context, id api.TeamId, userId api.MemberUserId, params api.DeleteApiV1TeamsIdMembersUserIdParams) {
+	const methodCtx = "handler.DeleteApiV1TeamsIdMembersUserId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.teams.RemoveMember(c.Request.Context(), userID, id, userId, params); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PostApiV1TeamsIdLeave исключает пользователя из команды по его запросу.
+func (h *Handler) PostApiV1TeamsIdLeave(c *gin.Context, id api.TeamId, params api.PostApiV1TeamsIdLeaveParams) {
+	const methodCtx = "handler.PostApiV1TeamsIdLeave"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.teams.LeaveTeam(c.Request.Context(), userID, id, params); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
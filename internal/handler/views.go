@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// GetApiV1TeamsIdViews возвращает представления команды, доступные пользователю.
+func (h *Handler) GetApiV1TeamsIdViews(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.GetApiV1TeamsIdViews"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.views.List(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1TeamsIdViews сохраняет представление списка задач.
+func (h *Handler) PostApiV1TeamsIdViews(c *gin.Context, id api.TeamId) {
+	const methodCtx = "handler.PostApiV1TeamsIdViews"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.CreateTaskViewRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.views.Create(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetApiV1ViewsViewId возвращает представление.
+func (h *Handler) GetApiV1ViewsViewId(c *gin.Context, viewId api.ViewId) {
+	const methodCtx = "handler.GetApiV1ViewsViewId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.views.Get(c.Request.Context(), userID, viewId)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PutApiV1ViewsViewId изменяет представление.
+func (h *Handler) PutApiV1ViewsViewId(c *gin.Context, viewId api.ViewId) {
+	const methodCtx = "handler.PutApiV1ViewsViewId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.UpdateTaskViewRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.views.Update(c.Request.Context(), userID, viewId, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteApiV1ViewsViewId удаляет представление.
+func (h *Handler) DeleteApiV1ViewsViewId(c *gin.Context, viewId api.ViewId) {
+	const methodCtx = "handler.DeleteApiV1ViewsViewId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.views.Delete(c.Request.Context(), userID, viewId); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
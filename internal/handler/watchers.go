@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// GetApiV1TasksIdWatchers возвращает наблюдателей задачи.
+func (h *Handler) GetApiV1TasksIdWatchers(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.GetApiV1TasksIdWatchers"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.watchers.List(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1TasksIdWatch подписывает пользователя на задачу.
+func (h *Handler) PostApiV1TasksIdWatch(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.PostApiV1TasksIdWatch"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.watchers.Watch(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// DeleteApiV1TasksIdWatch отписывает пользователя от задачи.
+func (h *Handler) DeleteApiV1TasksIdWatch(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.DeleteApiV1TasksIdWatch"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.watchers.Unwatch(c.Request.Context(), userID, id); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// GetApiV1TasksIdWorklogs возвращает записи о затраченном времени.
+func (h *Handler) GetApiV1TasksIdWorklogs(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.GetApiV1TasksIdWorklogs"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.worklogs.List(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostApiV1TasksIdWorklogs добавляет запись о затраченном времени.
+func (h *Handler) PostApiV1TasksIdWorklogs(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.PostApiV1TasksIdWorklogs"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.CreateWorklogRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.worklogs.Create(c.Request.Context(), userID, id, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// PutApiV1TasksIdWorklogsWorklogId обновляет запись о затраченном времени.
+func (h *Handler) PutApiV1TasksIdWorklogsWorklogId(c *gin.Context, id api.TaskId, worklogId api.WorklogId) {
+	const methodCtx = "handler.PutApiV1TasksIdWorklogsWorklogId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.UpdateWorklogRequest
+	if err := bindJSON(c, &req, methodCtx); err != nil {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.worklogs.Update(c.Request.Context(), userID, id, worklogId, req)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteApiV1TasksIdWorklogsWorklogId удаляет запись о затраченном времени.
+func (h *Handler) DeleteApiV1TasksIdWorklogsWorklogId(c *gin.Context, id api.TaskId, worklogId api.WorklogId) {
+	const methodCtx = "handler.DeleteApiV1TasksIdWorklogsWorklogId"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.worklogs.Delete(c.Request.Context(), userID, id, worklogId); err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PostApiV1TasksIdTimerStart запускает таймер по задаче.
+func (h *Handler) PostApiV1TasksIdTimerStart(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.PostApiV1TasksIdTimerStart"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	resp, err := h.worklogs.StartTimer(c.Request.Context(), userID, id)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// PostApiV1TasksIdTimerStop останавливает таймер по задаче. Тело запроса необязательно.
+func (h *Handler) PostApiV1TasksIdTimerStop(c *gin.Context, id api.TaskId) {
+	const methodCtx = "handler.PostApiV1TasksIdTimerStop"
+
+	userID, err := getUserID(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, api.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var req api.StopTimerRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Error: methodCtx + ": ошибка разбора запроса"})
+		return
+	}
+
+	resp, err := h.worklogs.StopTimer(c.Request.Context(), userID, id, req.Note)
+	if err != nil {
+		writeError(c, err, methodCtx)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE task_watchers (
+  task_id CHAR(36) NOT NULL,
+  user_id CHAR(36) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (task_id, user_id),
+  CONSTRAINT fk_task_watchers_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_watchers_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_task_watchers_user ON task_watchers (user_id);
+
+INSERT IGNORE INTO task_watchers (task_id, user_id, created_at)
+SELECT id, created_by, created_at FROM tasks;
+
+INSERT IGNORE INTO task_watchers (task_id, user_id, created_at)
+SELECT id, assignee_id, created_at FROM tasks WHERE assignee_id IS NOT NULL;
+
+INSERT IGNORE INTO task_watchers (task_id, user_id, created_at)
+SELECT task_id, user_id, MIN(created_at) FROM task_comments GROUP BY task_id, user_id;
+
+-- +goose Down
+DROP TABLE IF EXISTS task_watchers;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE task_attachments (
+  id CHAR(36) NOT NULL,
+  task_id CHAR(36) NULL,
+  comment_id CHAR(36) NULL,
+  target ENUM('task', 'comment') NOT NULL DEFAULT 'task',
+  uploaded_by CHAR(36) NOT NULL,
+  file_name VARCHAR(255) NOT NULL,
+  content_type VARCHAR(255) NOT NULL,
+  size_bytes BIGINT NOT NULL,
+  storage_key VARCHAR(255) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_task_attachments_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL,
+  CONSTRAINT fk_task_attachments_comment FOREIGN KEY (comment_id) REFERENCES task_comments(id) ON DELETE SET NULL,
+  CONSTRAINT fk_task_attachments_uploaded_by FOREIGN KEY (uploaded_by) REFERENCES users(id) ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_task_attachments_task_created ON task_attachments (task_id, created_at);
+CREATE INDEX idx_task_attachments_comment ON task_attachments (comment_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_attachments;
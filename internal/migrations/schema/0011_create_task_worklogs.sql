@@ -0,0 +1,25 @@
+-- +goose Up
+ALTER TABLE tasks ADD COLUMN estimate_minutes INT UNSIGNED NULL AFTER assignee_id;
+
+CREATE TABLE task_worklogs (
+  id CHAR(36) NOT NULL,
+  task_id CHAR(36) NOT NULL,
+  user_id CHAR(36) NOT NULL,
+  started_at DATETIME NOT NULL,
+  duration_minutes INT UNSIGNED NULL,
+  note TEXT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  running_user_id CHAR(36) AS (IF(duration_minutes IS NULL, user_id, NULL)) STORED,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_task_worklogs_running_user (running_user_id),
+  CONSTRAINT fk_task_worklogs_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_worklogs_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_task_worklogs_task_started ON task_worklogs (task_id, started_at);
+CREATE INDEX idx_task_worklogs_user_started ON task_worklogs (user_id, started_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_worklogs;
+ALTER TABLE tasks DROP COLUMN estimate_minutes;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN task_edit_policy VARCHAR(32) NOT NULL DEFAULT 'assignee_status' AFTER created_by;
+
+-- +goose Down
+ALTER TABLE teams DROP COLUMN task_edit_policy;
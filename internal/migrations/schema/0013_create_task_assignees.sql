@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE task_assignees (
+  task_id CHAR(36) NOT NULL,
+  user_id CHAR(36) NOT NULL,
+  is_primary TINYINT(1) NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (task_id, user_id),
+  CONSTRAINT fk_task_assignees_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_assignees_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_task_assignees_user ON task_assignees (user_id);
+
+INSERT IGNORE INTO task_assignees (task_id, user_id, is_primary, created_at)
+SELECT id, assignee_id, 1, created_at FROM tasks WHERE assignee_id IS NOT NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS task_assignees;
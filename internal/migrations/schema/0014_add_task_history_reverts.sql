@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE task_history ADD COLUMN reverts_id CHAR(36) NULL AFTER changes;
+ALTER TABLE task_history ADD CONSTRAINT fk_task_history_reverts FOREIGN KEY (reverts_id) REFERENCES task_history(id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE task_history DROP FOREIGN KEY fk_task_history_reverts;
+ALTER TABLE task_history DROP COLUMN reverts_id;
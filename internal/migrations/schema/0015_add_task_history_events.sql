@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE task_history
+  ADD COLUMN event VARCHAR(32) NOT NULL DEFAULT 'updated' AFTER changed_by,
+  ADD COLUMN schema_version SMALLINT UNSIGNED NOT NULL DEFAULT 1 AFTER changes;
+
+UPDATE task_history SET event = 'moved' WHERE JSON_CONTAINS_PATH(changes, 'one', '$.team_id');
+UPDATE task_history SET event = 'reverted' WHERE reverts_id IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE task_history DROP COLUMN schema_version, DROP COLUMN event;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE task_imports (
+  id CHAR(36) NOT NULL,
+  team_id CHAR(36) NOT NULL,
+  created_by CHAR(36) NOT NULL,
+  format ENUM('csv', 'ndjson') NOT NULL,
+  mapping JSON NULL,
+  dry_run TINYINT(1) NOT NULL DEFAULT 0,
+  status ENUM('pending', 'running', 'completed', 'failed') NOT NULL DEFAULT 'pending',
+  payload MEDIUMBLOB NOT NULL,
+  total_rows INT UNSIGNED NOT NULL DEFAULT 0,
+  processed_rows INT UNSIGNED NOT NULL DEFAULT 0,
+  created_rows INT UNSIGNED NOT NULL DEFAULT 0,
+  row_errors JSON NULL,
+  error_message TEXT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  started_at DATETIME NULL,
+  finished_at DATETIME NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_task_imports_team FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_imports_created_by FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_task_imports_status_created ON task_imports (status, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_imports;
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE task_views (
+  id CHAR(36) NOT NULL,
+  owner_id CHAR(36) NOT NULL,
+  team_id CHAR(36) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  status ENUM('todo', 'in_progress', 'done') NULL,
+  assignee_ids JSON NULL,
+  sort VARCHAR(32) NOT NULL DEFAULT 'created_at_desc',
+  shared TINYINT(1) NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_task_views_owner_team_name (owner_id, team_id, name),
+  CONSTRAINT fk_task_views_owner FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_views_team FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_task_views_team_shared ON task_views (team_id, shared);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_views;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- rank — строка в стиле LexoRank (цифры base36), сравнивается побайтно.
+ALTER TABLE tasks ADD COLUMN `rank` VARCHAR(64) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '' AFTER status;
+
+UPDATE tasks t
+JOIN (
+  SELECT id, ROW_NUMBER() OVER (PARTITION BY team_id, status ORDER BY created_at, id) AS n
+  FROM tasks
+) ordered ON ordered.id = t.id
+SET t.`rank` = TRIM(TRAILING '0' FROM LPAD(LOWER(CONV(ordered.n * 1024, 10, 36)), 6, '0'));
+
+CREATE INDEX idx_tasks_team_status_rank ON tasks (team_id, status, `rank`);
+
+-- +goose Down
+DROP INDEX idx_tasks_team_status_rank ON tasks;
+ALTER TABLE tasks DROP COLUMN `rank`;
@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE sprints (
+  id CHAR(36) NOT NULL,
+  team_id CHAR(36) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  goal TEXT NULL,
+  start_date DATE NOT NULL,
+  end_date DATE NOT NULL,
+  state ENUM('planned', 'active', 'closed') NOT NULL DEFAULT 'planned',
+  created_by CHAR(36) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at DATETIME NULL,
+  closed_at DATETIME NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT fk_sprints_team FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE,
+  CONSTRAINT fk_sprints_created_by FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_sprints_team_state ON sprints (team_id, state, start_date);
+
+ALTER TABLE tasks ADD COLUMN sprint_id CHAR(36) NULL AFTER `rank`,
+  ADD CONSTRAINT fk_tasks_sprint FOREIGN KEY (sprint_id) REFERENCES sprints(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_tasks_team_sprint ON tasks (team_id, sprint_id);
+
+-- +goose Down
+DROP INDEX idx_tasks_team_sprint ON tasks;
+ALTER TABLE tasks DROP FOREIGN KEY fk_tasks_sprint;
+ALTER TABLE tasks DROP COLUMN sprint_id;
+DROP TABLE IF EXISTS sprints;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE tasks ADD COLUMN story_points INT UNSIGNED NULL AFTER estimate_minutes;
+
+-- +goose Down
+ALTER TABLE tasks DROP COLUMN story_points;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE tasks ADD COLUMN due_at DATETIME NULL AFTER story_points;
+
+CREATE INDEX idx_tasks_status_due ON tasks (status, due_at);
+
+-- +goose Down
+DROP INDEX idx_tasks_status_due ON tasks;
+ALTER TABLE tasks DROP COLUMN due_at;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Отправленные напоминания: по одному на задачу, порог и срок. После переноса срока напоминания отправляются заново.
+CREATE TABLE task_reminders (
+  task_id CHAR(36) NOT NULL,
+  threshold VARCHAR(32) NOT NULL,
+  due_at DATETIME NOT NULL,
+  sent_at DATETIME NOT NULL,
+  PRIMARY KEY (task_id, threshold, due_at),
+  CONSTRAINT fk_task_reminders_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Отсутствие строки означает настройки по умолчанию (все уведомления включены).
+CREATE TABLE user_notification_settings (
+  user_id CHAR(36) NOT NULL,
+  due_reminders TINYINT(1) NOT NULL DEFAULT 1,
+  updated_at DATETIME NOT NULL,
+  PRIMARY KEY (user_id),
+  CONSTRAINT fk_user_notification_settings_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +goose Down
+DROP TABLE IF EXISTS user_notification_settings;
+DROP TABLE IF EXISTS task_reminders;
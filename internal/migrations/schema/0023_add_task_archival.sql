@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN archive_after_days INT UNSIGNED NULL AFTER task_edit_policy;
+ALTER TABLE tasks ADD COLUMN archived_at DATETIME NULL AFTER completed_at;
+
+CREATE INDEX idx_tasks_team_archive ON tasks (team_id, status, archived_at, completed_at);
+
+-- +goose Down
+DROP INDEX idx_tasks_team_archive ON tasks;
+ALTER TABLE tasks DROP COLUMN archived_at;
+ALTER TABLE teams DROP COLUMN archive_after_days;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE task_links (
+  task_id CHAR(36) NOT NULL,
+  linked_task_id CHAR(36) NOT NULL,
+  link_type VARCHAR(32) NOT NULL,
+  created_by CHAR(36) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (task_id, linked_task_id, link_type),
+  CONSTRAINT fk_task_links_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_links_linked FOREIGN KEY (linked_task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+  CONSTRAINT fk_task_links_created_by FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE INDEX idx_task_links_linked ON task_links (linked_task_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_links;
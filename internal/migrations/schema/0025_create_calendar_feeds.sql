@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE calendar_feeds (
+  user_id CHAR(36) NOT NULL,
+  token_hash CHAR(64) NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id),
+  UNIQUE KEY uq_calendar_feeds_token (token_hash),
+  CONSTRAINT fk_calendar_feeds_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +goose Down
+DROP TABLE IF EXISTS calendar_feeds;
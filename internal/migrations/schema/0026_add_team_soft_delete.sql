@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE teams
+  ADD COLUMN deleted_at DATETIME NULL AFTER archive_after_days,
+  ADD COLUMN deleted_by CHAR(36) NULL AFTER deleted_at;
+
+CREATE INDEX idx_teams_deleted_at ON teams (deleted_at);
+
+-- +goose Down
+DROP INDEX idx_teams_deleted_at ON teams;
+ALTER TABLE teams DROP COLUMN deleted_by;
+ALTER TABLE teams DROP COLUMN deleted_at;
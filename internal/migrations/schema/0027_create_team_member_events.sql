@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE team_member_events (
+  id CHAR(36) NOT NULL,
+  team_id CHAR(36) NOT NULL,
+  user_id CHAR(36) NOT NULL,
+  actor_id CHAR(36) NOT NULL,
+  event VARCHAR(32) NOT NULL,
+  old_role VARCHAR(16) NULL,
+  new_role VARCHAR(16) NULL,
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (id),
+  KEY idx_team_member_events_team_created (team_id, created_at),
+  CONSTRAINT fk_team_member_events_team FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +goose Down
+DROP TABLE IF EXISTS team_member_events;
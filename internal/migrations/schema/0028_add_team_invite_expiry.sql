@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE team_invites
+  ADD COLUMN expires_at DATETIME NULL AFTER created_at;
+
+UPDATE team_invites SET code = SHA2(code, 256), expires_at = created_at + INTERVAL 3 DAY;
+
+ALTER TABLE team_invites
+  DROP INDEX uq_team_invites_code,
+  CHANGE COLUMN code code_hash CHAR(64) NOT NULL,
+  MODIFY COLUMN expires_at DATETIME NOT NULL,
+  ADD UNIQUE KEY uq_team_invites_code_hash (code_hash);
+
+CREATE INDEX idx_team_invites_expires_at ON team_invites (expires_at);
+
+-- +goose Down
+DROP INDEX idx_team_invites_expires_at ON team_invites;
+ALTER TABLE team_invites
+  DROP INDEX uq_team_invites_code_hash,
+  CHANGE COLUMN code_hash code VARCHAR(128) NOT NULL,
+  ADD UNIQUE KEY uq_team_invites_code (code),
+  DROP COLUMN expires_at;
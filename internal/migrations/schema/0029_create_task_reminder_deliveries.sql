@@ -0,0 +1,14 @@
+-- +goose Up
+-- Доставка напоминаний по получателям: при повторе после частичной ошибки письмо получают только те, кому оно еще не ушло.
+CREATE TABLE task_reminder_deliveries (
+  task_id CHAR(36) NOT NULL,
+  threshold VARCHAR(32) NOT NULL,
+  due_at DATETIME NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  sent_at DATETIME NOT NULL,
+  PRIMARY KEY (task_id, threshold, due_at, email),
+  CONSTRAINT fk_task_reminder_deliveries_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +goose Down
+DROP TABLE IF EXISTS task_reminder_deliveries;
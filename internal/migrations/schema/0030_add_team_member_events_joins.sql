@@ -0,0 +1,22 @@
+-- +goose Up
+-- Журнал участников дополняется вступлениями и приглашениями и служит источником ленты активности команды.
+-- Для приглашения задается email, а user_id остается пустым: приглашенный может еще не быть зарегистрирован.
+ALTER TABLE team_member_events
+  MODIFY COLUMN user_id CHAR(36) NULL,
+  ADD COLUMN email VARCHAR(255) NULL AFTER user_id,
+  ADD KEY idx_team_member_events_team_event_created (team_id, event, created_at);
+
+INSERT INTO team_member_events (id, team_id, user_id, actor_id, event, new_role, created_at)
+SELECT UUID(), m.team_id, m.user_id, m.user_id, 'joined', m.role, m.created_at
+FROM team_members m;
+
+INSERT INTO team_member_events (id, team_id, email, actor_id, event, created_at)
+SELECT UUID(), i.team_id, i.email, i.inviter_id, 'invited', i.created_at
+FROM team_invites i;
+
+-- +goose Down
+DELETE FROM team_member_events WHERE event IN ('joined', 'invited');
+ALTER TABLE team_member_events
+  DROP KEY idx_team_member_events_team_event_created,
+  DROP COLUMN email,
+  MODIFY COLUMN user_id CHAR(36) NOT NULL;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Выполняющееся задание обновляет heartbeat_at при сохранении прогресса; зависшие задания определяются по нему.
+ALTER TABLE task_imports
+  ADD COLUMN heartbeat_at DATETIME NULL AFTER started_at;
+
+UPDATE task_imports SET heartbeat_at = started_at WHERE status = 'running';
+
+CREATE INDEX idx_task_imports_status_heartbeat ON task_imports (status, heartbeat_at);
+
+-- +goose Down
+DROP INDEX idx_task_imports_status_heartbeat ON task_imports;
+ALTER TABLE task_imports DROP COLUMN heartbeat_at;
@@ -0,0 +1,56 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+)
+
+// ErrNotFound возвращается, если объект отсутствует в хранилище.
+var ErrNotFound = errors.New("объект не найден")
+
+// BlobStore описывает хранилище бинарных объектов.
+type BlobStore interface {
+	// Put сохраняет объект. size < 0 означает, что размер заранее неизвестен.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get возвращает содержимое объекта. Вызывающий обязан закрыть reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete удаляет объект. Отсутствие объекта не считается ошибкой.
+	Delete(ctx context.Context, key string) error
+}
+
+// New создает хранилище по конфигурации.
+func New(cfg config.StorageConfig) (BlobStore, error) {
+	const methodCtx = "blobstore.New"
+
+	switch strings.ToLower(cfg.Driver) {
+	case "", "local":
+		store, err := NewLocalStore(cfg.Local.Path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		return store, nil
+	case "s3":
+		store, err := NewS3Store(cfg.S3)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("%s: неизвестный драйвер хранилища: %s", methodCtx, cfg.Driver)
+	}
+}
+
+func validateKey(key string) error {
+	if key == "" {
+		return errors.New("ключ объекта не задан")
+	}
+	if strings.HasPrefix(key, "/") || strings.Contains(key, "..") || strings.Contains(key, "\\") {
+		return fmt.Errorf("некорректный ключ объекта: %s", key)
+	}
+	return nil
+}
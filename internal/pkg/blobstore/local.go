@@ -0,0 +1,123 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+)
+
+// LocalStore хранит объекты в локальной файловой системе.
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore создает хранилище в каталоге root.
+func NewLocalStore(root string) (*LocalStore, error) {
+	const methodCtx = "blobstore.NewLocalStore"
+
+	slog.Debug("инициализация локального хранилища", slog.String("context", methodCtx))
+
+	if root == "" {
+		return nil, fmt.Errorf("%s: каталог хранилища не задан", methodCtx)
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("%s: ошибка создания каталога: %w", methodCtx, err)
+	}
+
+	return &LocalStore{root: root}, nil
+}
+
+// Put сохраняет объект атомарно: сначала во временный файл, затем переименованием.
+func (s *LocalStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	const methodCtx = "blobstore.LocalStore.Put"
+
+	path, err := s.path(key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := io.Copy(tmp, &contextReader{ctx: ctx, r: r}); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return nil
+}
+
+// Get открывает объект на чтение.
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	const methodCtx = "blobstore.LocalStore.Get"
+
+	path, err := s.path(key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return file, nil
+}
+
+// Delete удаляет объект.
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	const methodCtx = "blobstore.LocalStore.Delete"
+
+	path, err := s.path(key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return nil
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	if s == nil || s.root == "" {
+		return "", errors.New("хранилище не инициализировано")
+	}
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// contextReader прерывает чтение при отмене контекста.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
@@ -0,0 +1,356 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+)
+
+const (
+	s3Service        = "s3"
+	s3Algorithm      = "AWS4-HMAC-SHA256"
+	s3UnsignedBody   = "UNSIGNED-PAYLOAD"
+	s3AmzDateFormat  = "20060102T150405Z"
+	s3DateFormat     = "20060102"
+	s3DefaultRegion  = "us-east-1"
+	s3DefaultTimeout = 30 * time.Second
+)
+
+// S3Store хранит объекты в S3-совместимом хранилище (AWS S3, MinIO и т.п.).
+// Запросы подписываются AWS Signature V4.
+type S3Store struct {
+	endpoint  *url.URL
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+	pathStyle bool
+	client    *http.Client
+	now       func() time.Time
+}
+
+// NewS3Store создает S3-хранилище.
+func NewS3Store(cfg config.S3Config) (*S3Store, error) {
+	const methodCtx = "blobstore.NewS3Store"
+
+	slog.Debug("инициализация S3 хранилища", slog.String("context", methodCtx))
+
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("%s: endpoint не задан", methodCtx)
+	}
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("%s: bucket не задан", methodCtx)
+	}
+	if cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("%s: ключи доступа не заданы", methodCtx)
+	}
+
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("%s: некорректный endpoint: %s", methodCtx, cfg.Endpoint)
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = s3DefaultRegion
+	}
+
+	timeout := s3DefaultTimeout
+	if cfg.TimeoutSeconds > 0 {
+		timeout = time.Duration(cfg.TimeoutSeconds) * time.Second
+	}
+
+	return &S3Store{
+		endpoint:  endpoint,
+		bucket:    cfg.Bucket,
+		region:    region,
+		accessKey: cfg.AccessKey,
+		secretKey: cfg.SecretKey,
+		pathStyle: cfg.PathStyle,
+		client:    &http.Client{Timeout: timeout},
+		now:       time.Now,
+	}, nil
+}
+
+// Put загружает объект. Если размер неизвестен, тело сначала буферизуется
+// во временный файл, так как S3 требует Content-Length.
+func (s *S3Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	const methodCtx = "blobstore.S3Store.Put"
+
+	if err := validateKey(key); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	body := r
+	if size < 0 {
+		spool, err := os.CreateTemp("", "s3-upload-*")
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		defer func() {
+			_ = spool.Close()
+			_ = os.Remove(spool.Name())
+		}()
+
+		size, err = io.Copy(spool, &contextReader{ctx: ctx, r: r})
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if _, err := spool.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		body = spool
+	}
+	if size == 0 {
+		// Нулевой ContentLength с непустым телом net/http считает неизвестной длиной.
+		body = http.NoBody
+	}
+
+	req, err := s.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	s.sign(req, s3UnsignedBody)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer drainAndClose(resp.Body)
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s: неожиданный ответ S3: %d", methodCtx, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Get скачивает объект.
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	const methodCtx = "blobstore.S3Store.Get"
+
+	if err := validateKey(key); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	s.sign(req, s3UnsignedBody)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		drainAndClose(resp.Body)
+		return nil, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	case resp.StatusCode/100 != 2:
+		drainAndClose(resp.Body)
+		return nil, fmt.Errorf("%s: неожиданный ответ S3: %d", methodCtx, resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// Delete удаляет объект.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	const methodCtx = "blobstore.S3Store.Delete"
+
+	if err := validateKey(key); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	s.sign(req, s3UnsignedBody)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer drainAndClose(resp.Body)
+
+	if resp.StatusCode/100 != 2 && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("%s: неожиданный ответ S3: %d", methodCtx, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *S3Store) newRequest(ctx context.Context, method string, key string, body io.Reader) (*http.Request, error) {
+	u := *s.endpoint
+	if s.pathStyle {
+		u.Path = strings.TrimRight(u.Path, "/") + "/" + s.bucket + "/" + key
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = strings.TrimRight(u.Path, "/") + "/" + key
+	}
+	u.RawPath = s3EscapePath(u.Path)
+
+	return http.NewRequestWithContext(ctx, method, u.String(), body)
+}
+
+// sign добавляет заголовки подписи AWS Signature V4.
+func (s *S3Store) sign(req *http.Request, payloadHash string) {
+	now := s.now().UTC()
+	amzDate := now.Format(s3AmzDateFormat)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders, canonicalHeaders := s3CanonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3EscapePath(req.URL.Path),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(s3DateFormat), s.region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), now.Format(s3DateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm,
+		s.accessKey,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+// s3CanonicalHeaders подписывает host, content-type, range и все x-amz-* заголовки.
+func s3CanonicalHeaders(req *http.Request) (string, string) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	values := map[string]string{"host": strings.TrimSpace(host)}
+	for name, vals := range req.Header {
+		lower := strings.ToLower(name)
+		if lower != "content-type" && lower != "range" && !strings.HasPrefix(lower, "x-amz-") {
+			continue
+		}
+		trimmed := make([]string, 0, len(vals))
+		for _, v := range vals {
+			trimmed = append(trimmed, strings.Join(strings.Fields(v), " "))
+		}
+		values[lower] = strings.Join(trimmed, ",")
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name)
+		canonical.WriteByte(':')
+		canonical.WriteString(values[name])
+		canonical.WriteByte('\n')
+	}
+
+	return strings.Join(names, ";"), canonical.String()
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vals := append([]string(nil), query[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, s3Escape(k, true)+"="+s3Escape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func s3EscapePath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return s3Escape(path, false)
+}
+
+// s3Escape кодирует строку по правилам SigV4: не кодируются только A-Z, a-z, 0-9, '-', '_', '.', '~'
+// и, для пути, '/'.
+func s3Escape(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func drainAndClose(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, 64<<10))
+	_ = body.Close()
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/Seraf-seraf/mkk_test/internal/api"
 )
 
-const tasksListTTL = 5 * time.Minute
+const (
+	tasksListTTL    = 5 * time.Minute
+	invalidateBatch = 100
+)
 
 // TasksCache хранит списки задач в Redis.
 type TasksCache struct {
@@ -73,3 +76,30 @@ func (c *TasksCache) SetTeamTasks(ctx context.Context, _ uuid.UUID, key string,
 
 	return c.client.Set(ctx, key, data, tasksListTTL).Err()
 }
+
+// InvalidateTeam удаляет все закешированные списки задач команды.
+func (c *TasksCache) InvalidateTeam(ctx context.Context, teamID uuid.UUID) error {
+	const methodCtx = "cache.TasksCache.InvalidateTeam"
+
+	if c == nil || c.client == nil {
+		return fmt.Errorf("%s: кеш не инициализирован", methodCtx)
+	}
+
+	pattern := fmt.Sprintf("tasks:%s:*", teamID.String())
+	var cursor uint64
+	for {
+		keys, next, err := c.client.Scan(ctx, cursor, pattern, invalidateBatch).Result()
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if len(keys) > 0 {
+			if err := c.client.Del(ctx, keys...).Err(); err != nil {
+				return fmt.Errorf("%s: %w", methodCtx, err)
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
@@ -41,3 +41,38 @@ func TestTasksCacheTTL(t *testing.T) {
 	require.Greater(t, ttl, 4*time.Minute, methodCtx)
 	require.LessOrEqual(t, ttl, 5*time.Minute, methodCtx)
 }
+
+func TestTasksCacheInvalidateTeam(t *testing.T) {
+	const methodCtx = "cache.TestTasksCacheInvalidateTeam"
+
+	client, cleanup := redistest.Start(t)
+	t.Cleanup(cleanup)
+
+	cache, err := NewTasksCache(client)
+	require.NoError(t, err, methodCtx)
+
+	ctx := context.Background()
+	teamID := uuid.New()
+	otherTeamID := uuid.New()
+
+	keys := []string{
+		"tasks:" + teamID.String() + ":status=:assignee=:page=1:per=20",
+		"tasks:" + teamID.String() + ":status=done:assignee=:page=1:per=20",
+	}
+	otherKey := "tasks:" + otherTeamID.String() + ":status=:assignee=:page=1:per=20"
+	for _, key := range append(keys, otherKey) {
+		require.NoError(t, cache.SetTeamTasks(ctx, teamID, key, []api.Task{}), methodCtx)
+	}
+
+	require.NoError(t, cache.InvalidateTeam(ctx, teamID), methodCtx)
+
+	for _, key := range keys {
+		_, hit, err := cache.GetTeamTasks(ctx, teamID, key)
+		require.NoError(t, err, methodCtx)
+		require.False(t, hit, methodCtx)
+	}
+
+	_, hit, err := cache.GetTeamTasks(ctx, otherTeamID, otherKey)
+	require.NoError(t, err, methodCtx)
+	require.True(t, hit, methodCtx)
+}
@@ -0,0 +1,111 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Job описывает периодическую фоновую задачу.
+type Job func(ctx context.Context) error
+
+// Worker периодически выполняет Job в отдельной горутине.
+type Worker struct {
+	name     string
+	interval time.Duration
+	job      Job
+
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	done    chan struct{}
+	started bool
+}
+
+// New создает фоновый обработчик с указанным интервалом запуска.
+func New(name string, interval time.Duration, job Job) (*Worker, error) {
+	const methodCtx = "worker.New"
+
+	slog.Debug("инициализация фонового обработчика", slog.String("context", methodCtx), slog.String("name", name))
+
+	if name == "" {
+		return nil, fmt.Errorf("%s: name не задан", methodCtx)
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("%s: интервал должен быть положительным", methodCtx)
+	}
+	if job == nil {
+		return nil, fmt.Errorf("%s: job не задан", methodCtx)
+	}
+
+	return &Worker{name: name, interval: interval, job: job}, nil
+}
+
+// Start запускает обработчик. Повторный вызов ничего не делает.
+func (w *Worker) Start() {
+	const methodCtx = "worker.Worker.Start"
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.started {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+	w.started = true
+
+	slog.Info("фоновый обработчик запущен", slog.String("context", methodCtx), slog.String("name", w.name))
+
+	go w.loop(ctx)
+}
+
+// Stop останавливает обработчик и ждет завершения текущего запуска.
+func (w *Worker) Stop(ctx context.Context) error {
+	const methodCtx = "worker.Worker.Stop"
+
+	w.mu.Lock()
+	if !w.started {
+		w.mu.Unlock()
+		return nil
+	}
+	cancel, done := w.cancel, w.done
+	w.started = false
+	w.mu.Unlock()
+
+	cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%s: %s: %w", methodCtx, w.name, ctx.Err())
+	}
+}
+
+func (w *Worker) loop(ctx context.Context) {
+	const methodCtx = "worker.Worker.loop"
+
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.job(ctx); err != nil && ctx.Err() == nil {
+				slog.Error("ошибка фонового обработчика",
+					slog.String("context", methodCtx),
+					slog.String("name", w.name),
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+}
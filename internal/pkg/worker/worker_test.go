@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerRunsJobPeriodically(t *testing.T) {
+	const methodCtx = "worker.TestWorkerRunsJobPeriodically"
+
+	var calls atomic.Int32
+	w, err := New("test", 10*time.Millisecond, func(ctx context.Context) error {
+		calls.Add(1)
+		return errors.New("ошибка не должна останавливать обработчик")
+	})
+	require.NoError(t, err, methodCtx)
+
+	w.Start()
+	w.Start()
+
+	require.Eventually(t, func() bool {
+		return calls.Load() >= 3
+	}, time.Second, 5*time.Millisecond, methodCtx)
+
+	require.NoError(t, w.Stop(context.Background()), methodCtx)
+	stopped := calls.Load()
+	time.Sleep(30 * time.Millisecond)
+	require.Equal(t, stopped, calls.Load(), methodCtx)
+
+	require.NoError(t, w.Stop(context.Background()), methodCtx)
+}
+
+func TestWorkerStopCancelsJob(t *testing.T) {
+	const methodCtx = "worker.TestWorkerStopCancelsJob"
+
+	started := make(chan struct{})
+	w, err := New("blocking", time.Millisecond, func(ctx context.Context) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err, methodCtx)
+
+	w.Start()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, w.Stop(ctx), methodCtx)
+}
+
+func TestNewValidatesArguments(t *testing.T) {
+	const methodCtx = "worker.TestNewValidatesArguments"
+
+	job := func(ctx context.Context) error { return nil }
+
+	_, err := New("", time.Second, job)
+	require.Error(t, err, methodCtx)
+
+	_, err = New("name", 0, job)
+	require.Error(t, err, methodCtx)
+
+	_, err = New("name", time.Second, nil)
+	require.Error(t, err, methodCtx)
+}
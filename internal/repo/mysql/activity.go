@@ -0,0 +1,253 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Типы событий ленты активности.
+const (
+	ActivityTaskHistory       = "task_history"
+	ActivityComment           = "comment"
+	ActivityMemberJoined      = "member_joined"
+	ActivityInviteCreated     = "invite_created"
+	ActivityMemberRoleChanged = "member_role_changed"
+	ActivityMemberRemoved     = "member_removed"
+	ActivityMemberLeft        = "member_left"
+)
+
+// memberActivityEvents сопоставляет типы ленты с событиями журнала участников.
+var memberActivityEvents = map[string]string{
+	ActivityMemberJoined:      TeamMemberEventJoined,
+	ActivityInviteCreated:     TeamMemberEventInvited,
+	ActivityMemberRoleChanged: TeamMemberEventRoleChanged,
+	ActivityMemberRemoved:     TeamMemberEventRemoved,
+	ActivityMemberLeft:        TeamMemberEventLeft,
+}
+
+// ActivityRecord описывает событие ленты активности.
+// Для событий участников UserID — затронутый участник, Role и OldRole — новая и прежняя роль.
+type ActivityRecord struct {
+	Type         string
+	ID           uuid.UUID
+	TeamID       uuid.UUID
+	TaskID       *uuid.UUID
+	ActorID      uuid.UUID
+	OccurredAt   time.Time
+	HistoryEvent *string
+	Changes      map[string]interface{}
+	Body         *string
+	Email        *string
+	Role         *string
+	UserID       *uuid.UUID
+	OldRole      *string
+}
+
+// ActivityCursor задает позицию в ленте: возвращаются события строго раньше нее.
+type ActivityCursor struct {
+	OccurredAt time.Time
+	Type       string
+	ID         uuid.UUID
+}
+
+// ActivityFilter описывает выборку ленты активности.
+// Задается ровно одно из TeamID и TaskID; для задачи учитываются только история и комментарии.
+type ActivityFilter struct {
+	TeamID *uuid.UUID
+	TaskID *uuid.UUID
+	Types  []string
+	Before *ActivityCursor
+	Limit  int
+}
+
+// ActivityRepo собирает ленту активности из истории задач, комментариев и журнала участников команды.
+type ActivityRepo struct {
+	db *sql.DB
+}
+
+// NewActivityRepo создает репозиторий ленты активности.
+func NewActivityRepo(db *sql.DB) *ActivityRepo {
+	const methodCtx = "repo.NewActivityRepo"
+
+	slog.Debug("инициализация репозитория ленты активности", slog.String("context", methodCtx))
+
+	return &ActivityRepo{db: db}
+}
+
+// List возвращает события ленты от новых к старым.
+func (r *ActivityRepo) List(ctx context.Context, filter ActivityFilter) ([]ActivityRecord, error) {
+	const methodCtx = "repo.ActivityRepo.List"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if (filter.TeamID == nil) == (filter.TaskID == nil) {
+		return nil, fmt.Errorf("%s: должен быть задан team_id или task_id", methodCtx)
+	}
+
+	var parts []string
+	var args []interface{}
+	for _, activityType := range filter.Types {
+		part, partArgs, ok := activitySource(activityType, filter)
+		if !ok {
+			continue
+		}
+		parts = append(parts, part)
+		args = append(args, partArgs...)
+	}
+	if len(parts) == 0 {
+		return nil, nil
+	}
+
+	query := "SELECT type, id, team_id, task_id, actor_id, occurred_at, history_event, changes, body, email, role, user_id, old_role FROM (" +
+		strings.Join(parts, " UNION ALL ") + ") activity"
+	if filter.Before != nil {
+		query += " WHERE (occurred_at, type, id) < (?, ?, ?)"
+		args = append(args, filter.Before.OccurredAt, filter.Before.Type, filter.Before.ID.String())
+	}
+	query += " ORDER BY occurred_at DESC, type DESC, id DESC LIMIT ?"
+	args = append(args, filter.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []ActivityRecord
+	for rows.Next() {
+		record, err := scanActivityRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// activitySource возвращает подзапрос для одного типа событий.
+// История и комментарии привязаны к команде через текущий tasks.team_id, поэтому после переноса
+// задачи ее события до переноса попадают в ленту новой команды.
+func activitySource(activityType string, filter ActivityFilter) (string, []interface{}, bool) {
+	var scope string
+	var scopeID string
+	if filter.TaskID != nil {
+		scope, scopeID = "t.id = ?", filter.TaskID.String()
+	} else {
+		scope, scopeID = "t.team_id = ?", filter.TeamID.String()
+	}
+
+	switch activityType {
+	case ActivityTaskHistory:
+		return `SELECT 'task_history' AS type, h.id, t.team_id, h.task_id, h.changed_by AS actor_id, h.changed_at AS occurred_at,
+			h.event AS history_event, h.changes, NULL AS body, NULL AS email, NULL AS role, NULL AS user_id, NULL AS old_role
+			FROM task_history h
+			JOIN tasks t ON t.id = h.task_id
+			WHERE ` + scope, []interface{}{scopeID}, true
+	case ActivityComment:
+		return `SELECT 'comment', c.id, t.team_id, c.task_id, c.user_id, c.created_at, NULL, NULL, c.body, NULL, NULL, NULL, NULL
+			FROM task_comments c
+			JOIN tasks t ON t.id = c.task_id
+			WHERE ` + scope, []interface{}{scopeID}, true
+	}
+
+	event, ok := memberActivityEvents[activityType]
+	if !ok || filter.TeamID == nil {
+		return "", nil, false
+	}
+	// Журнал участников только дополняется, поэтому лента не зависит от текущего состава команды и приглашений.
+	return `SELECT '` + activityType + `', e.id, e.team_id, NULL, e.actor_id, e.created_at, NULL, NULL, NULL, e.email, e.new_role, e.user_id, e.old_role
+		FROM team_member_events e
+		WHERE e.team_id = ? AND e.event = ?`, []interface{}{filter.TeamID.String(), event}, true
+}
+
+func scanActivityRecord(scanner interface {
+	Scan(dest ...interface{}) error
+}) (ActivityRecord, error) {
+	var record ActivityRecord
+	var idStr, teamIDStr, actorIDStr string
+	var taskID, historyEvent, body, email, role, userID, oldRole sql.NullString
+	var changesData []byte
+
+	if err := scanner.Scan(
+		&record.Type,
+		&idStr,
+		&teamIDStr,
+		&taskID,
+		&actorIDStr,
+		&record.OccurredAt,
+		&historyEvent,
+		&changesData,
+		&body,
+		&email,
+		&role,
+		&userID,
+		&oldRole,
+	); err != nil {
+		return ActivityRecord{}, err
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return ActivityRecord{}, fmt.Errorf("некорректный id события")
+	}
+	teamID, err := uuid.Parse(teamIDStr)
+	if err != nil {
+		return ActivityRecord{}, fmt.Errorf("некорректный id команды")
+	}
+	actorID, err := uuid.Parse(actorIDStr)
+	if err != nil {
+		return ActivityRecord{}, fmt.Errorf("некорректный id автора")
+	}
+	record.ID = id
+	record.TeamID = teamID
+	record.ActorID = actorID
+
+	if taskID.Valid {
+		parsed, err := uuid.Parse(taskID.String)
+		if err != nil {
+			return ActivityRecord{}, fmt.Errorf("некорректный task_id")
+		}
+		record.TaskID = &parsed
+	}
+	if historyEvent.Valid {
+		record.HistoryEvent = &historyEvent.String
+	}
+	if len(changesData) > 0 {
+		if err := json.Unmarshal(changesData, &record.Changes); err != nil {
+			return ActivityRecord{}, fmt.Errorf("некорректные изменения: %w", err)
+		}
+	}
+	if body.Valid {
+		record.Body = &body.String
+	}
+	if email.Valid {
+		record.Email = &email.String
+	}
+	if role.Valid {
+		record.Role = &role.String
+	}
+	if userID.Valid {
+		parsed, err := uuid.Parse(userID.String)
+		if err != nil {
+			return ActivityRecord{}, fmt.Errorf("некорректный user_id")
+		}
+		record.UserID = &parsed
+	}
+	if oldRole.Valid {
+		record.OldRole = &oldRole.String
+	}
+
+	return record, nil
+}
@@ -0,0 +1,193 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CalendarFeedRecord описывает календарную ленту пользователя. Токен хранится только в виде хеша.
+type CalendarFeedRecord struct {
+	UserID    uuid.UUID
+	TokenHash string
+	CreatedAt time.Time
+}
+
+// CalendarTaskRecord описывает задачу со сроком для календарной ленты.
+type CalendarTaskRecord struct {
+	ID          uuid.UUID
+	TeamName    string
+	Title       string
+	Description *string
+	Status      string
+	DueAt       time.Time
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+// CalendarFeedsRepo реализует хранение календарных лент и выборку задач для них.
+type CalendarFeedsRepo struct {
+	db *sql.DB
+}
+
+// NewCalendarFeedsRepo создает репозиторий календарных лент.
+func NewCalendarFeedsRepo(db *sql.DB) *CalendarFeedsRepo {
+	const methodCtx = "repo.NewCalendarFeedsRepo"
+
+	slog.Debug("инициализация репозитория календарных лент", slog.String("context", methodCtx))
+
+	return &CalendarFeedsRepo{db: db}
+}
+
+// Get возвращает ленту пользователя.
+func (r *CalendarFeedsRepo) Get(ctx context.Context, userID uuid.UUID) (CalendarFeedRecord, error) {
+	const methodCtx = "repo.CalendarFeedsRepo.Get"
+
+	if r == nil || r.db == nil {
+		return CalendarFeedRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	record := CalendarFeedRecord{UserID: userID}
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT token_hash, created_at FROM calendar_feeds WHERE user_id = ?",
+		userID.String(),
+	).Scan(&record.TokenHash, &record.CreatedAt)
+	if err != nil {
+		return CalendarFeedRecord{}, err
+	}
+	return record, nil
+}
+
+// GetUserByToken возвращает владельца ленты по хешу токена.
+func (r *CalendarFeedsRepo) GetUserByToken(ctx context.Context, tokenHash string) (uuid.UUID, error) {
+	const methodCtx = "repo.CalendarFeedsRepo.GetUserByToken"
+
+	if r == nil || r.db == nil {
+		return uuid.Nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var userIDStr string
+	if err := r.db.QueryRowContext(ctx, "SELECT user_id FROM calendar_feeds WHERE token_hash = ?", tokenHash).Scan(&userIDStr); err != nil {
+		return uuid.Nil, err
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: некорректный user_id", methodCtx)
+	}
+	return userID, nil
+}
+
+// Save создает ленту или заменяет токен существующей; старый токен перестает действовать.
+func (r *CalendarFeedsRepo) Save(ctx context.Context, record CalendarFeedRecord) error {
+	const methodCtx = "repo.CalendarFeedsRepo.Save"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO calendar_feeds (user_id, token_hash, created_at) VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE token_hash = VALUES(token_hash), created_at = VALUES(created_at)`,
+		record.UserID.String(),
+		record.TokenHash,
+		record.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Delete отзывает ленту пользователя. Возвращает false, если ленты не было.
+func (r *CalendarFeedsRepo) Delete(ctx context.Context, userID uuid.UUID) (bool, error) {
+	const methodCtx = "repo.CalendarFeedsRepo.Delete"
+
+	if r == nil || r.db == nil {
+		return false, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM calendar_feeds WHERE user_id = ?", userID.String())
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return affected > 0, nil
+}
+
+// ListTasks возвращает задачи со сроком не раньше dueAfter, где пользователь назначен исполнителем,
+// во всех командах, в которых он состоит. Архивные задачи и задачи удаленных команд не включаются.
+func (r *CalendarFeedsRepo) ListTasks(ctx context.Context, userID uuid.UUID, dueAfter time.Time, limit int) ([]CalendarTaskRecord, error) {
+	const methodCtx = "repo.CalendarFeedsRepo.ListTasks"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT t.id, tm.name, t.title, t.description, t.status, t.due_at, t.created_at, t.updated_at, t.completed_at
+		FROM task_assignees ta
+		JOIN tasks t ON t.id = ta.task_id
+		JOIN teams tm ON tm.id = t.team_id
+		JOIN team_members m ON m.team_id = t.team_id AND m.user_id = ta.user_id
+		WHERE ta.user_id = ? AND t.due_at IS NOT NULL AND t.due_at >= ? AND t.archived_at IS NULL AND tm.deleted_at IS NULL
+		ORDER BY t.due_at ASC, t.id ASC
+		LIMIT ?`,
+		userID.String(),
+		dueAfter,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []CalendarTaskRecord
+	for rows.Next() {
+		var record CalendarTaskRecord
+		var idStr string
+		var description sql.NullString
+		var updatedAt, completedAt sql.NullTime
+		if err := rows.Scan(
+			&idStr,
+			&record.TeamName,
+			&record.Title,
+			&description,
+			&record.Status,
+			&record.DueAt,
+			&record.CreatedAt,
+			&updatedAt,
+			&completedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if record.ID, err = uuid.Parse(idStr); err != nil {
+			return nil, fmt.Errorf("%s: некорректный id задачи", methodCtx)
+		}
+		if description.Valid {
+			record.Description = &description.String
+		}
+		if updatedAt.Valid {
+			record.UpdatedAt = &updatedAt.Time
+		}
+		if completedAt.Valid {
+			record.CompletedAt = &completedAt.Time
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
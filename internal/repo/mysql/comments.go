@@ -34,14 +34,17 @@ func NewCommentsRepo(db *sql.DB) *CommentsRepo {
 }
 
 // Create создает комментарий.
-func (r *CommentsRepo) Create(ctx context.Context, record CommentRecord) error {
+func (r *CommentsRepo) Create(ctx context.Context, exec DBTX, record CommentRecord) error {
 	const methodCtx = "repo.CommentsRepo.Create"
 
 	if r == nil || r.db == nil {
 		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
 	}
+	if exec == nil {
+		exec = r.db
+	}
 
-	_, err := r.db.ExecContext(
+	_, err := exec.ExecContext(
 		ctx,
 		"INSERT INTO task_comments (id, task_id, user_id, body, created_at) VALUES (?, ?, ?, ?, ?)",
 		record.ID.String(),
@@ -130,6 +133,66 @@ func (r *CommentsRepo) Count(ctx context.Context, taskID uuid.UUID) (int, error)
 	return total, nil
 }
 
+// ListByTasks возвращает комментарии нескольких задач, сгруппированные по задаче в хронологическом порядке.
+func (r *CommentsRepo) ListByTasks(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]CommentRecord, error) {
+	const methodCtx = "repo.CommentsRepo.ListByTasks"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if len(taskIDs) == 0 {
+		return map[uuid.UUID][]CommentRecord{}, nil
+	}
+
+	args := make([]interface{}, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		args = append(args, taskID.String())
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, task_id, user_id, body, created_at
+		FROM task_comments
+		WHERE task_id IN (`+placeholders(len(taskIDs))+`)
+		ORDER BY task_id, created_at ASC, id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	grouped := map[uuid.UUID][]CommentRecord{}
+	for rows.Next() {
+		var record CommentRecord
+		var idStr, taskIDStr, userIDStr string
+		if err := rows.Scan(&idStr, &taskIDStr, &userIDStr, &record.Body, &record.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id комментария", methodCtx)
+		}
+		taskUUID, err := uuid.Parse(taskIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный task_id", methodCtx)
+		}
+		userUUID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный user_id", methodCtx)
+		}
+		record.ID = id
+		record.TaskID = taskUUID
+		record.UserID = userUUID
+		grouped[taskUUID] = append(grouped[taskUUID], record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return grouped, nil
+}
+
 // Get возвращает комментарий по id.
 func (r *CommentsRepo) Get(ctx context.Context, taskID uuid.UUID, commentID uuid.UUID) (CommentRecord, error) {
 	const methodCtx = "repo.CommentsRepo.Get"
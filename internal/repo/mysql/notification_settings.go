@@ -0,0 +1,76 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationSettingsRecord описывает настройки уведомлений пользователя.
+type NotificationSettingsRecord struct {
+	UserID       uuid.UUID
+	DueReminders bool
+	UpdatedAt    time.Time
+}
+
+// NotificationSettingsRepo реализует хранение настроек уведомлений.
+type NotificationSettingsRepo struct {
+	db *sql.DB
+}
+
+// NewNotificationSettingsRepo создает репозиторий настроек уведомлений.
+func NewNotificationSettingsRepo(db *sql.DB) *NotificationSettingsRepo {
+	const methodCtx = "repo.NewNotificationSettingsRepo"
+
+	slog.Debug("инициализация репозитория настроек уведомлений", slog.String("context", methodCtx))
+
+	return &NotificationSettingsRepo{db: db}
+}
+
+// Get возвращает настройки пользователя; без сохраненной записи — значения по умолчанию.
+func (r *NotificationSettingsRepo) Get(ctx context.Context, userID uuid.UUID) (NotificationSettingsRecord, error) {
+	const methodCtx = "repo.NotificationSettingsRepo.Get"
+
+	if r == nil || r.db == nil {
+		return NotificationSettingsRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	record := NotificationSettingsRecord{UserID: userID, DueReminders: true}
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT due_reminders, updated_at FROM user_notification_settings WHERE user_id = ?",
+		userID.String(),
+	).Scan(&record.DueReminders, &record.UpdatedAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return NotificationSettingsRecord{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return record, nil
+}
+
+// Save сохраняет настройки пользователя.
+func (r *NotificationSettingsRepo) Save(ctx context.Context, record NotificationSettingsRecord) error {
+	const methodCtx = "repo.NotificationSettingsRepo.Save"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	if _, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO user_notification_settings (user_id, due_reminders, updated_at)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE due_reminders = VALUES(due_reminders), updated_at = VALUES(updated_at)`,
+		record.UserID.String(),
+		record.DueReminders,
+		record.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
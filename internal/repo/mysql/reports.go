@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -32,6 +33,54 @@ type InvalidAssigneeRecord struct {
 	AssigneeID uuid.UUID
 }
 
+// TimesheetFilter описывает фильтры табеля учета времени.
+// Период задается полуинтервалом [From, To).
+type TimesheetFilter struct {
+	RequesterID uuid.UUID
+	From        time.Time
+	To          time.Time
+	TeamID      *uuid.UUID
+	UserID      *uuid.UUID
+}
+
+// TimesheetRecord описывает суммарное время пользователя в команде.
+type TimesheetRecord struct {
+	TeamID        uuid.UUID
+	TeamName      string
+	UserID        uuid.UUID
+	TotalMinutes  int
+	WorklogsCount int
+}
+
+// Группировки отчета о скорости.
+const (
+	VelocityByWeek   = "week"
+	VelocityBySprint = "sprint"
+)
+
+// VelocityFilter описывает фильтры отчета о скорости.
+// Период задается полуинтервалом [From, To) по completed_at.
+type VelocityFilter struct {
+	RequesterID uuid.UUID
+	GroupBy     string
+	From        time.Time
+	To          time.Time
+	TeamID      *uuid.UUID
+}
+
+// VelocityRecord описывает завершенные story points команды за неделю или спринт.
+// WeekStart заполняется для группировки по неделям, SprintID и SprintName — по спринтам.
+type VelocityRecord struct {
+	TeamID           uuid.UUID
+	TeamName         string
+	WeekStart        *time.Time
+	SprintID         *uuid.UUID
+	SprintName       *string
+	CompletedPoints  int
+	CompletedTasks   int
+	UnestimatedTasks int
+}
+
 // ReportsRepo реализует запросы отчетов.
 type ReportsRepo struct {
 	db *sql.DB
@@ -62,6 +111,7 @@ func (r *ReportsRepo) TeamSummary(ctx context.Context) ([]TeamSummaryRecord, err
 		FROM teams t
 		LEFT JOIN team_members tm ON tm.team_id = t.id
 		LEFT JOIN tasks tk ON tk.team_id = t.id
+		WHERE t.deleted_at IS NULL
 		GROUP BY t.id, t.name
 		ORDER BY t.name`,
 	)
@@ -118,6 +168,7 @@ func (r *ReportsRepo) TopCreators(ctx context.Context, month string) ([]TopCreat
 				WHERE DATE_FORMAT(created_at, '%Y-%m') = ?
 				GROUP BY team_id, created_by
 			) tc ON tc.team_id = t.id
+			WHERE t.deleted_at IS NULL
 		) ranked
 		WHERE rn <= 3
 		ORDER BY team_id, tasks_created DESC`,
@@ -157,7 +208,8 @@ func (r *ReportsRepo) TopCreators(ctx context.Context, month string) ([]TopCreat
 	return items, nil
 }
 
-// InvalidAssignees возвращает задачи с некорректными исполнителями.
+// InvalidAssignees возвращает задачи с исполнителями, которые не состоят в команде задачи.
+// Проверяются как task_assignees, так и основной исполнитель в tasks.assignee_id.
 func (r *ReportsRepo) InvalidAssignees(ctx context.Context) ([]InvalidAssigneeRecord, error) {
 	const methodCtx = "repo.ReportsRepo.InvalidAssignees"
 
@@ -167,7 +219,13 @@ func (r *ReportsRepo) InvalidAssignees(ctx context.Context) ([]InvalidAssigneeRe
 
 	rows, err := r.db.QueryContext(
 		ctx,
-		`SELECT t.id, t.team_id, t.assignee_id
+		`SELECT t.id, t.team_id, ta.user_id
+		FROM task_assignees ta
+		JOIN tasks t ON t.id = ta.task_id
+		LEFT JOIN team_members tm ON tm.team_id = t.team_id AND tm.user_id = ta.user_id
+		WHERE tm.user_id IS NULL
+		UNION
+		SELECT t.id, t.team_id, t.assignee_id
 		FROM tasks t
 		LEFT JOIN team_members tm ON tm.team_id = t.team_id AND tm.user_id = t.assignee_id
 		WHERE t.assignee_id IS NOT NULL AND tm.user_id IS NULL`,
@@ -207,3 +265,179 @@ func (r *ReportsRepo) InvalidAssignees(ctx context.Context) ([]InvalidAssigneeRe
 
 	return items, nil
 }
+
+// Timesheet возвращает затраченное время по пользователям и командам.
+// Учитываются только завершенные записи в командах, где состоит RequesterID.
+func (r *ReportsRepo) Timesheet(ctx context.Context, filter TimesheetFilter) ([]TimesheetRecord, error) {
+	const methodCtx = "repo.ReportsRepo.Timesheet"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	query := `SELECT t.id, t.name, w.user_id, SUM(w.duration_minutes) AS total_minutes, COUNT(*) AS worklogs_count
+		FROM task_worklogs w
+		JOIN tasks tk ON tk.id = w.task_id
+		JOIN teams t ON t.id = tk.team_id
+		JOIN team_members me ON me.team_id = t.id AND me.user_id = ?
+		WHERE w.duration_minutes IS NOT NULL AND w.started_at >= ? AND w.started_at < ? AND t.deleted_at IS NULL`
+	args := []interface{}{filter.RequesterID.String(), filter.From, filter.To}
+
+	if filter.TeamID != nil {
+		query += " AND t.id = ?"
+		args = append(args, filter.TeamID.String())
+	}
+	if filter.UserID != nil {
+		query += " AND w.user_id = ?"
+		args = append(args, filter.UserID.String())
+	}
+
+	query += " GROUP BY t.id, t.name, w.user_id ORDER BY t.name, total_minutes DESC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []TimesheetRecord
+	for rows.Next() {
+		var teamIDStr, teamName, userIDStr string
+		var totalMinutes, worklogsCount int
+		if err := rows.Scan(&teamIDStr, &teamName, &userIDStr, &totalMinutes, &worklogsCount); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		teamID, err := uuid.Parse(teamIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id команды", methodCtx)
+		}
+		userID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id пользователя", methodCtx)
+		}
+		items = append(items, TimesheetRecord{
+			TeamID:        teamID,
+			TeamName:      teamName,
+			UserID:        userID,
+			TotalMinutes:  totalMinutes,
+			WorklogsCount: worklogsCount,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// Velocity возвращает сумму story points завершенных задач по командам и неделям или спринтам.
+// Очки задачи — последнее значение story_points в истории до completed_at;
+// без записей в истории используется текущее значение. Учитываются только команды, где состоит RequesterID.
+func (r *ReportsRepo) Velocity(ctx context.Context, filter VelocityFilter) ([]VelocityRecord, error) {
+	const methodCtx = "repo.ReportsRepo.Velocity"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	done := `SELECT tk.team_id, tk.sprint_id, tk.completed_at, tk.story_points,
+			(SELECT JSON_EXTRACT(h.changes, '$.story_points.to')
+			FROM task_history h
+			WHERE h.task_id = tk.id AND h.changed_at <= tk.completed_at
+				AND JSON_CONTAINS_PATH(h.changes, 'one', '$.story_points')
+			ORDER BY h.changed_at DESC
+			LIMIT 1) AS history_points
+		FROM tasks tk
+		JOIN team_members me ON me.team_id = tk.team_id AND me.user_id = ?
+		WHERE tk.status = 'done' AND tk.completed_at >= ? AND tk.completed_at < ?`
+	args := []interface{}{filter.RequesterID.String(), filter.From, filter.To}
+
+	if filter.TeamID != nil {
+		done += " AND tk.team_id = ?"
+		args = append(args, filter.TeamID.String())
+	}
+
+	query := `WITH done AS (` + done + `),
+		scored AS (
+			SELECT team_id, sprint_id, completed_at,
+				CASE
+					WHEN history_points IS NULL THEN story_points
+					WHEN JSON_TYPE(history_points) IN ('INTEGER', 'UNSIGNED INTEGER') THEN CAST(history_points AS SIGNED)
+				END AS points
+			FROM done
+		)`
+
+	switch filter.GroupBy {
+	case VelocityByWeek:
+		query += `
+		SELECT t.id, t.name, DATE(DATE_SUB(sc.completed_at, INTERVAL WEEKDAY(sc.completed_at) DAY)) AS week_start, NULL, NULL,
+			COALESCE(SUM(sc.points), 0), COUNT(*), SUM(sc.points IS NULL)
+		FROM scored sc
+		JOIN teams t ON t.id = sc.team_id
+		WHERE t.deleted_at IS NULL
+		GROUP BY t.id, t.name, week_start
+		ORDER BY t.name, week_start`
+	case VelocityBySprint:
+		query += `
+		SELECT t.id, t.name, NULL, sp.id, sp.name,
+			COALESCE(SUM(sc.points), 0), COUNT(*), SUM(sc.points IS NULL)
+		FROM scored sc
+		JOIN teams t ON t.id = sc.team_id
+		JOIN sprints sp ON sp.id = sc.sprint_id
+		WHERE t.deleted_at IS NULL
+		GROUP BY t.id, t.name, sp.id, sp.name, sp.start_date
+		ORDER BY t.name, sp.start_date, sp.id`
+	default:
+		return nil, fmt.Errorf("%s: неизвестная группировка %q", methodCtx, filter.GroupBy)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []VelocityRecord
+	for rows.Next() {
+		var record VelocityRecord
+		var teamIDStr string
+		var weekStart sql.NullTime
+		var sprintIDStr, sprintName sql.NullString
+		if err := rows.Scan(
+			&teamIDStr,
+			&record.TeamName,
+			&weekStart,
+			&sprintIDStr,
+			&sprintName,
+			&record.CompletedPoints,
+			&record.CompletedTasks,
+			&record.UnestimatedTasks,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		teamID, err := uuid.Parse(teamIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id команды", methodCtx)
+		}
+		record.TeamID = teamID
+		if weekStart.Valid {
+			record.WeekStart = &weekStart.Time
+		}
+		if sprintIDStr.Valid {
+			sprintID, err := uuid.Parse(sprintIDStr.String)
+			if err != nil {
+				return nil, fmt.Errorf("%s: некорректный id спринта", methodCtx)
+			}
+			record.SprintID = &sprintID
+		}
+		if sprintName.Valid {
+			record.SprintName = &sprintName.String
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
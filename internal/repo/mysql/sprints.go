@@ -0,0 +1,348 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Состояния спринта.
+const (
+	SprintPlanned = "planned"
+	SprintActive  = "active"
+	SprintClosed  = "closed"
+)
+
+// SprintRecord описывает спринт команды. StartDate и EndDate хранят только дату.
+type SprintRecord struct {
+	ID        uuid.UUID
+	TeamID    uuid.UUID
+	Name      string
+	Goal      *string
+	StartDate time.Time
+	EndDate   time.Time
+	State     string
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	ClosedAt  *time.Time
+}
+
+// SprintsRepo реализует хранение спринтов и привязку к ним задач.
+type SprintsRepo struct {
+	db *sql.DB
+}
+
+// NewSprintsRepo создает репозиторий спринтов.
+func NewSprintsRepo(db *sql.DB) *SprintsRepo {
+	const methodCtx = "repo.NewSprintsRepo"
+
+	slog.Debug("инициализация репозитория спринтов", slog.String("context", methodCtx))
+
+	return &SprintsRepo{db: db}
+}
+
+// Create сохраняет спринт.
+func (r *SprintsRepo) Create(ctx context.Context, record SprintRecord) error {
+	const methodCtx = "repo.SprintsRepo.Create"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var goalValue interface{}
+	if record.Goal != nil {
+		goalValue = *record.Goal
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO sprints (id, team_id, name, goal, start_date, end_date, state, created_by, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.ID.String(),
+		record.TeamID.String(),
+		record.Name,
+		goalValue,
+		record.StartDate.Format(time.DateOnly),
+		record.EndDate.Format(time.DateOnly),
+		record.State,
+		record.CreatedBy.String(),
+		record.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Get возвращает спринт по id.
+func (r *SprintsRepo) Get(ctx context.Context, sprintID uuid.UUID) (SprintRecord, error) {
+	const methodCtx = "repo.SprintsRepo.Get"
+
+	if r == nil || r.db == nil {
+		return SprintRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	row := r.db.QueryRowContext(
+		ctx,
+		`SELECT id, team_id, name, goal, start_date, end_date, state, created_by, created_at, updated_at, closed_at
+		FROM sprints
+		WHERE id = ?`,
+		sprintID.String(),
+	)
+
+	return scanSprintRecord(row)
+}
+
+// GetForUpdate возвращает спринт с блокировкой.
+func (r *SprintsRepo) GetForUpdate(ctx context.Context, tx *sql.Tx, sprintID uuid.UUID) (SprintRecord, error) {
+	const methodCtx = "repo.SprintsRepo.GetForUpdate"
+
+	if r == nil || r.db == nil {
+		return SprintRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return SprintRecord{}, fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+
+	row := tx.QueryRowContext(
+		ctx,
+		`SELECT id, team_id, name, goal, start_date, end_date, state, created_by, created_at, updated_at, closed_at
+		FROM sprints
+		WHERE id = ?
+		FOR UPDATE`,
+		sprintID.String(),
+	)
+
+	return scanSprintRecord(row)
+}
+
+// CountActive блокирует и считает активные спринты команды, кроме excludeID.
+func (r *SprintsRepo) CountActive(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, excludeID uuid.UUID) (int, error) {
+	const methodCtx = "repo.SprintsRepo.CountActive"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return 0, fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+
+	var total int
+	if err := tx.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM sprints WHERE team_id = ? AND state = ? AND id <> ? FOR UPDATE",
+		teamID.String(),
+		SprintActive,
+		excludeID.String(),
+	).Scan(&total); err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return total, nil
+}
+
+// List возвращает спринты команды в порядке дат начала. Пустой state означает все состояния.
+func (r *SprintsRepo) List(ctx context.Context, teamID uuid.UUID, state *string) ([]SprintRecord, error) {
+	const methodCtx = "repo.SprintsRepo.List"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	query := `SELECT id, team_id, name, goal, start_date, end_date, state, created_by, created_at, updated_at, closed_at
+		FROM sprints
+		WHERE team_id = ?`
+	args := []interface{}{teamID.String()}
+	if state != nil {
+		query += " AND state = ?"
+		args = append(args, *state)
+	}
+	query += " ORDER BY start_date ASC, created_at ASC, id ASC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []SprintRecord
+	for rows.Next() {
+		record, err := scanSprintRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// Update сохраняет изменяемые поля спринта.
+func (r *SprintsRepo) Update(ctx context.Context, exec DBTX, record SprintRecord) error {
+	const methodCtx = "repo.SprintsRepo.Update"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	var goalValue interface{}
+	if record.Goal != nil {
+		goalValue = *record.Goal
+	}
+
+	_, err := exec.ExecContext(
+		ctx,
+		`UPDATE sprints
+		SET name = ?, goal = ?, start_date = ?, end_date = ?, state = ?, updated_at = ?, closed_at = ?
+		WHERE id = ?`,
+		record.Name,
+		goalValue,
+		record.StartDate.Format(time.DateOnly),
+		record.EndDate.Format(time.DateOnly),
+		record.State,
+		record.UpdatedAt,
+		record.ClosedAt,
+		record.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// ListUnfinishedTasks блокирует и возвращает id незавершенных задач спринта.
+func (r *SprintsRepo) ListUnfinishedTasks(ctx context.Context, tx *sql.Tx, sprintID uuid.UUID) ([]uuid.UUID, error) {
+	const methodCtx = "repo.SprintsRepo.ListUnfinishedTasks"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		"SELECT id FROM tasks WHERE sprint_id = ? AND status <> 'done' ORDER BY created_at ASC, id ASC FOR UPDATE",
+		sprintID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var idStr string
+		if err := rows.Scan(&idStr); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id задачи", methodCtx)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return ids, nil
+}
+
+// SetTasksSprint переносит задачи в спринт; nil sprintID возвращает их в бэклог.
+func (r *SprintsRepo) SetTasksSprint(ctx context.Context, tx *sql.Tx, taskIDs []uuid.UUID, sprintID *uuid.UUID, updatedAt time.Time) error {
+	const methodCtx = "repo.SprintsRepo.SetTasksSprint"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+	if len(taskIDs) == 0 {
+		return nil
+	}
+
+	var sprintValue interface{}
+	if sprintID != nil {
+		sprintValue = sprintID.String()
+	}
+
+	args := []interface{}{sprintValue, updatedAt}
+	for _, taskID := range taskIDs {
+		args = append(args, taskID.String())
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		"UPDATE tasks SET sprint_id = ?, updated_at = ? WHERE id IN ("+placeholders(len(taskIDs))+")",
+		args...,
+	); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+func scanSprintRecord(scanner interface {
+	Scan(dest ...interface{}) error
+}) (SprintRecord, error) {
+	var record SprintRecord
+	var idStr, teamIDStr, createdByStr string
+	var goal sql.NullString
+	var updatedAt, closedAt sql.NullTime
+
+	if err := scanner.Scan(
+		&idStr,
+		&teamIDStr,
+		&record.Name,
+		&goal,
+		&record.StartDate,
+		&record.EndDate,
+		&record.State,
+		&createdByStr,
+		&record.CreatedAt,
+		&updatedAt,
+		&closedAt,
+	); err != nil {
+		return SprintRecord{}, err
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return SprintRecord{}, fmt.Errorf("некорректный id спринта")
+	}
+	teamID, err := uuid.Parse(teamIDStr)
+	if err != nil {
+		return SprintRecord{}, fmt.Errorf("некорректный id команды")
+	}
+	createdBy, err := uuid.Parse(createdByStr)
+	if err != nil {
+		return SprintRecord{}, fmt.Errorf("некорректный created_by")
+	}
+	record.ID = id
+	record.TeamID = teamID
+	record.CreatedBy = createdBy
+
+	if goal.Valid {
+		record.Goal = &goal.String
+	}
+	if updatedAt.Valid {
+		record.UpdatedAt = &updatedAt.Time
+	}
+	if closedAt.Valid {
+		record.ClosedAt = &closedAt.Time
+	}
+
+	return record, nil
+}
@@ -0,0 +1,166 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskAssigneesRepo реализует доступ к исполнителям задач.
+// Основной исполнитель дублируется в tasks.assignee_id для обратной совместимости.
+type TaskAssigneesRepo struct {
+	db *sql.DB
+}
+
+// NewTaskAssigneesRepo создает репозиторий исполнителей.
+func NewTaskAssigneesRepo(db *sql.DB) *TaskAssigneesRepo {
+	const methodCtx = "repo.NewTaskAssigneesRepo"
+
+	slog.Debug("инициализация репозитория исполнителей", slog.String("context", methodCtx))
+
+	return &TaskAssigneesRepo{db: db}
+}
+
+// Replace заменяет состав исполнителей задачи. Дата назначения оставшихся исполнителей сохраняется.
+func (r *TaskAssigneesRepo) Replace(ctx context.Context, exec DBTX, taskID uuid.UUID, primaryID *uuid.UUID, userIDs []uuid.UUID, createdAt time.Time) error {
+	const methodCtx = "repo.TaskAssigneesRepo.Replace"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	deleteQuery := "DELETE FROM task_assignees WHERE task_id = ?"
+	deleteArgs := []interface{}{taskID.String()}
+	if len(userIDs) > 0 {
+		deleteQuery += " AND user_id NOT IN (" + placeholders(len(userIDs)) + ")"
+		for _, userID := range userIDs {
+			deleteArgs = append(deleteArgs, userID.String())
+		}
+	}
+	if _, err := exec.ExecContext(ctx, deleteQuery, deleteArgs...); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	for _, userID := range userIDs {
+		isPrimary := primaryID != nil && *primaryID == userID
+		_, err := exec.ExecContext(
+			ctx,
+			`INSERT INTO task_assignees (task_id, user_id, is_primary, created_at)
+			VALUES (?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE is_primary = VALUES(is_primary)`,
+			taskID.String(),
+			userID.String(),
+			isPrimary,
+			createdAt,
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	return nil
+}
+
+// ListByTask возвращает исполнителей задачи, основной исполнитель идет первым.
+func (r *TaskAssigneesRepo) ListByTask(ctx context.Context, exec DBTX, taskID uuid.UUID) ([]uuid.UUID, error) {
+	const methodCtx = "repo.TaskAssigneesRepo.ListByTask"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	rows, err := exec.QueryContext(
+		ctx,
+		`SELECT task_id, user_id
+		FROM task_assignees
+		WHERE task_id = ?
+		ORDER BY is_primary DESC, created_at ASC, user_id ASC`,
+		taskID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	grouped, err := scanAssignees(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return grouped[taskID], nil
+}
+
+// ListByTasks возвращает исполнителей для набора задач.
+func (r *TaskAssigneesRepo) ListByTasks(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	const methodCtx = "repo.TaskAssigneesRepo.ListByTasks"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if len(taskIDs) == 0 {
+		return map[uuid.UUID][]uuid.UUID{}, nil
+	}
+
+	args := make([]interface{}, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		args = append(args, taskID.String())
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT task_id, user_id
+		FROM task_assignees
+		WHERE task_id IN (`+placeholders(len(taskIDs))+`)
+		ORDER BY task_id, is_primary DESC, created_at ASC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	grouped, err := scanAssignees(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return grouped, nil
+}
+
+func scanAssignees(rows *sql.Rows) (map[uuid.UUID][]uuid.UUID, error) {
+	grouped := map[uuid.UUID][]uuid.UUID{}
+	for rows.Next() {
+		var taskIDStr, userIDStr string
+		if err := rows.Scan(&taskIDStr, &userIDStr); err != nil {
+			return nil, err
+		}
+		taskID, err := uuid.Parse(taskIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("некорректный task_id")
+		}
+		userID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("некорректный user_id")
+		}
+		grouped[taskID] = append(grouped[taskID], userID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return grouped, nil
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
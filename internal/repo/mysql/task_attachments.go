@@ -0,0 +1,288 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentTargetTask и AttachmentTargetComment определяют, к чему привязано вложение.
+const (
+	AttachmentTargetTask    = "task"
+	AttachmentTargetComment = "comment"
+)
+
+// AttachmentRecord описывает метаданные вложения.
+type AttachmentRecord struct {
+	ID          uuid.UUID
+	TaskID      uuid.UUID
+	CommentID   *uuid.UUID
+	Target      string
+	UploadedBy  uuid.UUID
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	StorageKey  string
+	CreatedAt   time.Time
+}
+
+// TaskAttachmentsRepo реализует доступ к метаданным вложений.
+//
+// При удалении задачи или комментария внешние ключи обнуляют task_id/comment_id,
+// и такие записи становятся "осиротевшими": их объекты удаляет фоновая очистка.
+type TaskAttachmentsRepo struct {
+	db *sql.DB
+}
+
+// NewTaskAttachmentsRepo создает репозиторий вложений.
+func NewTaskAttachmentsRepo(db *sql.DB) *TaskAttachmentsRepo {
+	const methodCtx = "repo.NewTaskAttachmentsRepo"
+
+	slog.Debug("инициализация репозитория вложений", slog.String("context", methodCtx))
+
+	return &TaskAttachmentsRepo{db: db}
+}
+
+// Create сохраняет метаданные вложения.
+func (r *TaskAttachmentsRepo) Create(ctx context.Context, exec DBTX, record AttachmentRecord) error {
+	const methodCtx = "repo.TaskAttachmentsRepo.Create"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	var commentID interface{}
+	if record.CommentID != nil {
+		commentID = record.CommentID.String()
+	}
+
+	_, err := exec.ExecContext(
+		ctx,
+		`INSERT INTO task_attachments
+		(id, task_id, comment_id, target, uploaded_by, file_name, content_type, size_bytes, storage_key, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.ID.String(),
+		record.TaskID.String(),
+		commentID,
+		record.Target,
+		record.UploadedBy.String(),
+		record.FileName,
+		record.ContentType,
+		record.SizeBytes,
+		record.StorageKey,
+		record.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// ListByTask возвращает вложения задачи и ее комментариев.
+func (r *TaskAttachmentsRepo) ListByTask(ctx context.Context, taskID uuid.UUID) ([]AttachmentRecord, error) {
+	const methodCtx = "repo.TaskAttachmentsRepo.ListByTask"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, task_id, comment_id, target, uploaded_by, file_name, content_type, size_bytes, storage_key, created_at
+		FROM task_attachments
+		WHERE task_id = ? AND (target = 'task' OR comment_id IS NOT NULL)
+		ORDER BY created_at ASC, id ASC`,
+		taskID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []AttachmentRecord
+	for rows.Next() {
+		record, err := scanAttachmentRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// Get возвращает вложение задачи по id.
+func (r *TaskAttachmentsRepo) Get(ctx context.Context, taskID uuid.UUID, attachmentID uuid.UUID) (AttachmentRecord, error) {
+	const methodCtx = "repo.TaskAttachmentsRepo.Get"
+
+	if r == nil || r.db == nil {
+		return AttachmentRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	record, err := scanAttachmentRecord(r.db.QueryRowContext(
+		ctx,
+		`SELECT id, task_id, comment_id, target, uploaded_by, file_name, content_type, size_bytes, storage_key, created_at
+		FROM task_attachments
+		WHERE id = ? AND task_id = ? AND (target = 'task' OR comment_id IS NOT NULL)`,
+		attachmentID.String(),
+		taskID.String(),
+	))
+	if err != nil {
+		return AttachmentRecord{}, err
+	}
+	return record, nil
+}
+
+// Detach отвязывает вложение от задачи и комментария; объект удалит фоновая очистка.
+func (r *TaskAttachmentsRepo) Detach(ctx context.Context, attachmentID uuid.UUID) error {
+	const methodCtx = "repo.TaskAttachmentsRepo.Detach"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"UPDATE task_attachments SET task_id = NULL, comment_id = NULL WHERE id = ?",
+		attachmentID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// ListOrphans возвращает вложения, потерявшие задачу или комментарий.
+func (r *TaskAttachmentsRepo) ListOrphans(ctx context.Context, limit int) ([]AttachmentRecord, error) {
+	const methodCtx = "repo.TaskAttachmentsRepo.ListOrphans"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, task_id, comment_id, target, uploaded_by, file_name, content_type, size_bytes, storage_key, created_at
+		FROM task_attachments
+		WHERE task_id IS NULL OR (target = 'comment' AND comment_id IS NULL)
+		ORDER BY created_at ASC
+		LIMIT ?`,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []AttachmentRecord
+	for rows.Next() {
+		record, err := scanAttachmentRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// CountSharedKey возвращает количество других вложений, ссылающихся на тот же объект хранилища.
+// Копии вложений при клонировании задач используют общий storage_key.
+func (r *TaskAttachmentsRepo) CountSharedKey(ctx context.Context, storageKey string, excludeID uuid.UUID) (int, error) {
+	const methodCtx = "repo.TaskAttachmentsRepo.CountSharedKey"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM task_attachments WHERE storage_key = ? AND id <> ?",
+		storageKey,
+		excludeID.String(),
+	).Scan(&total); err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return total, nil
+}
+
+// Delete удаляет метаданные вложения.
+func (r *TaskAttachmentsRepo) Delete(ctx context.Context, attachmentID uuid.UUID) error {
+	const methodCtx = "repo.TaskAttachmentsRepo.Delete"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	_, err := r.db.ExecContext(ctx, "DELETE FROM task_attachments WHERE id = ?", attachmentID.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+func scanAttachmentRecord(scanner interface {
+	Scan(dest ...interface{}) error
+}) (AttachmentRecord, error) {
+	var record AttachmentRecord
+	var idStr, uploadedByStr string
+	var taskIDStr, commentIDStr sql.NullString
+
+	if err := scanner.Scan(
+		&idStr,
+		&taskIDStr,
+		&commentIDStr,
+		&record.Target,
+		&uploadedByStr,
+		&record.FileName,
+		&record.ContentType,
+		&record.SizeBytes,
+		&record.StorageKey,
+		&record.CreatedAt,
+	); err != nil {
+		return AttachmentRecord{}, err
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return AttachmentRecord{}, fmt.Errorf("некорректный id вложения")
+	}
+	uploadedBy, err := uuid.Parse(uploadedByStr)
+	if err != nil {
+		return AttachmentRecord{}, fmt.Errorf("некорректный uploaded_by")
+	}
+
+	record.ID = id
+	record.UploadedBy = uploadedBy
+
+	if taskIDStr.Valid {
+		taskID, err := uuid.Parse(taskIDStr.String)
+		if err != nil {
+			return AttachmentRecord{}, fmt.Errorf("некорректный task_id")
+		}
+		record.TaskID = taskID
+	}
+	if commentIDStr.Valid {
+		commentID, err := uuid.Parse(commentIDStr.String)
+		if err != nil {
+			return AttachmentRecord{}, fmt.Errorf("некорректный comment_id")
+		}
+		record.CommentID = &commentID
+	}
+
+	return record, nil
+}
@@ -12,12 +12,29 @@ import (
 )
 
 // TaskHistoryRecord описывает запись истории.
+// Changes хранит пары {"from", "to"} по полям в формате версии SchemaVersion.
+// RevertsID указывает на отмененную запись, если изменение было откатом.
 type TaskHistoryRecord struct {
-	ID        uuid.UUID
+	ID            uuid.UUID
+	TaskID        uuid.UUID
+	ChangedBy     uuid.UUID
+	Event         string
+	Changes       map[string]interface{}
+	SchemaVersion int
+	RevertsID     *uuid.UUID
+	ChangedAt     time.Time
+}
+
+// TaskHistoryFilter описывает фильтры истории задачи.
+// Field должен быть проверен вызывающим кодом: он подставляется в JSON-путь.
+type TaskHistoryFilter struct {
 	TaskID    uuid.UUID
-	ChangedBy uuid.UUID
-	Changes   map[string]interface{}
-	ChangedAt time.Time
+	Field     *string
+	ChangedBy *uuid.UUID
+	From      *time.Time
+	To        *time.Time
+	Page      int
+	PerPage   int
 }
 
 // TaskHistoryRepo реализует доступ к истории задач.
@@ -50,13 +67,22 @@ func (r *TaskHistoryRepo) Add(ctx context.Context, exec DBTX, record TaskHistory
 		return fmt.Errorf("%s: ошибка сериализации изменений", methodCtx)
 	}
 
+	var revertsValue interface{}
+	if record.RevertsID != nil {
+		revertsValue = record.RevertsID.String()
+	}
+
 	_, err = exec.ExecContext(
 		ctx,
-		"INSERT INTO task_history (id, task_id, changed_by, changes, changed_at) VALUES (?, ?, ?, ?, ?)",
+		`INSERT INTO task_history (id, task_id, changed_by, event, changes, schema_version, reverts_id, changed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
 		record.ID.String(),
 		record.TaskID.String(),
 		record.ChangedBy.String(),
+		record.Event,
 		payload,
+		record.SchemaVersion,
+		revertsValue,
 		record.ChangedAt,
 	)
 	if err != nil {
@@ -65,19 +91,20 @@ func (r *TaskHistoryRepo) Add(ctx context.Context, exec DBTX, record TaskHistory
 	return nil
 }
 
-// ListByTask возвращает историю по задаче.
-func (r *TaskHistoryRepo) ListByTask(ctx context.Context, taskID uuid.UUID) ([]TaskHistoryRecord, error) {
-	const methodCtx = "repo.TaskHistoryRepo.ListByTask"
+// List возвращает страницу истории задачи в хронологическом порядке.
+func (r *TaskHistoryRepo) List(ctx context.Context, filter TaskHistoryFilter) ([]TaskHistoryRecord, error) {
+	const methodCtx = "repo.TaskHistoryRepo.List"
 
 	if r == nil || r.db == nil {
 		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
 	}
 
-	rows, err := r.db.QueryContext(
-		ctx,
-		"SELECT id, task_id, changed_by, changes, changed_at FROM task_history WHERE task_id = ? ORDER BY changed_at ASC",
-		taskID.String(),
-	)
+	where, args := historyWhere(filter)
+	query := `SELECT id, task_id, changed_by, event, changes, schema_version, reverts_id, changed_at
+		FROM task_history` + where + " ORDER BY changed_at ASC, id ASC LIMIT ? OFFSET ?"
+	args = append(args, filter.PerPage, (filter.Page-1)*filter.PerPage)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
@@ -85,44 +112,177 @@ func (r *TaskHistoryRepo) ListByTask(ctx context.Context, taskID uuid.UUID) ([]T
 
 	var items []TaskHistoryRecord
 	for rows.Next() {
-		var idStr, taskIDStr, changedByStr string
-		var changesData []byte
-		var changedAt time.Time
-		if err := rows.Scan(&idStr, &taskIDStr, &changedByStr, &changesData, &changedAt); err != nil {
+		record, err := scanTaskHistoryRecord(rows)
+		if err != nil {
 			return nil, fmt.Errorf("%s: %w", methodCtx, err)
 		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
 
-		id, err := uuid.Parse(idStr)
-		if err != nil {
-			return nil, fmt.Errorf("%s: некорректный id истории", methodCtx)
-		}
-		taskUUID, err := uuid.Parse(taskIDStr)
-		if err != nil {
-			return nil, fmt.Errorf("%s: некорректный task_id", methodCtx)
-		}
-		changedBy, err := uuid.Parse(changedByStr)
-		if err != nil {
-			return nil, fmt.Errorf("%s: некорректный changed_by", methodCtx)
-		}
+	return items, nil
+}
 
-		changes := map[string]interface{}{}
-		if len(changesData) > 0 {
-			if err := json.Unmarshal(changesData, &changes); err != nil {
-				return nil, fmt.Errorf("%s: ошибка разбора изменений", methodCtx)
-			}
-		}
+// Count возвращает количество записей истории по фильтрам.
+func (r *TaskHistoryRepo) Count(ctx context.Context, filter TaskHistoryFilter) (int, error) {
+	const methodCtx = "repo.TaskHistoryRepo.Count"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	where, args := historyWhere(filter)
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM task_history"+where, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return total, nil
+}
+
+// Get возвращает запись истории задачи по id.
+func (r *TaskHistoryRepo) Get(ctx context.Context, exec DBTX, taskID uuid.UUID, historyID uuid.UUID) (TaskHistoryRecord, error) {
+	const methodCtx = "repo.TaskHistoryRepo.Get"
+
+	if r == nil || r.db == nil {
+		return TaskHistoryRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	return scanTaskHistoryRecord(exec.QueryRowContext(
+		ctx,
+		`SELECT id, task_id, changed_by, event, changes, schema_version, reverts_id, changed_at
+		FROM task_history
+		WHERE id = ? AND task_id = ?`,
+		historyID.String(),
+		taskID.String(),
+	))
+}
+
+// ListByTasks возвращает всю историю нескольких задач, сгруппированную по задаче в хронологическом порядке.
+func (r *TaskHistoryRepo) ListByTasks(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]TaskHistoryRecord, error) {
+	const methodCtx = "repo.TaskHistoryRepo.ListByTasks"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if len(taskIDs) == 0 {
+		return map[uuid.UUID][]TaskHistoryRecord{}, nil
+	}
+
+	args := make([]interface{}, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		args = append(args, taskID.String())
+	}
 
-		items = append(items, TaskHistoryRecord{
-			ID:        id,
-			TaskID:    taskUUID,
-			ChangedBy: changedBy,
-			Changes:   changes,
-			ChangedAt: changedAt,
-		})
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, task_id, changed_by, event, changes, schema_version, reverts_id, changed_at
+		FROM task_history
+		WHERE task_id IN (`+placeholders(len(taskIDs))+`)
+		ORDER BY task_id, changed_at ASC, id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	grouped := map[uuid.UUID][]TaskHistoryRecord{}
+	for rows.Next() {
+		record, err := scanTaskHistoryRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		grouped[record.TaskID] = append(grouped[record.TaskID], record)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	return items, nil
+	return grouped, nil
+}
+
+func historyWhere(filter TaskHistoryFilter) (string, []interface{}) {
+	where := " WHERE task_id = ?"
+	args := []interface{}{filter.TaskID.String()}
+
+	if filter.Field != nil {
+		where += " AND JSON_CONTAINS_PATH(changes, 'one', ?)"
+		args = append(args, "$."+*filter.Field)
+	}
+	if filter.ChangedBy != nil {
+		where += " AND changed_by = ?"
+		args = append(args, filter.ChangedBy.String())
+	}
+	if filter.From != nil {
+		where += " AND changed_at >= ?"
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		where += " AND changed_at < ?"
+		args = append(args, *filter.To)
+	}
+
+	return where, args
+}
+
+func scanTaskHistoryRecord(scanner interface {
+	Scan(dest ...interface{}) error
+}) (TaskHistoryRecord, error) {
+	var record TaskHistoryRecord
+	var idStr, taskIDStr, changedByStr string
+	var changesData []byte
+	var reverts sql.NullString
+
+	if err := scanner.Scan(
+		&idStr,
+		&taskIDStr,
+		&changedByStr,
+		&record.Event,
+		&changesData,
+		&record.SchemaVersion,
+		&reverts,
+		&record.ChangedAt,
+	); err != nil {
+		return TaskHistoryRecord{}, err
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return TaskHistoryRecord{}, fmt.Errorf("некорректный id истории")
+	}
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		return TaskHistoryRecord{}, fmt.Errorf("некорректный task_id")
+	}
+	changedBy, err := uuid.Parse(changedByStr)
+	if err != nil {
+		return TaskHistoryRecord{}, fmt.Errorf("некорректный changed_by")
+	}
+
+	record.ID = id
+	record.TaskID = taskID
+	record.ChangedBy = changedBy
+
+	record.Changes = map[string]interface{}{}
+	if len(changesData) > 0 {
+		if err := json.Unmarshal(changesData, &record.Changes); err != nil {
+			return TaskHistoryRecord{}, fmt.Errorf("ошибка разбора изменений")
+		}
+	}
+
+	if reverts.Valid {
+		revertsID, err := uuid.Parse(reverts.String)
+		if err != nil {
+			return TaskHistoryRecord{}, fmt.Errorf("некорректный reverts_id")
+		}
+		record.RevertsID = &revertsID
+	}
+
+	return record, nil
 }
@@ -0,0 +1,378 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Статусы задания импорта.
+const (
+	TaskImportPending   = "pending"
+	TaskImportRunning   = "running"
+	TaskImportCompleted = "completed"
+	TaskImportFailed    = "failed"
+)
+
+// ErrImportNotRunning возвращается, если задание уже не выполняется: например, его завершил FailStale.
+var ErrImportNotRunning = errors.New("задание импорта не выполняется")
+
+// TaskImportRowError описывает ошибку в строке импорта. Row считается с 1 без учета заголовка.
+type TaskImportRowError struct {
+	Row   int    `json:"row"`
+	Field string `json:"field,omitempty"`
+	Error string `json:"error"`
+}
+
+// TaskImportRecord описывает задание импорта задач.
+// Mapping сопоставляет поле задачи с колонкой (CSV) или ключом (NDJSON) исходных данных.
+type TaskImportRecord struct {
+	ID            uuid.UUID
+	TeamID        uuid.UUID
+	CreatedBy     uuid.UUID
+	Format        string
+	Mapping       map[string]string
+	DryRun        bool
+	Status        string
+	Payload       []byte
+	TotalRows     int
+	ProcessedRows int
+	CreatedRows   int
+	RowErrors     []TaskImportRowError
+	ErrorMessage  *string
+	CreatedAt     time.Time
+	StartedAt     *time.Time
+	FinishedAt    *time.Time
+}
+
+// TaskImportsRepo реализует хранение заданий импорта задач.
+type TaskImportsRepo struct {
+	db *sql.DB
+}
+
+// NewTaskImportsRepo создает репозиторий заданий импорта.
+func NewTaskImportsRepo(db *sql.DB) *TaskImportsRepo {
+	const methodCtx = "repo.NewTaskImportsRepo"
+
+	slog.Debug("инициализация репозитория импорта задач", slog.String("context", methodCtx))
+
+	return &TaskImportsRepo{db: db}
+}
+
+// Create сохраняет задание импорта вместе с исходными данными.
+func (r *TaskImportsRepo) Create(ctx context.Context, record TaskImportRecord) error {
+	const methodCtx = "repo.TaskImportsRepo.Create"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var mappingValue interface{}
+	if len(record.Mapping) > 0 {
+		payload, err := json.Marshal(record.Mapping)
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		mappingValue = payload
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO task_imports (id, team_id, created_by, format, mapping, dry_run, status, payload, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.ID.String(),
+		record.TeamID.String(),
+		record.CreatedBy.String(),
+		record.Format,
+		mappingValue,
+		record.DryRun,
+		record.Status,
+		record.Payload,
+		record.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Get возвращает задание импорта команды без исходных данных.
+func (r *TaskImportsRepo) Get(ctx context.Context, teamID uuid.UUID, importID uuid.UUID) (TaskImportRecord, error) {
+	const methodCtx = "repo.TaskImportsRepo.Get"
+
+	if r == nil || r.db == nil {
+		return TaskImportRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	row := r.db.QueryRowContext(
+		ctx,
+		`SELECT id, team_id, created_by, format, mapping, dry_run, status, '', total_rows, processed_rows, created_rows,
+			row_errors, error_message, created_at, started_at, finished_at
+		FROM task_imports
+		WHERE id = ? AND team_id = ?`,
+		importID.String(),
+		teamID.String(),
+	)
+
+	return scanTaskImportRecord(row)
+}
+
+// ClaimNext переводит самое раннее ожидающее задание в статус running и возвращает его
+// вместе с исходными данными. SKIP LOCKED позволяет нескольким экземплярам разбирать очередь.
+// Задание, прерванное остановкой процесса, повторно не запускается: это могло бы создать дубликаты
+// уже вставленных пакетов. Такие задания завершает FailStale.
+func (r *TaskImportsRepo) ClaimNext(ctx context.Context, startedAt time.Time) (TaskImportRecord, bool, error) {
+	const methodCtx = "repo.TaskImportsRepo.ClaimNext"
+
+	if r == nil || r.db == nil {
+		return TaskImportRecord{}, false, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return TaskImportRecord{}, false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	row := tx.QueryRowContext(
+		ctx,
+		`SELECT id, team_id, created_by, format, mapping, dry_run, status, payload, total_rows, processed_rows, created_rows,
+			row_errors, error_message, created_at, started_at, finished_at
+		FROM task_imports
+		WHERE status = ?
+		ORDER BY created_at ASC
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED`,
+		TaskImportPending,
+	)
+	record, err := scanTaskImportRecord(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return TaskImportRecord{}, false, nil
+	}
+	if err != nil {
+		return TaskImportRecord{}, false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		"UPDATE task_imports SET status = ?, started_at = ?, heartbeat_at = ? WHERE id = ?",
+		TaskImportRunning,
+		startedAt,
+		startedAt,
+		record.ID.String(),
+	); err != nil {
+		return TaskImportRecord{}, false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return TaskImportRecord{}, false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	record.Status = TaskImportRunning
+	record.StartedAt = &startedAt
+	return record, true, nil
+}
+
+// FailStale завершает статусом failed выполняющиеся задания, прогресс которых не обновлялся с момента
+// раньше heartbeatBefore, и возвращает их количество. Созданные задачи и прогресс сохраняются,
+// исходные данные очищаются.
+func (r *TaskImportsRepo) FailStale(ctx context.Context, heartbeatBefore time.Time, message string, finishedAt time.Time) (int, error) {
+	const methodCtx = "repo.TaskImportsRepo.FailStale"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	res, err := r.db.ExecContext(
+		ctx,
+		`UPDATE task_imports
+		SET status = ?, error_message = ?, finished_at = ?, payload = ''
+		WHERE status = ? AND heartbeat_at <= ?`,
+		TaskImportFailed,
+		message,
+		finishedAt,
+		TaskImportRunning,
+		heartbeatBefore,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return int(affected), nil
+}
+
+// UpdateProgress сохраняет прогресс выполняющегося задания и отмечает heartbeat_at.
+// Если задание уже не выполняется, возвращается ErrImportNotRunning.
+func (r *TaskImportsRepo) UpdateProgress(ctx context.Context, importID uuid.UUID, totalRows int, processedRows int, createdRows int, heartbeatAt time.Time) error {
+	const methodCtx = "repo.TaskImportsRepo.UpdateProgress"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	res, err := r.db.ExecContext(
+		ctx,
+		`UPDATE task_imports SET total_rows = ?, processed_rows = ?, created_rows = ?, heartbeat_at = ?
+		WHERE id = ? AND status = ?`,
+		totalRows,
+		processedRows,
+		createdRows,
+		heartbeatAt,
+		importID.String(),
+		TaskImportRunning,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	// MySQL не считает строку затронутой, если значения не изменились, поэтому статус проверяется отдельно.
+	var status string
+	if err := r.db.QueryRowContext(ctx, "SELECT status FROM task_imports WHERE id = ?", importID.String()).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", methodCtx, ErrImportNotRunning)
+		}
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if status != TaskImportRunning {
+		return fmt.Errorf("%s: %w", methodCtx, ErrImportNotRunning)
+	}
+	return nil
+}
+
+// Finish завершает задание: сохраняет итоговый статус, счетчики и ошибки строк.
+// Исходные данные после завершения больше не нужны и очищаются. Завершить можно только выполняющееся
+// задание, иначе возвращается ErrImportNotRunning.
+func (r *TaskImportsRepo) Finish(ctx context.Context, record TaskImportRecord) error {
+	const methodCtx = "repo.TaskImportsRepo.Finish"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var rowErrors interface{}
+	if len(record.RowErrors) > 0 {
+		payload, err := json.Marshal(record.RowErrors)
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		rowErrors = payload
+	}
+
+	var errorMessage interface{}
+	if record.ErrorMessage != nil {
+		errorMessage = *record.ErrorMessage
+	}
+
+	res, err := r.db.ExecContext(
+		ctx,
+		`UPDATE task_imports
+		SET status = ?, total_rows = ?, processed_rows = ?, created_rows = ?, row_errors = ?, error_message = ?,
+			finished_at = ?, payload = ''
+		WHERE id = ? AND status = ?`,
+		record.Status,
+		record.TotalRows,
+		record.ProcessedRows,
+		record.CreatedRows,
+		rowErrors,
+		errorMessage,
+		record.FinishedAt,
+		record.ID.String(),
+		TaskImportRunning,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", methodCtx, ErrImportNotRunning)
+	}
+	return nil
+}
+
+func scanTaskImportRecord(scanner interface {
+	Scan(dest ...interface{}) error
+}) (TaskImportRecord, error) {
+	var record TaskImportRecord
+	var idStr, teamIDStr, createdByStr string
+	var mappingData, rowErrorsData []byte
+	var errorMessage sql.NullString
+	var startedAt, finishedAt sql.NullTime
+
+	if err := scanner.Scan(
+		&idStr,
+		&teamIDStr,
+		&createdByStr,
+		&record.Format,
+		&mappingData,
+		&record.DryRun,
+		&record.Status,
+		&record.Payload,
+		&record.TotalRows,
+		&record.ProcessedRows,
+		&record.CreatedRows,
+		&rowErrorsData,
+		&errorMessage,
+		&record.CreatedAt,
+		&startedAt,
+		&finishedAt,
+	); err != nil {
+		return TaskImportRecord{}, err
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return TaskImportRecord{}, fmt.Errorf("некорректный id импорта")
+	}
+	teamID, err := uuid.Parse(teamIDStr)
+	if err != nil {
+		return TaskImportRecord{}, fmt.Errorf("некорректный id команды")
+	}
+	createdBy, err := uuid.Parse(createdByStr)
+	if err != nil {
+		return TaskImportRecord{}, fmt.Errorf("некорректный created_by")
+	}
+	record.ID = id
+	record.TeamID = teamID
+	record.CreatedBy = createdBy
+
+	if len(mappingData) > 0 {
+		if err := json.Unmarshal(mappingData, &record.Mapping); err != nil {
+			return TaskImportRecord{}, fmt.Errorf("некорректное сопоставление колонок: %w", err)
+		}
+	}
+	if len(rowErrorsData) > 0 {
+		if err := json.Unmarshal(rowErrorsData, &record.RowErrors); err != nil {
+			return TaskImportRecord{}, fmt.Errorf("некорректные ошибки строк: %w", err)
+		}
+	}
+	if errorMessage.Valid {
+		record.ErrorMessage = &errorMessage.String
+	}
+	if startedAt.Valid {
+		record.StartedAt = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		record.FinishedAt = &finishedAt.Time
+	}
+
+	return record, nil
+}
@@ -0,0 +1,110 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskLinkRelatesTo связывает задачу с исходной при клонировании.
+const TaskLinkRelatesTo = "relates_to"
+
+// TaskLinkRecord описывает связь задачи TaskID с задачей LinkedTaskID.
+type TaskLinkRecord struct {
+	TaskID       uuid.UUID
+	LinkedTaskID uuid.UUID
+	Type         string
+	CreatedBy    uuid.UUID
+	CreatedAt    time.Time
+}
+
+// TaskLinksRepo реализует хранение связей между задачами.
+type TaskLinksRepo struct {
+	db *sql.DB
+}
+
+// NewTaskLinksRepo создает репозиторий связей задач.
+func NewTaskLinksRepo(db *sql.DB) *TaskLinksRepo {
+	const methodCtx = "repo.NewTaskLinksRepo"
+
+	slog.Debug("инициализация репозитория связей задач", slog.String("context", methodCtx))
+
+	return &TaskLinksRepo{db: db}
+}
+
+// Add сохраняет связь. Повторная связь того же типа игнорируется.
+func (r *TaskLinksRepo) Add(ctx context.Context, exec DBTX, record TaskLinkRecord) error {
+	const methodCtx = "repo.TaskLinksRepo.Add"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	_, err := exec.ExecContext(
+		ctx,
+		"INSERT IGNORE INTO task_links (task_id, linked_task_id, link_type, created_by, created_at) VALUES (?, ?, ?, ?, ?)",
+		record.TaskID.String(),
+		record.LinkedTaskID.String(),
+		record.Type,
+		record.CreatedBy.String(),
+		record.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// ListByTask возвращает связи, в которых участвует задача, с обеих сторон.
+func (r *TaskLinksRepo) ListByTask(ctx context.Context, taskID uuid.UUID) ([]TaskLinkRecord, error) {
+	const methodCtx = "repo.TaskLinksRepo.ListByTask"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT task_id, linked_task_id, link_type, created_by, created_at
+		FROM task_links
+		WHERE task_id = ? OR linked_task_id = ?
+		ORDER BY created_at ASC, task_id ASC, linked_task_id ASC`,
+		taskID.String(),
+		taskID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []TaskLinkRecord
+	for rows.Next() {
+		var taskIDStr, linkedIDStr, createdByStr string
+		var record TaskLinkRecord
+		if err := rows.Scan(&taskIDStr, &linkedIDStr, &record.Type, &createdByStr, &record.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if record.TaskID, err = uuid.Parse(taskIDStr); err != nil {
+			return nil, fmt.Errorf("%s: некорректный task_id", methodCtx)
+		}
+		if record.LinkedTaskID, err = uuid.Parse(linkedIDStr); err != nil {
+			return nil, fmt.Errorf("%s: некорректный linked_task_id", methodCtx)
+		}
+		if record.CreatedBy, err = uuid.Parse(createdByStr); err != nil {
+			return nil, fmt.Errorf("%s: некорректный created_by", methodCtx)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
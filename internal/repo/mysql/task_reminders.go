@@ -0,0 +1,254 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Пороги напоминаний о сроке задачи.
+const (
+	ReminderDueSoon    = "due_soon"
+	ReminderOverdue    = "overdue"
+	ReminderEscalation = "escalation"
+)
+
+// ReminderCandidate описывает незавершенную задачу, по которой еще не отправлено напоминание порога.
+type ReminderCandidate struct {
+	TaskID   uuid.UUID
+	TeamID   uuid.UUID
+	TeamName string
+	Title    string
+	DueAt    time.Time
+}
+
+// ReminderFilter описывает выборку задач для напоминаний.
+// Отбираются задачи со сроком в полуинтервале (DueAfter, DueBefore]; nil DueAfter снимает нижнюю границу.
+type ReminderFilter struct {
+	Threshold string
+	DueBefore time.Time
+	DueAfter  *time.Time
+	Limit     int
+}
+
+// TaskRemindersRepo реализует учет отправленных напоминаний о сроках задач.
+type TaskRemindersRepo struct {
+	db *sql.DB
+}
+
+// NewTaskRemindersRepo создает репозиторий напоминаний.
+func NewTaskRemindersRepo(db *sql.DB) *TaskRemindersRepo {
+	const methodCtx = "repo.NewTaskRemindersRepo"
+
+	slog.Debug("инициализация репозитория напоминаний", slog.String("context", methodCtx))
+
+	return &TaskRemindersRepo{db: db}
+}
+
+// ListPending возвращает задачи, по текущему сроку которых напоминание порога еще не отправлено.
+func (r *TaskRemindersRepo) ListPending(ctx context.Context, filter ReminderFilter) ([]ReminderCandidate, error) {
+	const methodCtx = "repo.TaskRemindersRepo.ListPending"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	query := `SELECT t.id, t.team_id, tm.name, t.title, t.due_at
+		FROM tasks t
+		JOIN teams tm ON tm.id = t.team_id
+		WHERE t.status <> 'done' AND t.due_at IS NOT NULL AND t.due_at <= ? AND tm.deleted_at IS NULL`
+	args := []interface{}{filter.DueBefore}
+	if filter.DueAfter != nil {
+		query += " AND t.due_at > ?"
+		args = append(args, *filter.DueAfter)
+	}
+	query += ` AND NOT EXISTS (
+			SELECT 1 FROM task_reminders r
+			WHERE r.task_id = t.id AND r.threshold = ? AND r.due_at = t.due_at
+		)
+		ORDER BY t.due_at ASC, t.id ASC
+		LIMIT ?`
+	args = append(args, filter.Threshold, filter.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []ReminderCandidate
+	for rows.Next() {
+		var item ReminderCandidate
+		var taskIDStr, teamIDStr string
+		if err := rows.Scan(&taskIDStr, &teamIDStr, &item.TeamName, &item.Title, &item.DueAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		taskID, err := uuid.Parse(taskIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id задачи", methodCtx)
+		}
+		teamID, err := uuid.Parse(teamIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id команды", methodCtx)
+		}
+		item.TaskID = taskID
+		item.TeamID = teamID
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// Claim отмечает напоминание отправленным. false означает, что его уже отправил другой обработчик.
+func (r *TaskRemindersRepo) Claim(ctx context.Context, taskID uuid.UUID, threshold string, dueAt time.Time, sentAt time.Time) (bool, error) {
+	const methodCtx = "repo.TaskRemindersRepo.Claim"
+
+	if r == nil || r.db == nil {
+		return false, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO task_reminders (task_id, threshold, due_at, sent_at) VALUES (?, ?, ?, ?)",
+		taskID.String(),
+		threshold,
+		dueAt,
+		sentAt,
+	)
+	if err != nil {
+		if isDuplicate(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return true, nil
+}
+
+// Release снимает отметку, чтобы напоминание отправилось при следующем запуске.
+func (r *TaskRemindersRepo) Release(ctx context.Context, taskID uuid.UUID, threshold string, dueAt time.Time) error {
+	const methodCtx = "repo.TaskRemindersRepo.Release"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	if _, err := r.db.ExecContext(
+		ctx,
+		"DELETE FROM task_reminders WHERE task_id = ? AND threshold = ? AND due_at = ?",
+		taskID.String(),
+		threshold,
+		dueAt,
+	); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Delivered возвращает адреса, которым напоминание по текущему сроку уже доставлено.
+func (r *TaskRemindersRepo) Delivered(ctx context.Context, taskID uuid.UUID, threshold string, dueAt time.Time) ([]string, error) {
+	const methodCtx = "repo.TaskRemindersRepo.Delivered"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	return r.emails(ctx, methodCtx,
+		`SELECT email
+		FROM task_reminder_deliveries
+		WHERE task_id = ? AND threshold = ? AND due_at = ?
+		ORDER BY email`,
+		taskID.String(),
+		threshold,
+		dueAt,
+	)
+}
+
+// MarkDelivered отмечает доставку напоминания получателю. Повторная отметка не считается ошибкой.
+func (r *TaskRemindersRepo) MarkDelivered(ctx context.Context, taskID uuid.UUID, threshold string, dueAt time.Time, email string, sentAt time.Time) error {
+	const methodCtx = "repo.TaskRemindersRepo.MarkDelivered"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO task_reminder_deliveries (task_id, threshold, due_at, email, sent_at) VALUES (?, ?, ?, ?, ?)",
+		taskID.String(),
+		threshold,
+		dueAt,
+		email,
+		sentAt,
+	)
+	if err != nil && !isDuplicate(err) {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// AssigneeEmails возвращает адреса исполнителей задачи, не отключивших напоминания.
+func (r *TaskRemindersRepo) AssigneeEmails(ctx context.Context, taskID uuid.UUID) ([]string, error) {
+	const methodCtx = "repo.TaskRemindersRepo.AssigneeEmails"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	return r.emails(ctx, methodCtx,
+		`SELECT u.email
+		FROM task_assignees ta
+		JOIN users u ON u.id = ta.user_id
+		LEFT JOIN user_notification_settings ns ON ns.user_id = u.id
+		WHERE ta.task_id = ? AND COALESCE(ns.due_reminders, 1) = 1
+		ORDER BY u.email`,
+		taskID.String(),
+	)
+}
+
+// ManagerEmails возвращает адреса owner и admin команды, не отключивших напоминания.
+func (r *TaskRemindersRepo) ManagerEmails(ctx context.Context, teamID uuid.UUID) ([]string, error) {
+	const methodCtx = "repo.TaskRemindersRepo.ManagerEmails"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	return r.emails(ctx, methodCtx,
+		`SELECT u.email
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id
+		LEFT JOIN user_notification_settings ns ON ns.user_id = u.id
+		WHERE tm.team_id = ? AND tm.role IN ('owner', 'admin') AND COALESCE(ns.due_reminders, 1) = 1
+		ORDER BY u.email`,
+		teamID.String(),
+	)
+}
+
+func (r *TaskRemindersRepo) emails(ctx context.Context, methodCtx string, query string, args ...interface{}) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var emails []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		emails = append(emails, email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return emails, nil
+}
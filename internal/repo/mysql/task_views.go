@@ -0,0 +1,273 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrTaskViewExists возвращается, если у пользователя в команде уже есть представление с таким именем.
+var ErrTaskViewExists = errors.New("представление с таким именем уже существует")
+
+// TaskViewRecord описывает сохраненное представление списка задач.
+type TaskViewRecord struct {
+	ID          uuid.UUID
+	OwnerID     uuid.UUID
+	TeamID      uuid.UUID
+	Name        string
+	Status      *string
+	AssigneeIDs []uuid.UUID
+	Sort        string
+	Shared      bool
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+// TaskViewsRepo реализует хранение сохраненных представлений.
+type TaskViewsRepo struct {
+	db *sql.DB
+}
+
+// NewTaskViewsRepo создает репозиторий представлений.
+func NewTaskViewsRepo(db *sql.DB) *TaskViewsRepo {
+	const methodCtx = "repo.NewTaskViewsRepo"
+
+	slog.Debug("инициализация репозитория представлений задач", slog.String("context", methodCtx))
+
+	return &TaskViewsRepo{db: db}
+}
+
+// Create сохраняет представление. Для повторяющегося имени возвращает ErrTaskViewExists.
+func (r *TaskViewsRepo) Create(ctx context.Context, record TaskViewRecord) error {
+	const methodCtx = "repo.TaskViewsRepo.Create"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	assigneesValue, err := taskViewAssignees(record.AssigneeIDs)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	var statusValue interface{}
+	if record.Status != nil {
+		statusValue = *record.Status
+	}
+
+	_, err = r.db.ExecContext(
+		ctx,
+		`INSERT INTO task_views (id, owner_id, team_id, name, status, assignee_ids, sort, shared, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.ID.String(),
+		record.OwnerID.String(),
+		record.TeamID.String(),
+		record.Name,
+		statusValue,
+		assigneesValue,
+		record.Sort,
+		record.Shared,
+		record.CreatedAt,
+	)
+	if err != nil {
+		if isDuplicate(err) {
+			return fmt.Errorf("%s: %w", methodCtx, ErrTaskViewExists)
+		}
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Get возвращает представление по id.
+func (r *TaskViewsRepo) Get(ctx context.Context, viewID uuid.UUID) (TaskViewRecord, error) {
+	const methodCtx = "repo.TaskViewsRepo.Get"
+
+	if r == nil || r.db == nil {
+		return TaskViewRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	row := r.db.QueryRowContext(
+		ctx,
+		`SELECT id, owner_id, team_id, name, status, assignee_ids, sort, shared, created_at, updated_at
+		FROM task_views
+		WHERE id = ?`,
+		viewID.String(),
+	)
+
+	return scanTaskViewRecord(row)
+}
+
+// ListVisible возвращает представления команды, доступные пользователю: собственные и общие.
+func (r *TaskViewsRepo) ListVisible(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) ([]TaskViewRecord, error) {
+	const methodCtx = "repo.TaskViewsRepo.ListVisible"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, owner_id, team_id, name, status, assignee_ids, sort, shared, created_at, updated_at
+		FROM task_views
+		WHERE team_id = ? AND (owner_id = ? OR shared = 1)
+		ORDER BY name ASC, id ASC`,
+		teamID.String(),
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []TaskViewRecord
+	for rows.Next() {
+		record, err := scanTaskViewRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// Update сохраняет изменения представления. Для повторяющегося имени возвращает ErrTaskViewExists.
+func (r *TaskViewsRepo) Update(ctx context.Context, record TaskViewRecord) error {
+	const methodCtx = "repo.TaskViewsRepo.Update"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	assigneesValue, err := taskViewAssignees(record.AssigneeIDs)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	var statusValue interface{}
+	if record.Status != nil {
+		statusValue = *record.Status
+	}
+
+	_, err = r.db.ExecContext(
+		ctx,
+		`UPDATE task_views
+		SET name = ?, status = ?, assignee_ids = ?, sort = ?, shared = ?, updated_at = ?
+		WHERE id = ?`,
+		record.Name,
+		statusValue,
+		assigneesValue,
+		record.Sort,
+		record.Shared,
+		record.UpdatedAt,
+		record.ID.String(),
+	)
+	if err != nil {
+		if isDuplicate(err) {
+			return fmt.Errorf("%s: %w", methodCtx, ErrTaskViewExists)
+		}
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Delete удаляет представление.
+func (r *TaskViewsRepo) Delete(ctx context.Context, viewID uuid.UUID) error {
+	const methodCtx = "repo.TaskViewsRepo.Delete"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM task_views WHERE id = ?", viewID.String()); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+func taskViewAssignees(ids []uuid.UUID) (interface{}, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, id.String())
+	}
+	payload, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func scanTaskViewRecord(scanner interface {
+	Scan(dest ...interface{}) error
+}) (TaskViewRecord, error) {
+	var record TaskViewRecord
+	var idStr, ownerIDStr, teamIDStr string
+	var status sql.NullString
+	var assigneesData []byte
+	var updatedAt sql.NullTime
+
+	if err := scanner.Scan(
+		&idStr,
+		&ownerIDStr,
+		&teamIDStr,
+		&record.Name,
+		&status,
+		&assigneesData,
+		&record.Sort,
+		&record.Shared,
+		&record.CreatedAt,
+		&updatedAt,
+	); err != nil {
+		return TaskViewRecord{}, err
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return TaskViewRecord{}, fmt.Errorf("некорректный id представления")
+	}
+	ownerID, err := uuid.Parse(ownerIDStr)
+	if err != nil {
+		return TaskViewRecord{}, fmt.Errorf("некорректный owner_id")
+	}
+	teamID, err := uuid.Parse(teamIDStr)
+	if err != nil {
+		return TaskViewRecord{}, fmt.Errorf("некорректный id команды")
+	}
+	record.ID = id
+	record.OwnerID = ownerID
+	record.TeamID = teamID
+
+	if status.Valid {
+		record.Status = &status.String
+	}
+	if len(assigneesData) > 0 {
+		var values []string
+		if err := json.Unmarshal(assigneesData, &values); err != nil {
+			return TaskViewRecord{}, fmt.Errorf("некорректные исполнители представления: %w", err)
+		}
+		for _, value := range values {
+			assigneeID, err := uuid.Parse(value)
+			if err != nil {
+				return TaskViewRecord{}, fmt.Errorf("некорректный id исполнителя представления")
+			}
+			record.AssigneeIDs = append(record.AssigneeIDs, assigneeID)
+		}
+	}
+	if updatedAt.Valid {
+		record.UpdatedAt = &updatedAt.Time
+	}
+
+	return record, nil
+}
@@ -0,0 +1,127 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskWatcherRecord описывает подписку пользователя на задачу.
+type TaskWatcherRecord struct {
+	TaskID    uuid.UUID
+	UserID    uuid.UUID
+	CreatedAt time.Time
+}
+
+// TaskWatchersRepo реализует доступ к наблюдателям задач.
+type TaskWatchersRepo struct {
+	db *sql.DB
+}
+
+// NewTaskWatchersRepo создает репозиторий наблюдателей.
+func NewTaskWatchersRepo(db *sql.DB) *TaskWatchersRepo {
+	const methodCtx = "repo.NewTaskWatchersRepo"
+
+	slog.Debug("инициализация репозитория наблюдателей", slog.String("context", methodCtx))
+
+	return &TaskWatchersRepo{db: db}
+}
+
+// Add подписывает пользователя на задачу. Повторная подписка игнорируется.
+func (r *TaskWatchersRepo) Add(ctx context.Context, exec DBTX, taskID uuid.UUID, userID uuid.UUID, createdAt time.Time) error {
+	const methodCtx = "repo.TaskWatchersRepo.Add"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	_, err := exec.ExecContext(
+		ctx,
+		"INSERT IGNORE INTO task_watchers (task_id, user_id, created_at) VALUES (?, ?, ?)",
+		taskID.String(),
+		userID.String(),
+		createdAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Remove отписывает пользователя от задачи.
+func (r *TaskWatchersRepo) Remove(ctx context.Context, taskID uuid.UUID, userID uuid.UUID) error {
+	const methodCtx = "repo.TaskWatchersRepo.Remove"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"DELETE FROM task_watchers WHERE task_id = ? AND user_id = ?",
+		taskID.String(),
+		userID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// ListByTask возвращает наблюдателей задачи, которые состоят в ее команде.
+func (r *TaskWatchersRepo) ListByTask(ctx context.Context, taskID uuid.UUID) ([]TaskWatcherRecord, error) {
+	const methodCtx = "repo.TaskWatchersRepo.ListByTask"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT w.task_id, w.user_id, w.created_at
+		FROM task_watchers w
+		JOIN tasks t ON t.id = w.task_id
+		JOIN team_members tm ON tm.team_id = t.team_id AND tm.user_id = w.user_id
+		WHERE w.task_id = ?
+		ORDER BY w.created_at ASC, w.user_id ASC`,
+		taskID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []TaskWatcherRecord
+	for rows.Next() {
+		var taskIDStr, userIDStr string
+		var createdAt time.Time
+		if err := rows.Scan(&taskIDStr, &userIDStr, &createdAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		taskUUID, err := uuid.Parse(taskIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный task_id", methodCtx)
+		}
+		userUUID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный user_id", methodCtx)
+		}
+		items = append(items, TaskWatcherRecord{
+			TaskID:    taskUUID,
+			UserID:    userUUID,
+			CreatedAt: createdAt,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
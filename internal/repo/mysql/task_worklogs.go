@@ -0,0 +1,280 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrWorklogRunning возвращается, если у пользователя уже есть запущенный таймер.
+var ErrWorklogRunning = errors.New("таймер уже запущен")
+
+// WorklogRecord описывает запись о затраченном времени.
+// DurationMinutes равен nil, пока таймер запущен.
+type WorklogRecord struct {
+	ID              uuid.UUID
+	TaskID          uuid.UUID
+	UserID          uuid.UUID
+	StartedAt       time.Time
+	DurationMinutes *int
+	Note            *string
+	CreatedAt       time.Time
+}
+
+// TaskWorklogsRepo реализует доступ к учету времени по задачам.
+type TaskWorklogsRepo struct {
+	db *sql.DB
+}
+
+// NewTaskWorklogsRepo создает репозиторий учета времени.
+func NewTaskWorklogsRepo(db *sql.DB) *TaskWorklogsRepo {
+	const methodCtx = "repo.NewTaskWorklogsRepo"
+
+	slog.Debug("инициализация репозитория учета времени", slog.String("context", methodCtx))
+
+	return &TaskWorklogsRepo{db: db}
+}
+
+// Create сохраняет запись. Для второго запущенного таймера пользователя возвращает ErrWorklogRunning.
+func (r *TaskWorklogsRepo) Create(ctx context.Context, record WorklogRecord) error {
+	const methodCtx = "repo.TaskWorklogsRepo.Create"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var durationValue interface{}
+	if record.DurationMinutes != nil {
+		durationValue = *record.DurationMinutes
+	}
+
+	var noteValue interface{}
+	if record.Note != nil {
+		noteValue = *record.Note
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO task_worklogs (id, task_id, user_id, started_at, duration_minutes, note, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		record.ID.String(),
+		record.TaskID.String(),
+		record.UserID.String(),
+		record.StartedAt,
+		durationValue,
+		noteValue,
+		record.CreatedAt,
+	)
+	if err != nil {
+		if isDuplicate(err) {
+			return fmt.Errorf("%s: %w", methodCtx, ErrWorklogRunning)
+		}
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// ListByTask возвращает записи задачи.
+func (r *TaskWorklogsRepo) ListByTask(ctx context.Context, taskID uuid.UUID) ([]WorklogRecord, error) {
+	const methodCtx = "repo.TaskWorklogsRepo.ListByTask"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, task_id, user_id, started_at, duration_minutes, note, created_at
+		FROM task_worklogs
+		WHERE task_id = ?
+		ORDER BY started_at ASC, id ASC`,
+		taskID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []WorklogRecord
+	for rows.Next() {
+		record, err := scanWorklogRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// Get возвращает запись задачи по id.
+func (r *TaskWorklogsRepo) Get(ctx context.Context, taskID uuid.UUID, worklogID uuid.UUID) (WorklogRecord, error) {
+	const methodCtx = "repo.TaskWorklogsRepo.Get"
+
+	if r == nil || r.db == nil {
+		return WorklogRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	return scanWorklogRecord(r.db.QueryRowContext(
+		ctx,
+		`SELECT id, task_id, user_id, started_at, duration_minutes, note, created_at
+		FROM task_worklogs
+		WHERE id = ? AND task_id = ?`,
+		worklogID.String(),
+		taskID.String(),
+	))
+}
+
+// GetRunning возвращает запущенный таймер пользователя по задаче.
+func (r *TaskWorklogsRepo) GetRunning(ctx context.Context, taskID uuid.UUID, userID uuid.UUID) (WorklogRecord, error) {
+	const methodCtx = "repo.TaskWorklogsRepo.GetRunning"
+
+	if r == nil || r.db == nil {
+		return WorklogRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	return scanWorklogRecord(r.db.QueryRowContext(
+		ctx,
+		`SELECT id, task_id, user_id, started_at, duration_minutes, note, created_at
+		FROM task_worklogs
+		WHERE task_id = ? AND user_id = ? AND duration_minutes IS NULL`,
+		taskID.String(),
+		userID.String(),
+	))
+}
+
+// Update обновляет время начала, длительность и заметку записи.
+func (r *TaskWorklogsRepo) Update(ctx context.Context, record WorklogRecord) error {
+	const methodCtx = "repo.TaskWorklogsRepo.Update"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var durationValue interface{}
+	if record.DurationMinutes != nil {
+		durationValue = *record.DurationMinutes
+	}
+
+	var noteValue interface{}
+	if record.Note != nil {
+		noteValue = *record.Note
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"UPDATE task_worklogs SET started_at = ?, duration_minutes = ?, note = ? WHERE id = ?",
+		record.StartedAt,
+		durationValue,
+		noteValue,
+		record.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Stop завершает запущенный таймер. Возвращает false, если таймер уже остановлен.
+func (r *TaskWorklogsRepo) Stop(ctx context.Context, worklogID uuid.UUID, durationMinutes int, note *string) (bool, error) {
+	const methodCtx = "repo.TaskWorklogsRepo.Stop"
+
+	if r == nil || r.db == nil {
+		return false, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var noteValue interface{}
+	if note != nil {
+		noteValue = *note
+	}
+
+	res, err := r.db.ExecContext(
+		ctx,
+		`UPDATE task_worklogs
+		SET duration_minutes = ?, note = COALESCE(?, note)
+		WHERE id = ? AND duration_minutes IS NULL`,
+		durationMinutes,
+		noteValue,
+		worklogID.String(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return affected > 0, nil
+}
+
+// Delete удаляет запись.
+func (r *TaskWorklogsRepo) Delete(ctx context.Context, worklogID uuid.UUID) error {
+	const methodCtx = "repo.TaskWorklogsRepo.Delete"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	_, err := r.db.ExecContext(ctx, "DELETE FROM task_worklogs WHERE id = ?", worklogID.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+func scanWorklogRecord(scanner interface {
+	Scan(dest ...interface{}) error
+}) (WorklogRecord, error) {
+	var record WorklogRecord
+	var idStr, taskIDStr, userIDStr string
+	var duration sql.NullInt64
+	var note sql.NullString
+
+	if err := scanner.Scan(
+		&idStr,
+		&taskIDStr,
+		&userIDStr,
+		&record.StartedAt,
+		&duration,
+		&note,
+		&record.CreatedAt,
+	); err != nil {
+		return WorklogRecord{}, err
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return WorklogRecord{}, fmt.Errorf("некорректный id записи времени")
+	}
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		return WorklogRecord{}, fmt.Errorf("некорректный task_id")
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return WorklogRecord{}, fmt.Errorf("некорректный user_id")
+	}
+
+	record.ID = id
+	record.TaskID = taskID
+	record.UserID = userID
+
+	if duration.Valid {
+		value := int(duration.Int64)
+		record.DurationMinutes = &value
+	}
+	if note.Valid {
+		record.Note = &note.String
+	}
+
+	return record, nil
+}
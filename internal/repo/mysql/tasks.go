@@ -5,32 +5,84 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 // TaskRecord описывает запись задачи.
+// AssigneeID хранит основного исполнителя, AssigneeIDs заполняется из task_assignees.
 type TaskRecord struct {
-	ID          uuid.UUID
-	TeamID      uuid.UUID
-	Title       string
-	Description *string
-	Status      string
-	AssigneeID  *uuid.UUID
-	CreatedBy   uuid.UUID
-	CreatedAt   time.Time
-	UpdatedAt   *time.Time
-	CompletedAt *time.Time
+	ID              uuid.UUID
+	TeamID          uuid.UUID
+	Title           string
+	Description     *string
+	Status          string
+	Rank            string
+	SprintID        *uuid.UUID
+	AssigneeID      *uuid.UUID
+	AssigneeIDs     []uuid.UUID
+	EstimateMinutes *int
+	StoryPoints     *int
+	DueAt           *time.Time
+	CreatedBy       uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       *time.Time
+	CompletedAt     *time.Time
+	ArchivedAt      *time.Time
+}
+
+// Режимы отбора архивных задач.
+const (
+	TaskArchivedExclude = "exclude"
+	TaskArchivedInclude = "include"
+	TaskArchivedOnly    = "only"
+)
+
+// Порядки сортировки списка задач.
+const (
+	TaskSortCreatedAtDesc = "created_at_desc"
+	TaskSortCreatedAtAsc  = "created_at_asc"
+	TaskSortUpdatedAtDesc = "updated_at_desc"
+	TaskSortTitleAsc      = "title_asc"
+	TaskSortRank          = "rank"
+)
+
+// taskOrders сопоставляет порядок сортировки с выражением ORDER BY.
+var taskOrders = map[string]string{
+	TaskSortCreatedAtDesc: "created_at DESC",
+	TaskSortCreatedAtAsc:  "created_at ASC",
+	TaskSortUpdatedAtDesc: "COALESCE(updated_at, created_at) DESC",
+	TaskSortTitleAsc:      "title ASC",
+	TaskSortRank:          "tasks.rank ASC, created_at ASC, id ASC",
 }
 
 // TaskFilter описывает фильтры списка задач.
+// AssigneeIDs отбирает задачи, где назначен хотя бы один из пользователей.
+// Пустой или неизвестный Sort означает сортировку по дате создания от новых к старым.
+// Пустой Archived означает TaskArchivedExclude.
 type TaskFilter struct {
-	TeamID     uuid.UUID
-	Status     *string
-	AssigneeID *uuid.UUID
-	Page       int
-	PerPage    int
+	TeamID      uuid.UUID
+	Status      *string
+	AssigneeIDs []uuid.UUID
+	SprintID    *uuid.UUID
+	Archived    string
+	Sort        string
+	Page        int
+	PerPage     int
+}
+
+// UserTaskFilter описывает фильтры списка задач пользователя по нескольким командам.
+// Отбираются задачи команд TeamIDs, где пользователь назначен исполнителем или является автором.
+type UserTaskFilter struct {
+	UserID   uuid.UUID
+	TeamIDs  []uuid.UUID
+	Status   *string
+	Archived string
+	Sort     string
+	Page     int
+	PerPage  int
 }
 
 // TasksRepo реализует доступ к задачам.
@@ -63,11 +115,31 @@ func (r *TasksRepo) Create(ctx context.Context, exec DBTX, record TaskRecord) er
 		descValue = *record.Description
 	}
 
+	var sprintValue interface{}
+	if record.SprintID != nil {
+		sprintValue = record.SprintID.String()
+	}
+
 	var assigneeValue interface{}
 	if record.AssigneeID != nil {
 		assigneeValue = record.AssigneeID.String()
 	}
 
+	var estimateValue interface{}
+	if record.EstimateMinutes != nil {
+		estimateValue = *record.EstimateMinutes
+	}
+
+	var pointsValue interface{}
+	if record.StoryPoints != nil {
+		pointsValue = *record.StoryPoints
+	}
+
+	var dueValue interface{}
+	if record.DueAt != nil {
+		dueValue = *record.DueAt
+	}
+
 	var updatedValue interface{}
 	if record.UpdatedAt != nil {
 		updatedValue = *record.UpdatedAt
@@ -80,14 +152,19 @@ func (r *TasksRepo) Create(ctx context.Context, exec DBTX, record TaskRecord) er
 
 	_, err := exec.ExecContext(
 		ctx,
-		`INSERT INTO tasks (id, team_id, title, description, status, assignee_id, created_by, created_at, updated_at, completed_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		`INSERT INTO tasks (id, team_id, title, description, status, tasks.rank, sprint_id, assignee_id, estimate_minutes, story_points, due_at, created_by, created_at, updated_at, completed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		record.ID.String(),
 		record.TeamID.String(),
 		record.Title,
 		descValue,
 		record.Status,
+		record.Rank,
+		sprintValue,
 		assigneeValue,
+		estimateValue,
+		pointsValue,
+		dueValue,
 		record.CreatedBy.String(),
 		record.CreatedAt,
 		updatedValue,
@@ -107,20 +184,9 @@ func (r *TasksRepo) List(ctx context.Context, filter TaskFilter) ([]TaskRecord,
 		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
 	}
 
-	query := `SELECT id, team_id, title, description, status, assignee_id, created_by, created_at, updated_at, completed_at
-		FROM tasks WHERE team_id = ?`
-	args := []interface{}{filter.TeamID.String()}
-
-	if filter.Status != nil {
-		query += " AND status = ?"
-		args = append(args, *filter.Status)
-	}
-	if filter.AssigneeID != nil {
-		query += " AND assignee_id = ?"
-		args = append(args, filter.AssigneeID.String())
-	}
-
-	query += " ORDER BY created_at DESC LIMIT ? OFFSET ?"
+	where, args := taskWhere(filter)
+	query := `SELECT id, team_id, title, description, status, tasks.rank, sprint_id, assignee_id, estimate_minutes, story_points, due_at, created_by, created_at, updated_at, completed_at, archived_at
+		FROM tasks` + where + " ORDER BY " + taskOrder(filter.Sort) + " LIMIT ? OFFSET ?"
 	args = append(args, filter.PerPage, (filter.Page-1)*filter.PerPage)
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -152,25 +218,109 @@ func (r *TasksRepo) Count(ctx context.Context, filter TaskFilter) (int, error) {
 		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
 	}
 
-	query := "SELECT COUNT(*) FROM tasks WHERE team_id = ?"
-	args := []interface{}{filter.TeamID.String()}
+	where, args := taskWhere(filter)
 
-	if filter.Status != nil {
-		query += " AND status = ?"
-		args = append(args, *filter.Status)
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks"+where, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
 	}
-	if filter.AssigneeID != nil {
-		query += " AND assignee_id = ?"
-		args = append(args, filter.AssigneeID.String())
+	return total, nil
+}
+
+// ListByUser возвращает задачи пользователя по фильтрам.
+func (r *TasksRepo) ListByUser(ctx context.Context, filter UserTaskFilter) ([]TaskRecord, error) {
+	const methodCtx = "repo.TasksRepo.ListByUser"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
 	}
+	if len(filter.TeamIDs) == 0 {
+		return nil, nil
+	}
+
+	from, args := userTaskFrom(filter)
+	query := `SELECT id, team_id, title, description, status, tasks.rank, sprint_id, assignee_id, estimate_minutes, story_points, due_at, created_by, created_at, updated_at, completed_at, archived_at` +
+		from + " ORDER BY " + taskOrder(filter.Sort) + " LIMIT ? OFFSET ?"
+	args = append(args, filter.PerPage, (filter.Page-1)*filter.PerPage)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []TaskRecord
+	for rows.Next() {
+		record, err := scanTaskRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// CountByUser возвращает количество задач пользователя по фильтрам.
+func (r *TasksRepo) CountByUser(ctx context.Context, filter UserTaskFilter) (int, error) {
+	const methodCtx = "repo.TasksRepo.CountByUser"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if len(filter.TeamIDs) == 0 {
+		return 0, nil
+	}
+
+	from, args := userTaskFrom(filter)
 
 	var total int
-	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*)"+from, args...).Scan(&total); err != nil {
 		return 0, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 	return total, nil
 }
 
+// Stream последовательно передает в fn все задачи по фильтрам, читая их курсором без пагинации.
+// Page и PerPage фильтра не учитываются. Ошибка fn прерывает чтение и возвращается как есть.
+func (r *TasksRepo) Stream(ctx context.Context, filter TaskFilter, fn func(TaskRecord) error) error {
+	const methodCtx = "repo.TasksRepo.Stream"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	where, args := taskWhere(filter)
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, team_id, title, description, status, tasks.rank, sprint_id, assignee_id, estimate_minutes, story_points, due_at, created_by, created_at, updated_at, completed_at, archived_at
+		FROM tasks`+where+" ORDER BY created_at ASC, id ASC",
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		record, err := scanTaskRecord(rows)
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return nil
+}
+
 // GetForUpdate возвращает задачу для обновления с блокировкой.
 func (r *TasksRepo) GetForUpdate(ctx context.Context, tx *sql.Tx, taskID uuid.UUID) (TaskRecord, error) {
 	const methodCtx = "repo.TasksRepo.GetForUpdate"
@@ -184,7 +334,7 @@ func (r *TasksRepo) GetForUpdate(ctx context.Context, tx *sql.Tx, taskID uuid.UU
 
 	row := tx.QueryRowContext(
 		ctx,
-		"SELECT id, team_id, title, description, status, assignee_id, created_by, created_at, updated_at, completed_at FROM tasks WHERE id = ? FOR UPDATE",
+		"SELECT id, team_id, title, description, status, tasks.rank, sprint_id, assignee_id, estimate_minutes, story_points, due_at, created_by, created_at, updated_at, completed_at, archived_at FROM tasks WHERE id = ? FOR UPDATE",
 		taskID.String(),
 	)
 
@@ -207,27 +357,96 @@ func (r *TasksRepo) Update(ctx context.Context, tx *sql.Tx, record TaskRecord) e
 		descValue = *record.Description
 	}
 
+	var sprintValue interface{}
+	if record.SprintID != nil {
+		sprintValue = record.SprintID.String()
+	}
+
 	var assigneeValue interface{}
 	if record.AssigneeID != nil {
 		assigneeValue = record.AssigneeID.String()
 	}
 
+	var estimateValue interface{}
+	if record.EstimateMinutes != nil {
+		estimateValue = *record.EstimateMinutes
+	}
+
+	var pointsValue interface{}
+	if record.StoryPoints != nil {
+		pointsValue = *record.StoryPoints
+	}
+
+	var dueValue interface{}
+	if record.DueAt != nil {
+		dueValue = *record.DueAt
+	}
+
 	var completedValue interface{}
 	if record.CompletedAt != nil {
 		completedValue = *record.CompletedAt
 	}
 
+	var archivedValue interface{}
+	if record.ArchivedAt != nil {
+		archivedValue = *record.ArchivedAt
+	}
+
 	_, err := tx.ExecContext(
 		ctx,
 		`UPDATE tasks
-		SET title = ?, description = ?, status = ?, assignee_id = ?, updated_at = ?, completed_at = ?
+		SET title = ?, description = ?, status = ?, tasks.rank = ?, sprint_id = ?, assignee_id = ?, estimate_minutes = ?, story_points = ?,
+			due_at = ?, updated_at = ?, completed_at = ?, archived_at = ?
 		WHERE id = ?`,
 		record.Title,
 		descValue,
 		record.Status,
+		record.Rank,
+		sprintValue,
 		assigneeValue,
+		estimateValue,
+		pointsValue,
+		dueValue,
 		record.UpdatedAt,
 		completedValue,
+		archivedValue,
+		record.ID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Move переносит задачу в другую команду вместе с новым исполнителем, позицией и спринтом.
+func (r *TasksRepo) Move(ctx context.Context, tx *sql.Tx, record TaskRecord) error {
+	const methodCtx = "repo.TasksRepo.Move"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+
+	var sprintValue interface{}
+	if record.SprintID != nil {
+		sprintValue = record.SprintID.String()
+	}
+
+	var assigneeValue interface{}
+	if record.AssigneeID != nil {
+		assigneeValue = record.AssigneeID.String()
+	}
+
+	_, err := tx.ExecContext(
+		ctx,
+		"UPDATE tasks SET team_id = ?, tasks.rank = ?, sprint_id = ?, assignee_id = ?, updated_at = ? WHERE id = ?",
+		record.TeamID.String(),
+		record.Rank,
+		sprintValue,
+		assigneeValue,
+		record.UpdatedAt,
 		record.ID.String(),
 	)
 	if err != nil {
@@ -236,6 +455,131 @@ func (r *TasksRepo) Update(ctx context.Context, tx *sql.Tx, record TaskRecord) e
 	return nil
 }
 
+// TaskRankRecord описывает позицию задачи в колонке доски.
+type TaskRankRecord struct {
+	ID   uuid.UUID
+	Rank string
+}
+
+// ListColumn блокирует и возвращает задачи колонки доски (команда и статус) в порядке rank.
+// Задачи с одинаковым rank упорядочиваются по дате создания.
+func (r *TasksRepo) ListColumn(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, status string) ([]TaskRankRecord, error) {
+	const methodCtx = "repo.TasksRepo.ListColumn"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT id, tasks.rank
+		FROM tasks
+		WHERE team_id = ? AND status = ?
+		ORDER BY tasks.rank ASC, created_at ASC, id ASC
+		FOR UPDATE`,
+		teamID.String(),
+		status,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []TaskRankRecord
+	for rows.Next() {
+		var idStr string
+		var item TaskRankRecord
+		if err := rows.Scan(&idStr, &item.Rank); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id задачи", methodCtx)
+		}
+		item.ID = id
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// LastRank возвращает наибольший rank в колонке доски или пустую строку для пустой колонки.
+func (r *TasksRepo) LastRank(ctx context.Context, exec DBTX, teamID uuid.UUID, status string) (string, error) {
+	const methodCtx = "repo.TasksRepo.LastRank"
+
+	if r == nil || r.db == nil {
+		return "", fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	var rank string
+	if err := exec.QueryRowContext(
+		ctx,
+		"SELECT COALESCE(MAX(tasks.rank), '') FROM tasks WHERE team_id = ? AND status = ?",
+		teamID.String(),
+		status,
+	).Scan(&rank); err != nil {
+		return "", fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return rank, nil
+}
+
+// UpdateRank меняет позицию задачи без изменения остальных полей.
+func (r *TasksRepo) UpdateRank(ctx context.Context, tx *sql.Tx, taskID uuid.UUID, rank string) error {
+	const methodCtx = "repo.TasksRepo.UpdateRank"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE tasks SET tasks.rank = ? WHERE id = ?", rank, taskID.String()); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// ArchiveDone архивирует не более limit задач команды, завершенных раньше completedBefore,
+// и возвращает количество заархивированных. Ограничение пачки держит блокировки InnoDB короткими.
+func (r *TasksRepo) ArchiveDone(ctx context.Context, teamID uuid.UUID, completedBefore time.Time, archivedAt time.Time, limit int) (int, error) {
+	const methodCtx = "repo.TasksRepo.ArchiveDone"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE tasks
+		SET archived_at = ?
+		WHERE team_id = ? AND status = 'done' AND archived_at IS NULL AND completed_at < ?
+		ORDER BY completed_at ASC
+		LIMIT ?`,
+		archivedAt,
+		teamID.String(),
+		completedBefore,
+		limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return int(affected), nil
+}
+
 // GetTeamID возвращает team_id задачи.
 func (r *TasksRepo) GetTeamID(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error) {
 	const methodCtx = "repo.TasksRepo.GetTeamID"
@@ -256,15 +600,203 @@ func (r *TasksRepo) GetTeamID(ctx context.Context, taskID uuid.UUID) (uuid.UUID,
 	return teamID, nil
 }
 
+// ListOpenByAssignee возвращает незавершенные неархивные задачи команды, где пользователь
+// основной исполнитель или соисполнитель, блокируя их до конца транзакции.
+func (r *TasksRepo) ListOpenByAssignee(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, userID uuid.UUID) ([]uuid.UUID, error) {
+	const methodCtx = "repo.TasksRepo.ListOpenByAssignee"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+
+	rows, err := tx.QueryContext(
+		ctx,
+		`SELECT t.id FROM tasks t
+		WHERE t.team_id = ? AND t.status <> 'done' AND t.archived_at IS NULL
+			AND (t.assignee_id = ? OR EXISTS (
+				SELECT 1 FROM task_assignees ta WHERE ta.task_id = t.id AND ta.user_id = ?
+			))
+		ORDER BY t.id ASC
+		FOR UPDATE`,
+		teamID.String(),
+		userID.String(),
+		userID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []uuid.UUID
+	for rows.Next() {
+		var idStr string
+		if err := rows.Scan(&idStr); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		taskID, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id задачи", methodCtx)
+		}
+		items = append(items, taskID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// ListInvalidAssignees возвращает пары задача–исполнитель команды, где исполнитель не состоит в команде.
+// Выборка совпадает с отчетом invalid-assignees, ограниченным одной командой.
+func (r *TasksRepo) ListInvalidAssignees(ctx context.Context, teamID uuid.UUID) ([]InvalidAssigneeRecord, error) {
+	const methodCtx = "repo.TasksRepo.ListInvalidAssignees"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT t.id, ta.user_id
+		FROM task_assignees ta
+		JOIN tasks t ON t.id = ta.task_id
+		LEFT JOIN team_members tm ON tm.team_id = t.team_id AND tm.user_id = ta.user_id
+		WHERE t.team_id = ? AND tm.user_id IS NULL
+		UNION
+		SELECT t.id, t.assignee_id
+		FROM tasks t
+		LEFT JOIN team_members tm ON tm.team_id = t.team_id AND tm.user_id = t.assignee_id
+		WHERE t.team_id = ? AND t.assignee_id IS NOT NULL AND tm.user_id IS NULL`,
+		teamID.String(),
+		teamID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []InvalidAssigneeRecord
+	for rows.Next() {
+		var taskIDStr, assigneeIDStr string
+		if err := rows.Scan(&taskIDStr, &assigneeIDStr); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		taskID, err := uuid.Parse(taskIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный task_id", methodCtx)
+		}
+		assigneeID, err := uuid.Parse(assigneeIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный assignee_id", methodCtx)
+		}
+		items = append(items, InvalidAssigneeRecord{
+			TaskID:     taskID,
+			TeamID:     teamID,
+			AssigneeID: assigneeID,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// taskOrder возвращает выражение сортировки списка задач.
+func taskOrder(sort string) string {
+	if order, ok := taskOrders[sort]; ok {
+		return order
+	}
+	return taskOrders[TaskSortCreatedAtDesc]
+}
+
+// taskWhere строит условие выборки задач по фильтрам.
+func taskWhere(filter TaskFilter) (string, []interface{}) {
+	where := " WHERE team_id = ?"
+	args := []interface{}{filter.TeamID.String()}
+
+	if filter.Status != nil {
+		where += " AND status = ?"
+		args = append(args, *filter.Status)
+	}
+	if filter.SprintID != nil {
+		where += " AND sprint_id = ?"
+		args = append(args, filter.SprintID.String())
+	}
+	switch filter.Archived {
+	case TaskArchivedInclude:
+	case TaskArchivedOnly:
+		where += " AND archived_at IS NOT NULL"
+	default:
+		where += " AND archived_at IS NULL"
+	}
+	if len(filter.AssigneeIDs) > 0 {
+		where += " AND id IN (SELECT task_id FROM task_assignees WHERE user_id IN (" + placeholders(len(filter.AssigneeIDs)) + "))"
+		for _, assigneeID := range filter.AssigneeIDs {
+			args = append(args, assigneeID.String())
+		}
+	}
+
+	return where, args
+}
+
+// userTaskFrom строит источник выборки задач пользователя. Идентификаторы собираются объединением
+// трех выборок, каждая из которых идет по своему индексу: основной исполнитель — idx_tasks_team_assignee,
+// соисполнители — idx_task_assignees_user, автор — idx_tasks_team_creator_created.
+func userTaskFrom(filter UserTaskFilter) (string, []interface{}) {
+	teams := placeholders(len(filter.TeamIDs))
+	teamArgs := make([]interface{}, 0, len(filter.TeamIDs))
+	for _, teamID := range filter.TeamIDs {
+		teamArgs = append(teamArgs, teamID.String())
+	}
+	userID := filter.UserID.String()
+
+	from := ` FROM tasks
+		JOIN (
+			SELECT id AS task_id FROM tasks WHERE team_id IN (` + teams + `) AND assignee_id = ?
+			UNION
+			SELECT ta.task_id FROM task_assignees ta JOIN tasks t ON t.id = ta.task_id WHERE ta.user_id = ? AND t.team_id IN (` + teams + `)
+			UNION
+			SELECT id AS task_id FROM tasks WHERE team_id IN (` + teams + `) AND created_by = ?
+		) mine ON mine.task_id = tasks.id`
+	args := make([]interface{}, 0, 3*len(teamArgs)+4)
+	args = append(args, teamArgs...)
+	args = append(args, userID, userID)
+	args = append(args, teamArgs...)
+	args = append(args, teamArgs...)
+	args = append(args, userID)
+
+	var conditions []string
+	if filter.Status != nil {
+		conditions = append(conditions, "status = ?")
+		args = append(args, *filter.Status)
+	}
+	switch filter.Archived {
+	case TaskArchivedInclude:
+	case TaskArchivedOnly:
+		conditions = append(conditions, "archived_at IS NOT NULL")
+	default:
+		conditions = append(conditions, "archived_at IS NULL")
+	}
+	if len(conditions) > 0 {
+		from += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	return from, args
+}
+
 func scanTaskRecord(scanner interface {
 	Scan(dest ...interface{}) error
 }) (TaskRecord, error) {
 	var record TaskRecord
 	var idStr, teamIDStr, createdByStr string
 	var description sql.NullString
-	var assignee sql.NullString
-	var updatedAt sql.NullTime
-	var completedAt sql.NullTime
+	var sprint, assignee sql.NullString
+	var estimate, points sql.NullInt64
+	var dueAt, updatedAt, completedAt, archivedAt sql.NullTime
 
 	if err := scanner.Scan(
 		&idStr,
@@ -272,11 +804,17 @@ func scanTaskRecord(scanner interface {
 		&record.Title,
 		&description,
 		&record.Status,
+		&record.Rank,
+		&sprint,
 		&assignee,
+		&estimate,
+		&points,
+		&dueAt,
 		&createdByStr,
 		&record.CreatedAt,
 		&updatedAt,
 		&completedAt,
+		&archivedAt,
 	); err != nil {
 		return TaskRecord{}, err
 	}
@@ -301,6 +839,13 @@ func scanTaskRecord(scanner interface {
 	if description.Valid {
 		record.Description = &description.String
 	}
+	if sprint.Valid {
+		sprintID, err := uuid.Parse(sprint.String)
+		if err != nil {
+			return TaskRecord{}, fmt.Errorf("некорректный sprint_id")
+		}
+		record.SprintID = &sprintID
+	}
 	if assignee.Valid {
 		assigneeID, err := uuid.Parse(assignee.String)
 		if err != nil {
@@ -308,12 +853,26 @@ func scanTaskRecord(scanner interface {
 		}
 		record.AssigneeID = &assigneeID
 	}
+	if estimate.Valid {
+		value := int(estimate.Int64)
+		record.EstimateMinutes = &value
+	}
+	if points.Valid {
+		value := int(points.Int64)
+		record.StoryPoints = &value
+	}
+	if dueAt.Valid {
+		record.DueAt = &dueAt.Time
+	}
 	if updatedAt.Valid {
 		record.UpdatedAt = &updatedAt.Time
 	}
 	if completedAt.Valid {
 		record.CompletedAt = &completedAt.Time
 	}
+	if archivedAt.Valid {
+		record.ArchivedAt = &archivedAt.Time
+	}
 
 	return record, nil
 }
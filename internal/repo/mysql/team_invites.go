@@ -10,14 +10,23 @@ import (
 	"github.com/google/uuid"
 )
 
-// TeamInviteRecord содержит данные приглашения.
+// TeamInviteRecord содержит данные приглашения. Код приглашения хранится только в виде SHA-256.
 type TeamInviteRecord struct {
 	ID        uuid.UUID
 	TeamID    uuid.UUID
 	Email     string
 	InviterID uuid.UUID
-	Code      string
+	CodeHash  string
 	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// TeamInviteFilter задает выборку действующих приглашений команды: истекшие к ActiveAt не попадают.
+type TeamInviteFilter struct {
+	TeamID   uuid.UUID
+	ActiveAt time.Time
+	Page     int
+	PerPage  int
 }
 
 // TeamInvitesRepo реализует доступ к приглашениям.
@@ -47,13 +56,14 @@ func (r *TeamInvitesRepo) Create(ctx context.Context, exec DBTX, record TeamInvi
 
 	_, err := exec.ExecContext(
 		ctx,
-		"INSERT INTO team_invites (id, team_id, email, inviter_id, code, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		"INSERT INTO team_invites (id, team_id, email, inviter_id, code_hash, created_at, expires_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
 		record.ID.String(),
 		record.TeamID.String(),
 		record.Email,
 		record.InviterID.String(),
-		record.Code,
+		record.CodeHash,
 		record.CreatedAt,
+		record.ExpiresAt,
 	)
 	if err != nil {
 		return fmt.Errorf("%s: %w", methodCtx, err)
@@ -61,21 +71,23 @@ func (r *TeamInvitesRepo) Create(ctx context.Context, exec DBTX, record TeamInvi
 	return nil
 }
 
-// GetByCode возвращает приглашение по коду.
-func (r *TeamInvitesRepo) GetByCode(ctx context.Context, code string) (*TeamInviteRecord, error) {
-	const methodCtx = "repo.TeamInvitesRepo.GetByCode"
+// GetByCodeHash возвращает приглашение по хешу кода. Приглашения удаленных команд не возвращаются.
+func (r *TeamInvitesRepo) GetByCodeHash(ctx context.Context, codeHash string) (*TeamInviteRecord, error) {
+	const methodCtx = "repo.TeamInvitesRepo.GetByCodeHash"
 
 	if r == nil || r.db == nil {
 		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
 	}
 
-	var rec TeamInviteRecord
-	var idStr, teamIDStr, inviterIDStr string
-	err := r.db.QueryRowContext(
+	row := r.db.QueryRowContext(
 		ctx,
-		"SELECT id, team_id, email, inviter_id, code, created_at FROM team_invites WHERE code = ?",
-		code,
-	).Scan(&idStr, &teamIDStr, &rec.Email, &inviterIDStr, &rec.Code, &rec.CreatedAt)
+		`SELECT i.id, i.team_id, i.email, i.inviter_id, i.code_hash, i.created_at, i.expires_at
+		FROM team_invites i
+		JOIN teams t ON t.id = i.team_id AND t.deleted_at IS NULL
+		WHERE i.code_hash = ?`,
+		codeHash,
+	)
+	rec, err := scanTeamInvite(row)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -83,29 +95,123 @@ func (r *TeamInvitesRepo) GetByCode(ctx context.Context, code string) (*TeamInvi
 		return nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	id, err := uuid.Parse(idStr)
+	return &rec, nil
+}
+
+// Get возвращает приглашение команды по id.
+func (r *TeamInvitesRepo) Get(ctx context.Context, exec DBTX, teamID uuid.UUID, inviteID uuid.UUID) (TeamInviteRecord, error) {
+	const methodCtx = "repo.TeamInvitesRepo.Get"
+
+	if r == nil || r.db == nil {
+		return TeamInviteRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	row := exec.QueryRowContext(
+		ctx,
+		"SELECT id, team_id, email, inviter_id, code_hash, created_at, expires_at FROM team_invites WHERE id = ? AND team_id = ?",
+		inviteID.String(),
+		teamID.String(),
+	)
+	rec, err := scanTeamInvite(row)
 	if err != nil {
-		return nil, fmt.Errorf("%s: некорректный id приглашения", methodCtx)
+		if err == sql.ErrNoRows {
+			return TeamInviteRecord{}, err
+		}
+		return TeamInviteRecord{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
-	teamID, err := uuid.Parse(teamIDStr)
+	return rec, nil
+}
+
+// ListByTeam возвращает страницу действующих приглашений команды, новые первыми.
+func (r *TeamInvitesRepo) ListByTeam(ctx context.Context, filter TeamInviteFilter) ([]TeamInviteRecord, error) {
+	const methodCtx = "repo.TeamInvitesRepo.ListByTeam"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, team_id, email, inviter_id, code_hash, created_at, expires_at
+		FROM team_invites
+		WHERE team_id = ? AND expires_at > ?
+		ORDER BY created_at DESC, id ASC LIMIT ? OFFSET ?`,
+		filter.TeamID.String(),
+		filter.ActiveAt,
+		filter.PerPage,
+		(filter.Page-1)*filter.PerPage,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("%s: некорректный id команды", methodCtx)
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
 	}
-	inviterID, err := uuid.Parse(inviterIDStr)
+	defer rows.Close()
+
+	var items []TeamInviteRecord
+	for rows.Next() {
+		record, err := scanTeamInvite(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// CountByTeam возвращает количество действующих приглашений команды.
+func (r *TeamInvitesRepo) CountByTeam(ctx context.Context, filter TeamInviteFilter) (int, error) {
+	const methodCtx = "repo.TeamInvitesRepo.CountByTeam"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var total int
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM team_invites WHERE team_id = ? AND expires_at > ?",
+		filter.TeamID.String(),
+		filter.ActiveAt,
+	).Scan(&total)
 	if err != nil {
-		return nil, fmt.Errorf("%s: некорректный id пригласившего", methodCtx)
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
 	}
+	return total, nil
+}
 
-	rec.ID = id
-	rec.TeamID = teamID
-	rec.InviterID = inviterID
+// Rotate заменяет код приглашения и продлевает срок его действия.
+func (r *TeamInvitesRepo) Rotate(ctx context.Context, exec DBTX, inviteID uuid.UUID, codeHash string, expiresAt time.Time) error {
+	const methodCtx = "repo.TeamInvitesRepo.Rotate"
 
-	return &rec, nil
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	_, err := exec.ExecContext(
+		ctx,
+		"UPDATE team_invites SET code_hash = ?, expires_at = ? WHERE id = ?",
+		codeHash,
+		expiresAt,
+		inviteID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
 }
 
-// DeleteByCode удаляет приглашение по коду.
-func (r *TeamInvitesRepo) DeleteByCode(ctx context.Context, exec DBTX, code string) error {
-	const methodCtx = "repo.TeamInvitesRepo.DeleteByCode"
+// Delete удаляет приглашение по id.
+func (r *TeamInvitesRepo) Delete(ctx context.Context, exec DBTX, inviteID uuid.UUID) error {
+	const methodCtx = "repo.TeamInvitesRepo.Delete"
 
 	if r == nil || r.db == nil {
 		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
@@ -114,9 +220,70 @@ func (r *TeamInvitesRepo) DeleteByCode(ctx context.Context, exec DBTX, code stri
 		exec = r.db
 	}
 
-	_, err := exec.ExecContext(ctx, "DELETE FROM team_invites WHERE code = ?", code)
+	_, err := exec.ExecContext(ctx, "DELETE FROM team_invites WHERE id = ?", inviteID.String())
 	if err != nil {
 		return fmt.Errorf("%s: %w", methodCtx, err)
 	}
 	return nil
 }
+
+// DeleteExpired удаляет не более limit приглашений, истекших к expiredBefore, и возвращает их количество.
+func (r *TeamInvitesRepo) DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int, error) {
+	const methodCtx = "repo.TeamInvitesRepo.DeleteExpired"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	res, err := r.db.ExecContext(
+		ctx,
+		"DELETE FROM team_invites WHERE expires_at <= ? ORDER BY expires_at ASC LIMIT ?",
+		expiredBefore,
+		limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return int(affected), nil
+}
+
+func scanTeamInvite(scanner interface {
+	Scan(dest ...interface{}) error
+}) (TeamInviteRecord, error) {
+	var record TeamInviteRecord
+	var idStr, teamIDStr, inviterIDStr string
+
+	if err := scanner.Scan(
+		&idStr,
+		&teamIDStr,
+		&record.Email,
+		&inviterIDStr,
+		&record.CodeHash,
+		&record.CreatedAt,
+		&record.ExpiresAt,
+	); err != nil {
+		return TeamInviteRecord{}, err
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return TeamInviteRecord{}, fmt.Errorf("некорректный id приглашения")
+	}
+	teamID, err := uuid.Parse(teamIDStr)
+	if err != nil {
+		return TeamInviteRecord{}, fmt.Errorf("некорректный id команды")
+	}
+	inviterID, err := uuid.Parse(inviterIDStr)
+	if err != nil {
+		return TeamInviteRecord{}, fmt.Errorf("некорректный id пригласившего")
+	}
+	record.ID = id
+	record.TeamID = teamID
+	record.InviterID = inviterID
+
+	return record, nil
+}
@@ -0,0 +1,84 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// События журнала участников команды.
+const (
+	TeamMemberEventJoined      = "joined"
+	TeamMemberEventInvited     = "invited"
+	TeamMemberEventRoleChanged = "role_changed"
+	TeamMemberEventRemoved     = "removed"
+	TeamMemberEventLeft        = "left"
+)
+
+// TeamMemberEventRecord описывает запись журнала изменений участников команды.
+// Для удаления и выхода NewRole не задается; для приглашения вместо UserID задается Email.
+type TeamMemberEventRecord struct {
+	ID        uuid.UUID
+	TeamID    uuid.UUID
+	UserID    *uuid.UUID
+	Email     *string
+	ActorID   uuid.UUID
+	Event     string
+	OldRole   *string
+	NewRole   *string
+	CreatedAt time.Time
+}
+
+// TeamMemberEventsRepo реализует журнал изменений участников команды.
+type TeamMemberEventsRepo struct {
+	db *sql.DB
+}
+
+// NewTeamMemberEventsRepo создает репозиторий журнала участников.
+func NewTeamMemberEventsRepo(db *sql.DB) *TeamMemberEventsRepo {
+	const methodCtx = "repo.NewTeamMemberEventsRepo"
+
+	slog.Debug("инициализация репозитория журнала участников", slog.String("context", methodCtx))
+
+	return &TeamMemberEventsRepo{db: db}
+}
+
+// Add добавляет запись в журнал.
+func (r *TeamMemberEventsRepo) Add(ctx context.Context, exec DBTX, record TeamMemberEventRecord) error {
+	const methodCtx = "repo.TeamMemberEventsRepo.Add"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	var userValue interface{}
+	if record.UserID != nil {
+		userValue = record.UserID.String()
+	}
+
+	_, err := exec.ExecContext(
+		ctx,
+		`INSERT INTO team_member_events (id, team_id, user_id, email, actor_id, event, old_role, new_role, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.ID.String(),
+		record.TeamID.String(),
+		userValue,
+		record.Email,
+		record.ActorID.String(),
+		record.Event,
+		record.OldRole,
+		record.NewRole,
+		record.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
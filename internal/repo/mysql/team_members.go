@@ -5,11 +5,29 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// TeamMemberRecord описывает участника команды вместе с email пользователя.
+type TeamMemberRecord struct {
+	TeamID    uuid.UUID
+	UserID    uuid.UUID
+	Email     string
+	Role      string
+	CreatedAt time.Time
+}
+
+// TeamMemberFilter задает выборку участников команды.
+type TeamMemberFilter struct {
+	TeamID      uuid.UUID
+	EmailPrefix string
+	Page        int
+	PerPage     int
+}
+
 // TeamMembersRepo реализует доступ к участникам команды.
 type TeamMembersRepo struct {
 	db *sql.DB
@@ -49,7 +67,7 @@ func (r *TeamMembersRepo) Add(ctx context.Context, exec DBTX, teamID uuid.UUID,
 	return nil
 }
 
-// GetRole возвращает роль пользователя в команде.
+// GetRole возвращает роль пользователя в команде. Участие в удаленных командах не учитывается.
 func (r *TeamMembersRepo) GetRole(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (string, bool, error) {
 	const methodCtx = "repo.TeamMembersRepo.GetRole"
 
@@ -60,7 +78,9 @@ func (r *TeamMembersRepo) GetRole(ctx context.Context, teamID uuid.UUID, userID
 	var role string
 	err := r.db.QueryRowContext(
 		ctx,
-		"SELECT role FROM team_members WHERE team_id = ? AND user_id = ?",
+		`SELECT tm.role FROM team_members tm
+		JOIN teams t ON t.id = tm.team_id
+		WHERE tm.team_id = ? AND tm.user_id = ? AND t.deleted_at IS NULL`,
 		teamID.String(),
 		userID.String(),
 	).Scan(&role)
@@ -73,7 +93,188 @@ func (r *TeamMembersRepo) GetRole(ctx context.Context, teamID uuid.UUID, userID
 	return role, true, nil
 }
 
-// IsMember проверяет членство пользователя в команде.
+// GetRoleForUpdate возвращает роль участника, блокируя запись до конца транзакции.
+func (r *TeamMembersRepo) GetRoleForUpdate(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, userID uuid.UUID) (string, bool, error) {
+	const methodCtx = "repo.TeamMembersRepo.GetRoleForUpdate"
+
+	if r == nil || r.db == nil {
+		return "", false, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return "", false, fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+
+	var role string
+	err := tx.QueryRowContext(
+		ctx,
+		"SELECT role FROM team_members WHERE team_id = ? AND user_id = ? FOR UPDATE",
+		teamID.String(),
+		userID.String(),
+	).Scan(&role)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return role, true, nil
+}
+
+// Get возвращает участника команды. Отсутствие участника возвращается как sql.ErrNoRows.
+func (r *TeamMembersRepo) Get(ctx context.Context, exec DBTX, teamID uuid.UUID, userID uuid.UUID) (TeamMemberRecord, error) {
+	const methodCtx = "repo.TeamMembersRepo.Get"
+
+	if r == nil || r.db == nil {
+		return TeamMemberRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	record, err := scanTeamMember(exec.QueryRowContext(
+		ctx,
+		`SELECT tm.team_id, tm.user_id, u.email, tm.role, tm.created_at
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id
+		WHERE tm.team_id = ? AND tm.user_id = ?`,
+		teamID.String(),
+		userID.String(),
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TeamMemberRecord{}, err
+		}
+		return TeamMemberRecord{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return record, nil
+}
+
+// ListByTeam возвращает участников команды по фильтру, отсортированных по email.
+func (r *TeamMembersRepo) ListByTeam(ctx context.Context, filter TeamMemberFilter) ([]TeamMemberRecord, error) {
+	const methodCtx = "repo.TeamMembersRepo.ListByTeam"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	where, args := teamMemberWhere(filter)
+	query := `SELECT tm.team_id, tm.user_id, u.email, tm.role, tm.created_at
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id` + where + " ORDER BY u.email ASC LIMIT ? OFFSET ?"
+	args = append(args, filter.PerPage, (filter.Page-1)*filter.PerPage)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []TeamMemberRecord
+	for rows.Next() {
+		record, err := scanTeamMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// CountByTeam возвращает количество участников команды по фильтру.
+func (r *TeamMembersRepo) CountByTeam(ctx context.Context, filter TeamMemberFilter) (int, error) {
+	const methodCtx = "repo.TeamMembersRepo.CountByTeam"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	where, args := teamMemberWhere(filter)
+	query := `SELECT COUNT(*)
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id` + where
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return total, nil
+}
+
+// CountOwnersForUpdate возвращает количество owner команды, блокируя их записи до конца транзакции.
+func (r *TeamMembersRepo) CountOwnersForUpdate(ctx context.Context, tx *sql.Tx, teamID uuid.UUID) (int, error) {
+	const methodCtx = "repo.TeamMembersRepo.CountOwnersForUpdate"
+
+	if r == nil || r.db == nil {
+		return 0, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if tx == nil {
+		return 0, fmt.Errorf("%s: транзакция не задана", methodCtx)
+	}
+
+	var count int
+	err := tx.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM team_members WHERE team_id = ? AND role = 'owner' FOR UPDATE",
+		teamID.String(),
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return count, nil
+}
+
+// Remove удаляет участника из команды.
+func (r *TeamMembersRepo) Remove(ctx context.Context, exec DBTX, teamID uuid.UUID, userID uuid.UUID) error {
+	const methodCtx = "repo.TeamMembersRepo.Remove"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	_, err := exec.ExecContext(
+		ctx,
+		"DELETE FROM team_members WHERE team_id = ? AND user_id = ?",
+		teamID.String(),
+		userID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// SetRole изменяет роль участника команды.
+func (r *TeamMembersRepo) SetRole(ctx context.Context, exec DBTX, teamID uuid.UUID, userID uuid.UUID, role string) error {
+	const methodCtx = "repo.TeamMembersRepo.SetRole"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+	if exec == nil {
+		exec = r.db
+	}
+
+	_, err := exec.ExecContext(
+		ctx,
+		"UPDATE team_members SET role = ? WHERE team_id = ? AND user_id = ?",
+		role,
+		teamID.String(),
+		userID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// IsMember проверяет членство пользователя в команде. Участие в удаленных командах не учитывается.
 func (r *TeamMembersRepo) IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error) {
 	const methodCtx = "repo.TeamMembersRepo.IsMember"
 
@@ -84,7 +285,10 @@ func (r *TeamMembersRepo) IsMember(ctx context.Context, teamID uuid.UUID, userID
 	var exists int
 	err := r.db.QueryRowContext(
 		ctx,
-		"SELECT 1 FROM team_members WHERE team_id = ? AND user_id = ? LIMIT 1",
+		`SELECT 1 FROM team_members tm
+		JOIN teams t ON t.id = tm.team_id
+		WHERE tm.team_id = ? AND tm.user_id = ? AND t.deleted_at IS NULL
+		LIMIT 1`,
 		teamID.String(),
 		userID.String(),
 	).Scan(&exists)
@@ -97,7 +301,7 @@ func (r *TeamMembersRepo) IsMember(ctx context.Context, teamID uuid.UUID, userID
 	return true, nil
 }
 
-// GetHighestRoleByUser возвращает максимальную роль пользователя среди команд.
+// GetHighestRoleByUser возвращает максимальную роль пользователя среди неудаленных команд.
 func (r *TeamMembersRepo) GetHighestRoleByUser(ctx context.Context, userID uuid.UUID) (string, bool, error) {
 	const methodCtx = "repo.TeamMembersRepo.GetHighestRoleByUser"
 
@@ -108,7 +312,11 @@ func (r *TeamMembersRepo) GetHighestRoleByUser(ctx context.Context, userID uuid.
 	var role string
 	err := r.db.QueryRowContext(
 		ctx,
-		"SELECT role FROM team_members WHERE user_id = ? ORDER BY FIELD(role, 'owner', 'admin', 'member') LIMIT 1",
+		`SELECT tm.role FROM team_members tm
+		JOIN teams t ON t.id = tm.team_id
+		WHERE tm.user_id = ? AND t.deleted_at IS NULL
+		ORDER BY FIELD(tm.role, 'owner', 'admin', 'member')
+		LIMIT 1`,
 		userID.String(),
 	).Scan(&role)
 	if err == sql.ErrNoRows {
@@ -120,3 +328,41 @@ func (r *TeamMembersRepo) GetHighestRoleByUser(ctx context.Context, userID uuid.
 
 	return role, true, nil
 }
+
+// teamMemberWhere строит условие выборки участников. Префикс email сравнивается через LIKE
+// с экранированием спецсимволов; регистр не учитывается за счет collation столбца.
+func teamMemberWhere(filter TeamMemberFilter) (string, []interface{}) {
+	where := " WHERE tm.team_id = ?"
+	args := []interface{}{filter.TeamID.String()}
+	if filter.EmailPrefix != "" {
+		where += ` AND u.email LIKE ? ESCAPE '\\'`
+		args = append(args, likeEscaper.Replace(filter.EmailPrefix)+"%")
+	}
+	return where, args
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func scanTeamMember(scanner interface {
+	Scan(dest ...interface{}) error
+}) (TeamMemberRecord, error) {
+	var record TeamMemberRecord
+	var teamIDStr, userIDStr string
+
+	if err := scanner.Scan(&teamIDStr, &userIDStr, &record.Email, &record.Role, &record.CreatedAt); err != nil {
+		return TeamMemberRecord{}, err
+	}
+
+	teamID, err := uuid.Parse(teamIDStr)
+	if err != nil {
+		return TeamMemberRecord{}, fmt.Errorf("некорректный id команды")
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return TeamMemberRecord{}, fmt.Errorf("некорректный id пользователя")
+	}
+	record.TeamID = teamID
+	record.UserID = userID
+
+	return record, nil
+}
@@ -18,6 +18,12 @@ type TeamRecord struct {
 	CreatedAt time.Time
 }
 
+// TeamArchivePolicyRecord описывает политику архивации завершенных задач команды.
+type TeamArchivePolicyRecord struct {
+	TeamID    uuid.UUID
+	AfterDays int
+}
+
 // TeamsRepo реализует доступ к командам.
 type TeamsRepo struct {
 	db *sql.DB
@@ -72,7 +78,7 @@ func (r *TeamsRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]TeamRec
 		`SELECT t.id, t.name, t.created_by, t.created_at
 		FROM teams t
 		INNER JOIN team_members tm ON tm.team_id = t.id
-		WHERE tm.user_id = ?
+		WHERE tm.user_id = ? AND t.deleted_at IS NULL
 		ORDER BY t.created_at ASC`,
 		userID.String(),
 	)
@@ -111,7 +117,165 @@ func (r *TeamsRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]TeamRec
 	return items, nil
 }
 
-// Exists проверяет наличие команды.
+// Get возвращает команду. Удаленные команды не возвращаются: ошибка sql.ErrNoRows передается как есть.
+func (r *TeamsRepo) Get(ctx context.Context, teamID uuid.UUID) (TeamRecord, error) {
+	const methodCtx = "repo.TeamsRepo.Get"
+
+	if r == nil || r.db == nil {
+		return TeamRecord{}, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var idStr, createdByStr string
+	var record TeamRecord
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT id, name, created_by, created_at FROM teams WHERE id = ? AND deleted_at IS NULL",
+		teamID.String(),
+	).Scan(&idStr, &record.Name, &createdByStr, &record.CreatedAt)
+	if err != nil {
+		return TeamRecord{}, err
+	}
+
+	if record.ID, err = uuid.Parse(idStr); err != nil {
+		return TeamRecord{}, fmt.Errorf("%s: некорректный id команды", methodCtx)
+	}
+	if record.CreatedBy, err = uuid.Parse(createdByStr); err != nil {
+		return TeamRecord{}, fmt.Errorf("%s: некорректный created_by", methodCtx)
+	}
+	return record, nil
+}
+
+// Rename изменяет название команды.
+func (r *TeamsRepo) Rename(ctx context.Context, teamID uuid.UUID, name string, updatedAt time.Time) error {
+	const methodCtx = "repo.TeamsRepo.Rename"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"UPDATE teams SET name = ?, updated_at = ? WHERE id = ? AND deleted_at IS NULL",
+		name,
+		updatedAt,
+		teamID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// SoftDelete помечает команду удаленной. Возвращает false, если команда уже удалена или не существует.
+func (r *TeamsRepo) SoftDelete(ctx context.Context, teamID uuid.UUID, deletedBy uuid.UUID, deletedAt time.Time) (bool, error) {
+	const methodCtx = "repo.TeamsRepo.SoftDelete"
+
+	if r == nil || r.db == nil {
+		return false, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	result, err := r.db.ExecContext(
+		ctx,
+		"UPDATE teams SET deleted_at = ?, deleted_by = ?, updated_at = ? WHERE id = ? AND deleted_at IS NULL",
+		deletedAt,
+		deletedBy.String(),
+		deletedAt,
+		teamID.String(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return affected > 0, nil
+}
+
+// Restore снимает пометку удаления с команды, удаленной не раньше deletedAfter, если userID — ее owner.
+// Возвращает false, если такой команды нет.
+func (r *TeamsRepo) Restore(ctx context.Context, teamID uuid.UUID, userID uuid.UUID, deletedAfter time.Time, updatedAt time.Time) (bool, error) {
+	const methodCtx = "repo.TeamsRepo.Restore"
+
+	if r == nil || r.db == nil {
+		return false, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE teams t
+		JOIN team_members tm ON tm.team_id = t.id AND tm.user_id = ? AND tm.role = 'owner'
+		SET t.deleted_at = NULL, t.deleted_by = NULL, t.updated_at = ?
+		WHERE t.id = ? AND t.deleted_at IS NOT NULL AND t.deleted_at > ?`,
+		userID.String(),
+		updatedAt,
+		teamID.String(),
+		deletedAfter,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return affected > 0, nil
+}
+
+// ListDeletedBefore возвращает не более limit команд, удаленных раньше deletedBefore.
+func (r *TeamsRepo) ListDeletedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]uuid.UUID, error) {
+	const methodCtx = "repo.TeamsRepo.ListDeletedBefore"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT id FROM teams WHERE deleted_at IS NOT NULL AND deleted_at <= ? ORDER BY deleted_at ASC LIMIT ?",
+		deletedBefore,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []uuid.UUID
+	for rows.Next() {
+		var idStr string
+		if err := rows.Scan(&idStr); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		teamID, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id команды", methodCtx)
+		}
+		items = append(items, teamID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
+
+// Purge окончательно удаляет команду, помеченную удаленной. Участники, приглашения, задачи и их
+// зависимые записи удаляются каскадом по внешним ключам; вложения задач становятся сиротами.
+func (r *TeamsRepo) Purge(ctx context.Context, teamID uuid.UUID) error {
+	const methodCtx = "repo.TeamsRepo.Purge"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM teams WHERE id = ? AND deleted_at IS NOT NULL", teamID.String()); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// Exists проверяет наличие команды. Удаленные команды считаются отсутствующими.
 func (r *TeamsRepo) Exists(ctx context.Context, teamID uuid.UUID) (bool, error) {
 	const methodCtx = "repo.TeamsRepo.Exists"
 
@@ -120,7 +284,7 @@ func (r *TeamsRepo) Exists(ctx context.Context, teamID uuid.UUID) (bool, error)
 	}
 
 	var exists int
-	err := r.db.QueryRowContext(ctx, "SELECT 1 FROM teams WHERE id = ? LIMIT 1", teamID.String()).Scan(&exists)
+	err := r.db.QueryRowContext(ctx, "SELECT 1 FROM teams WHERE id = ? AND deleted_at IS NULL LIMIT 1", teamID.String()).Scan(&exists)
 	if err == sql.ErrNoRows {
 		return false, nil
 	}
@@ -129,3 +293,124 @@ func (r *TeamsRepo) Exists(ctx context.Context, teamID uuid.UUID) (bool, error)
 	}
 	return true, nil
 }
+
+// GetTaskEditPolicy возвращает политику редактирования задач команды.
+func (r *TeamsRepo) GetTaskEditPolicy(ctx context.Context, teamID uuid.UUID) (string, error) {
+	const methodCtx = "repo.TeamsRepo.GetTaskEditPolicy"
+
+	if r == nil || r.db == nil {
+		return "", fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var policy string
+	err := r.db.QueryRowContext(ctx, "SELECT task_edit_policy FROM teams WHERE id = ? AND deleted_at IS NULL", teamID.String()).Scan(&policy)
+	if err != nil {
+		return "", err
+	}
+	return policy, nil
+}
+
+// SetTaskEditPolicy сохраняет политику редактирования задач команды.
+func (r *TeamsRepo) SetTaskEditPolicy(ctx context.Context, teamID uuid.UUID, policy string, updatedAt time.Time) error {
+	const methodCtx = "repo.TeamsRepo.SetTaskEditPolicy"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"UPDATE teams SET task_edit_policy = ?, updated_at = ? WHERE id = ? AND deleted_at IS NULL",
+		policy,
+		updatedAt,
+		teamID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// GetArchiveAfterDays возвращает срок архивации завершенных задач команды; nil — архивация выключена.
+func (r *TeamsRepo) GetArchiveAfterDays(ctx context.Context, teamID uuid.UUID) (*int, error) {
+	const methodCtx = "repo.TeamsRepo.GetArchiveAfterDays"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var days sql.NullInt64
+	err := r.db.QueryRowContext(ctx, "SELECT archive_after_days FROM teams WHERE id = ? AND deleted_at IS NULL", teamID.String()).Scan(&days)
+	if err != nil {
+		return nil, err
+	}
+	if !days.Valid {
+		return nil, nil
+	}
+	value := int(days.Int64)
+	return &value, nil
+}
+
+// SetArchiveAfterDays сохраняет срок архивации завершенных задач команды.
+func (r *TeamsRepo) SetArchiveAfterDays(ctx context.Context, teamID uuid.UUID, days *int, updatedAt time.Time) error {
+	const methodCtx = "repo.TeamsRepo.SetArchiveAfterDays"
+
+	if r == nil || r.db == nil {
+		return fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	var daysValue interface{}
+	if days != nil {
+		daysValue = *days
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"UPDATE teams SET archive_after_days = ?, updated_at = ? WHERE id = ? AND deleted_at IS NULL",
+		daysValue,
+		updatedAt,
+		teamID.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// ListArchivePolicies возвращает команды с включенной архивацией завершенных задач.
+func (r *TeamsRepo) ListArchivePolicies(ctx context.Context) ([]TeamArchivePolicyRecord, error) {
+	const methodCtx = "repo.TeamsRepo.ListArchivePolicies"
+
+	if r == nil || r.db == nil {
+		return nil, fmt.Errorf("%s: репозиторий не инициализирован", methodCtx)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT id, archive_after_days FROM teams WHERE archive_after_days IS NOT NULL AND deleted_at IS NULL ORDER BY id ASC",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer rows.Close()
+
+	var items []TeamArchivePolicyRecord
+	for rows.Next() {
+		var idStr string
+		var record TeamArchivePolicyRecord
+		if err := rows.Scan(&idStr, &record.AfterDays); err != nil {
+			return nil, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		teamID, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: некорректный id команды", methodCtx)
+		}
+		record.TeamID = teamID
+		items = append(items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,10 @@
+package activity
+
+import "errors"
+
+var (
+	ErrForbidden     = errors.New("доступ запрещен")
+	ErrNotFound      = errors.New("не найдено")
+	ErrInvalidCursor = errors.New("некорректный курсор")
+	ErrInvalidType   = errors.New("неизвестный тип события")
+)
@@ -0,0 +1,254 @@
+package activity
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// allTypes перечисляет типы событий ленты в порядке по умолчанию.
+var allTypes = []string{
+	repomysql.ActivityTaskHistory,
+	repomysql.ActivityComment,
+	repomysql.ActivityMemberJoined,
+	repomysql.ActivityInviteCreated,
+	repomysql.ActivityMemberRoleChanged,
+	repomysql.ActivityMemberRemoved,
+	repomysql.ActivityMemberLeft,
+}
+
+// Service собирает ленту активности задач и команд.
+type Service struct {
+	activity ActivityRepository
+	tasks    TasksRepository
+	members  MembersRepository
+}
+
+// ActivityRepository описывает выборку ленты активности.
+type ActivityRepository interface {
+	List(ctx context.Context, filter repomysql.ActivityFilter) ([]repomysql.ActivityRecord, error)
+}
+
+// TasksRepository описывает доступ к задачам.
+type TasksRepository interface {
+	GetTeamID(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error)
+}
+
+// MembersRepository описывает доступ к участникам команды.
+type MembersRepository interface {
+	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// NewService создает сервис ленты активности.
+func NewService(activity ActivityRepository, tasks TasksRepository, members MembersRepository) (*Service, error) {
+	const methodCtx = "activity.NewService"
+
+	slog.Debug("инициализация сервиса ленты активности", slog.String("context", methodCtx))
+
+	if activity == nil {
+		return nil, fmt.Errorf("%s: activity repo не задан", methodCtx)
+	}
+	if tasks == nil {
+		return nil, fmt.Errorf("%s: tasks repo не задан", methodCtx)
+	}
+	if members == nil {
+		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
+	}
+
+	return &Service{activity: activity, tasks: tasks, members: members}, nil
+}
+
+// TaskFeed возвращает ленту задачи: историю изменений и комментарии.
+func (s *Service) TaskFeed(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, types []api.ActivityType, cursor string, limit int) (api.ActivityFeedResponse, error) {
+	const methodCtx = "activity.Service.TaskFeed"
+
+	slog.Debug("вызов ленты активности задачи", slog.String("context", methodCtx))
+
+	teamID, err := s.tasks.GetTeamID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.ActivityFeedResponse{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.ActivityFeedResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.checkMember(ctx, teamID, userID); err != nil {
+		return api.ActivityFeedResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	resp, err := s.feed(ctx, repomysql.ActivityFilter{TaskID: &taskID}, types, cursor, limit)
+	if err != nil {
+		return api.ActivityFeedResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return resp, nil
+}
+
+// TeamFeed возвращает ленту команды: события задач и журнал участников (вступления, приглашения,
+// смены ролей, исключения и выходы).
+func (s *Service) TeamFeed(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, types []api.ActivityType, cursor string, limit int) (api.ActivityFeedResponse, error) {
+	const methodCtx = "activity.Service.TeamFeed"
+
+	slog.Debug("вызов ленты активности команды", slog.String("context", methodCtx))
+
+	if err := s.checkMember(ctx, teamID, userID); err != nil {
+		return api.ActivityFeedResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	resp, err := s.feed(ctx, repomysql.ActivityFilter{TeamID: &teamID}, types, cursor, limit)
+	if err != nil {
+		return api.ActivityFeedResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return resp, nil
+}
+
+func (s *Service) checkMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
+	member, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// feed выбирает страницу ленты; лишняя запись сверх limit означает наличие следующей страницы.
+func (s *Service) feed(ctx context.Context, filter repomysql.ActivityFilter, types []api.ActivityType, cursor string, limit int) (api.ActivityFeedResponse, error) {
+	filter.Types = allTypes
+	if len(types) > 0 {
+		filter.Types = make([]string, 0, len(types))
+		for _, activityType := range types {
+			if !knownType(string(activityType)) {
+				return api.ActivityFeedResponse{}, fmt.Errorf("%w: %s", ErrInvalidType, activityType)
+			}
+			filter.Types = append(filter.Types, string(activityType))
+		}
+	}
+
+	if cursor != "" {
+		before, err := decodeCursor(cursor)
+		if err != nil {
+			return api.ActivityFeedResponse{}, err
+		}
+		filter.Before = &before
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	filter.Limit = limit + 1
+
+	records, err := s.activity.List(ctx, filter)
+	if err != nil {
+		return api.ActivityFeedResponse{}, err
+	}
+
+	resp := api.ActivityFeedResponse{Items: make([]api.ActivityItem, 0, len(records))}
+	if len(records) > limit {
+		records = records[:limit]
+		last := records[len(records)-1]
+		next := encodeCursor(repomysql.ActivityCursor{OccurredAt: last.OccurredAt, Type: last.Type, ID: last.ID})
+		resp.NextCursor = &next
+	}
+	for _, record := range records {
+		resp.Items = append(resp.Items, activityToAPI(record))
+	}
+
+	return resp, nil
+}
+
+func knownType(activityType string) bool {
+	for _, known := range allTypes {
+		if known == activityType {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeCursor кодирует позицию в ленте как base64url("<unix-секунды>|<тип>|<id>").
+func encodeCursor(cursor repomysql.ActivityCursor) string {
+	raw := strconv.FormatInt(cursor.OccurredAt.Unix(), 10) + "|" + cursor.Type + "|" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(value string) (repomysql.ActivityCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return repomysql.ActivityCursor{}, ErrInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 3 || !knownType(parts[1]) {
+		return repomysql.ActivityCursor{}, ErrInvalidCursor
+	}
+
+	seconds, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return repomysql.ActivityCursor{}, ErrInvalidCursor
+	}
+	id, err := uuid.Parse(parts[2])
+	if err != nil {
+		return repomysql.ActivityCursor{}, ErrInvalidCursor
+	}
+
+	return repomysql.ActivityCursor{OccurredAt: time.Unix(seconds, 0).UTC(), Type: parts[1], ID: id}, nil
+}
+
+func activityToAPI(record repomysql.ActivityRecord) api.ActivityItem {
+	item := api.ActivityItem{
+		Id:         api.UUID(record.ID),
+		Type:       api.ActivityType(record.Type),
+		TeamId:     api.UUID(record.TeamID),
+		ActorId:    api.UUID(record.ActorID),
+		OccurredAt: record.OccurredAt,
+		Body:       record.Body,
+		Role:       record.Role,
+		OldRole:    record.OldRole,
+	}
+	if record.UserID != nil {
+		userID := api.UUID(*record.UserID)
+		item.UserId = &userID
+	}
+	if record.TaskID != nil {
+		taskID := api.UUID(*record.TaskID)
+		item.TaskId = &taskID
+	}
+	if record.HistoryEvent != nil {
+		event := api.TaskHistoryEvent(*record.HistoryEvent)
+		item.HistoryEvent = &event
+	}
+	if record.Changes != nil {
+		changes := make(map[string]api.TaskFieldChange, len(record.Changes))
+		for field, value := range record.Changes {
+			change, _ := value.(map[string]interface{})
+			changes[field] = api.TaskFieldChange{From: change["from"], To: change["to"]}
+		}
+		item.Changes = &changes
+	}
+	if record.Email != nil {
+		email := openapi_types.Email(*record.Email)
+		item.Email = &email
+	}
+	return item
+}
@@ -0,0 +1,201 @@
+package activity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+type ActivitySuite struct {
+	tests.IntegrationSuite
+	service    *Service
+	ownerID    uuid.UUID
+	memberID   uuid.UUID
+	outsiderID uuid.UUID
+	teamID     uuid.UUID
+	taskID     uuid.UUID
+}
+
+func TestActivitySuite(t *testing.T) {
+	const methodCtx = "activity.TestActivitySuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(ActivitySuite))
+}
+
+func (s *ActivitySuite) SetupTest() {
+	const methodCtx = "activity.ActivitySuite.SetupTest"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_member_events",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-activity@example.com")
+	s.memberID = s.CreateUser("member-activity@example.com")
+	s.outsiderID = s.CreateUser("outsider-activity@example.com")
+
+	s.teamID = s.CreateTeam("Activity Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.memberID, "member")
+
+	s.taskID = s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Task", "")
+
+	service, err := NewService(
+		repomysql.NewActivityRepo(s.DB),
+		repomysql.NewTasksRepo(s.DB),
+		repomysql.NewTeamMembersRepo(s.DB),
+	)
+	s.Require().NoError(err, methodCtx)
+	s.service = service
+}
+
+func (s *ActivitySuite) TestTaskFeed() {
+	const methodCtx = "activity.ActivitySuite.TestTaskFeed"
+
+	ctx := context.Background()
+	historyID := s.CreateTaskHistory(s.taskID, s.ownerID, `{"title": {"from": "old", "to": "Task"}}`)
+	commentID := s.CreateComment(s.taskID, s.memberID, "looks good")
+	otherTaskID := s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Other", "")
+	s.CreateComment(otherTaskID, s.memberID, "other")
+
+	resp, err := s.service.TaskFeed(ctx, s.memberID, s.taskID, nil, "", 0)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 2)
+	s.Nil(resp.NextCursor)
+
+	byID := map[uuid.UUID]api.ActivityItem{}
+	for _, item := range resp.Items {
+		byID[item.Id] = item
+	}
+	s.Require().Contains(byID, historyID)
+	s.Equal(api.ActivityTypeTaskHistory, byID[historyID].Type)
+	s.Require().NotNil(byID[historyID].Changes)
+	s.Equal("Task", (*byID[historyID].Changes)["title"].To)
+	s.Require().Contains(byID, commentID)
+	s.Equal(api.ActivityTypeComment, byID[commentID].Type)
+	s.Equal("looks good", *byID[commentID].Body)
+
+	resp, err = s.service.TaskFeed(ctx, s.memberID, s.taskID, []api.ActivityType{api.ActivityTypeComment}, "", 0)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 1)
+	s.Equal(commentID, resp.Items[0].Id)
+
+	resp, err = s.service.TaskFeed(ctx, s.memberID, s.taskID, []api.ActivityType{api.ActivityTypeMemberJoined}, "", 0)
+	s.Require().NoError(err, methodCtx)
+	s.Empty(resp.Items)
+
+	_, err = s.service.TaskFeed(ctx, s.outsiderID, s.taskID, nil, "", 0)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.TaskFeed(ctx, s.memberID, uuid.New(), nil, "", 0)
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *ActivitySuite) TestTeamFeedCursor() {
+	const methodCtx = "activity.ActivitySuite.TestTeamFeedCursor"
+
+	ctx := context.Background()
+	s.CreateTaskHistory(s.taskID, s.ownerID, `{"status": {"from": "todo", "to": "done"}}`)
+	s.CreateComment(s.taskID, s.memberID, "done")
+	s.CreateMemberEvent(s.teamID, &s.ownerID, s.ownerID, repomysql.TeamMemberEventJoined, "", "", "owner")
+	joinedID := s.CreateMemberEvent(s.teamID, &s.memberID, s.memberID, repomysql.TeamMemberEventJoined, "", "", "member")
+	inviteID := s.CreateMemberEvent(s.teamID, nil, s.ownerID, repomysql.TeamMemberEventInvited, "new-activity@example.com", "", "")
+
+	seen := map[uuid.UUID]api.ActivityType{}
+	cursor := ""
+	for pages := 0; pages < 10; pages++ {
+		resp, err := s.service.TeamFeed(ctx, s.memberID, s.teamID, nil, cursor, 2)
+		s.Require().NoError(err, methodCtx)
+		s.LessOrEqual(len(resp.Items), 2)
+		for i, item := range resp.Items {
+			_, dup := seen[item.Id]
+			s.False(dup, "событие не должно повторяться между страницами")
+			seen[item.Id] = item.Type
+			if i > 0 {
+				s.False(item.OccurredAt.After(resp.Items[i-1].OccurredAt), "лента упорядочена от новых к старым")
+			}
+		}
+		if resp.NextCursor == nil {
+			break
+		}
+		cursor = *resp.NextCursor
+	}
+
+	// История, комментарий, два вступления и приглашение.
+	s.Len(seen, 5)
+	s.Equal(api.ActivityTypeInviteCreated, seen[inviteID])
+	s.Equal(api.ActivityTypeMemberJoined, seen[joinedID])
+
+	resp, err := s.service.TeamFeed(ctx, s.memberID, s.teamID, []api.ActivityType{api.ActivityTypeMemberJoined, api.ActivityTypeInviteCreated}, "", 0)
+	s.Require().NoError(err, methodCtx)
+	s.Len(resp.Items, 3)
+
+	_, err = s.service.TeamFeed(ctx, s.memberID, s.teamID, nil, "not-a-cursor", 0)
+	s.ErrorIs(err, ErrInvalidCursor, methodCtx)
+
+	_, err = s.service.TeamFeed(ctx, s.memberID, s.teamID, []api.ActivityType{"unknown"}, "", 0)
+	s.ErrorIs(err, ErrInvalidType, methodCtx)
+
+	_, err = s.service.TeamFeed(ctx, s.outsiderID, s.teamID, nil, "", 0)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+}
+
+func (s *ActivitySuite) TestTeamFeedMemberEvents() {
+	const methodCtx = "activity.ActivitySuite.TestTeamFeedMemberEvents"
+
+	ctx := context.Background()
+	leaverID := s.CreateUser("leaver-activity@example.com")
+	removedID := s.CreateUser("removed-activity@example.com")
+
+	s.CreateMemberEvent(s.teamID, &leaverID, leaverID, repomysql.TeamMemberEventJoined, "", "", "member")
+	roleID := s.CreateMemberEvent(s.teamID, &s.memberID, s.ownerID, repomysql.TeamMemberEventRoleChanged, "", "member", "admin")
+	removedEventID := s.CreateMemberEvent(s.teamID, &removedID, s.ownerID, repomysql.TeamMemberEventRemoved, "", "member", "")
+	leftID := s.CreateMemberEvent(s.teamID, &leaverID, leaverID, repomysql.TeamMemberEventLeft, "", "member", "")
+	s.CreateInvite(s.teamID, s.ownerID, "pending-activity@example.com", "")
+
+	resp, err := s.service.TeamFeed(ctx, s.memberID, s.teamID, []api.ActivityType{
+		api.ActivityTypeMemberRoleChanged,
+		api.ActivityTypeMemberRemoved,
+		api.ActivityTypeMemberLeft,
+	}, "", 0)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 3)
+
+	byID := map[uuid.UUID]api.ActivityItem{}
+	for _, item := range resp.Items {
+		byID[item.Id] = item
+	}
+	s.Require().Contains(byID, roleID)
+	s.Equal(api.ActivityTypeMemberRoleChanged, byID[roleID].Type)
+	s.Equal(api.UUID(s.memberID), *byID[roleID].UserId)
+	s.Equal(api.UUID(s.ownerID), byID[roleID].ActorId)
+	s.Equal("member", *byID[roleID].OldRole)
+	s.Equal("admin", *byID[roleID].Role)
+	s.Require().Contains(byID, removedEventID)
+	s.Equal(api.ActivityTypeMemberRemoved, byID[removedEventID].Type)
+	s.Nil(byID[removedEventID].Role)
+	s.Require().Contains(byID, leftID)
+	s.Equal(api.ActivityTypeMemberLeft, byID[leftID].Type)
+
+	resp, err = s.service.TeamFeed(ctx, s.memberID, s.teamID, []api.ActivityType{api.ActivityTypeMemberJoined, api.ActivityTypeInviteCreated}, "", 0)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 1, "лента строится по журналу, а не по текущему составу и приглашениям")
+	s.Equal(api.UUID(leaverID), *resp.Items[0].UserId, "вступление вышедшего участника остается в ленте")
+}
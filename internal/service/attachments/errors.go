@@ -0,0 +1,11 @@
+package attachments
+
+import "errors"
+
+var (
+	ErrForbidden       = errors.New("доступ запрещен")
+	ErrNotFound        = errors.New("не найдено")
+	ErrTooLarge        = errors.New("файл превышает допустимый размер")
+	ErrUnsupportedType = errors.New("недопустимый тип файла")
+	ErrEmptyFile       = errors.New("файл пуст")
+)
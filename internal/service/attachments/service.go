@@ -0,0 +1,418 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/blobstore"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+const (
+	defaultMaxSizeBytes = 10 << 20
+	sniffLen            = 512
+	maxFileNameLen      = 255
+	purgeBatchSize      = 100
+)
+
+var defaultAllowedMIMETypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"text/plain",
+}
+
+// Service реализует загрузку, выдачу и очистку вложений.
+type Service struct {
+	attachments AttachmentsRepository
+	tasks       TasksRepository
+	members     MembersRepository
+	comments    CommentsRepository
+	store       blobstore.BlobStore
+	maxSize     int64
+	allowed     map[string]struct{}
+}
+
+// AttachmentsRepository описывает работу с метаданными вложений.
+type AttachmentsRepository interface {
+	Create(ctx context.Context, exec repomysql.DBTX, record repomysql.AttachmentRecord) error
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]repomysql.AttachmentRecord, error)
+	Get(ctx context.Context, taskID uuid.UUID, attachmentID uuid.UUID) (repomysql.AttachmentRecord, error)
+	Detach(ctx context.Context, attachmentID uuid.UUID) error
+	ListOrphans(ctx context.Context, limit int) ([]repomysql.AttachmentRecord, error)
+	CountSharedKey(ctx context.Context, storageKey string, excludeID uuid.UUID) (int, error)
+	Delete(ctx context.Context, attachmentID uuid.UUID) error
+}
+
+// TasksRepository описывает доступ к задачам.
+type TasksRepository interface {
+	GetTeamID(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error)
+}
+
+// MembersRepository описывает доступ к участникам команды.
+type MembersRepository interface {
+	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// CommentsRepository описывает доступ к комментариям.
+type CommentsRepository interface {
+	Get(ctx context.Context, taskID uuid.UUID, commentID uuid.UUID) (repomysql.CommentRecord, error)
+}
+
+// NewService создает сервис вложений.
+func NewService(
+	attachments AttachmentsRepository,
+	tasks TasksRepository,
+	members MembersRepository,
+	comments CommentsRepository,
+	store blobstore.BlobStore,
+	cfg config.AttachmentsConfig,
+) (*Service, error) {
+	const methodCtx = "attachments.NewService"
+
+	slog.Debug("инициализация сервиса вложений", slog.String("context", methodCtx))
+
+	if attachments == nil {
+		return nil, fmt.Errorf("%s: attachments repo не задан", methodCtx)
+	}
+	if tasks == nil {
+		return nil, fmt.Errorf("%s: tasks repo не задан", methodCtx)
+	}
+	if members == nil {
+		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
+	}
+	if comments == nil {
+		return nil, fmt.Errorf("%s: comments repo не задан", methodCtx)
+	}
+	if store == nil {
+		return nil, fmt.Errorf("%s: blob store не задан", methodCtx)
+	}
+
+	maxSize := cfg.MaxSizeBytes
+	if maxSize <= 0 {
+		maxSize = defaultMaxSizeBytes
+	}
+
+	types := cfg.AllowedMIMETypes
+	if len(types) == 0 {
+		types = defaultAllowedMIMETypes
+	}
+	allowed := make(map[string]struct{}, len(types))
+	for _, t := range types {
+		allowed[strings.ToLower(strings.TrimSpace(t))] = struct{}{}
+	}
+
+	return &Service{
+		attachments: attachments,
+		tasks:       tasks,
+		members:     members,
+		comments:    comments,
+		store:       store,
+		maxSize:     maxSize,
+		allowed:     allowed,
+	}, nil
+}
+
+// Upload потоково сохраняет файл и привязывает его к задаче или комментарию.
+// Тип содержимого определяется по первым байтам файла, а не по заголовкам клиента.
+func (s *Service) Upload(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, commentID *uuid.UUID, fileName string, body io.Reader) (api.Attachment, error) {
+	const methodCtx = "attachments.Service.Upload"
+
+	slog.Debug("вызов загрузки вложения", slog.String("context", methodCtx))
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return api.Attachment{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	target := repomysql.AttachmentTargetTask
+	if commentID != nil {
+		if _, err := s.comments.Get(ctx, taskID, *commentID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return api.Attachment{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+			}
+			return api.Attachment{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		target = repomysql.AttachmentTargetComment
+	}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(body, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return api.Attachment{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if n == 0 {
+		return api.Attachment{}, fmt.Errorf("%s: %w", methodCtx, ErrEmptyFile)
+	}
+	head = head[:n]
+
+	contentType := detectContentType(head)
+	if _, ok := s.allowed[contentType]; !ok {
+		return api.Attachment{}, fmt.Errorf("%s: %w: %s", methodCtx, ErrUnsupportedType, contentType)
+	}
+
+	attachmentID := uuid.New()
+	storageKey := "attachments/" + attachmentID.String()
+	limited := &limitReader{r: io.MultiReader(bytes.NewReader(head), body), remaining: s.maxSize}
+
+	if err := s.store.Put(ctx, storageKey, limited, -1, contentType); err != nil {
+		if errors.Is(err, ErrTooLarge) {
+			return api.Attachment{}, fmt.Errorf("%s: %w", methodCtx, ErrTooLarge)
+		}
+		return api.Attachment{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	record := repomysql.AttachmentRecord{
+		ID:          attachmentID,
+		TaskID:      taskID,
+		CommentID:   commentID,
+		Target:      target,
+		UploadedBy:  userID,
+		FileName:    sanitizeFileName(fileName),
+		ContentType: contentType,
+		SizeBytes:   limited.read,
+		StorageKey:  storageKey,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	if err := s.attachments.Create(ctx, nil, record); err != nil {
+		if delErr := s.store.Delete(context.WithoutCancel(ctx), storageKey); delErr != nil {
+			slog.Error("ошибка удаления объекта после сбоя записи метаданных",
+				slog.String("context", methodCtx),
+				slog.String("error", delErr.Error()),
+			)
+		}
+		return api.Attachment{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return attachmentToAPI(record), nil
+}
+
+// List возвращает вложения задачи и ее комментариев.
+func (s *Service) List(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.AttachmentsListResponse, error) {
+	const methodCtx = "attachments.Service.List"
+
+	slog.Debug("вызов списка вложений", slog.String("context", methodCtx))
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return api.AttachmentsListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	records, err := s.attachments.ListByTask(ctx, taskID)
+	if err != nil {
+		return api.AttachmentsListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.Attachment, 0, len(records))
+	for _, record := range records {
+		items = append(items, attachmentToAPI(record))
+	}
+
+	return api.AttachmentsListResponse{Items: items}, nil
+}
+
+// Download возвращает метаданные и содержимое вложения. Вызывающий обязан закрыть reader.
+func (s *Service) Download(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, attachmentID uuid.UUID) (api.Attachment, io.ReadCloser, error) {
+	const methodCtx = "attachments.Service.Download"
+
+	slog.Debug("вызов скачивания вложения", slog.String("context", methodCtx))
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return api.Attachment{}, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	record, err := s.attachments.Get(ctx, taskID, attachmentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Attachment{}, nil, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Attachment{}, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	content, err := s.store.Get(ctx, record.StorageKey)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return api.Attachment{}, nil, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Attachment{}, nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return attachmentToAPI(record), content, nil
+}
+
+// Delete отвязывает вложение. Доступно загрузившему, пока он состоит в команде задачи;
+// объект в хранилище удаляется фоновой очисткой.
+func (s *Service) Delete(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, attachmentID uuid.UUID) error {
+	const methodCtx = "attachments.Service.Delete"
+
+	slog.Debug("вызов удаления вложения", slog.String("context", methodCtx))
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	record, err := s.attachments.Get(ctx, taskID, attachmentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if record.UploadedBy != userID {
+		return fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	if err := s.attachments.Detach(ctx, attachmentID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return nil
+}
+
+// PurgeOrphans удаляет из хранилища вложения удаленных задач и комментариев.
+// Объект, на который ссылаются другие вложения, остается в хранилище.
+// Возвращает количество удаленных вложений.
+func (s *Service) PurgeOrphans(ctx context.Context) (int, error) {
+	const methodCtx = "attachments.Service.PurgeOrphans"
+
+	purged := 0
+	for {
+		records, err := s.attachments.ListOrphans(ctx, purgeBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+
+		for _, record := range records {
+			shared, err := s.attachments.CountSharedKey(ctx, record.StorageKey, record.ID)
+			if err != nil {
+				return purged, fmt.Errorf("%s: %w", methodCtx, err)
+			}
+			if shared == 0 {
+				if err := s.store.Delete(ctx, record.StorageKey); err != nil {
+					return purged, fmt.Errorf("%s: %w", methodCtx, err)
+				}
+			}
+			if err := s.attachments.Delete(ctx, record.ID); err != nil {
+				return purged, fmt.Errorf("%s: %w", methodCtx, err)
+			}
+			purged++
+		}
+
+		if len(records) < purgeBatchSize {
+			break
+		}
+	}
+
+	if purged > 0 {
+		slog.Info("очищены осиротевшие вложения", slog.String("context", methodCtx), slog.Int("count", purged))
+	}
+
+	return purged, nil
+}
+
+func (s *Service) checkAccess(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) error {
+	teamID, err := s.tasks.GetTeamID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	isMember, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func attachmentToAPI(record repomysql.AttachmentRecord) api.Attachment {
+	var commentID *api.UUID
+	if record.CommentID != nil {
+		tmp := api.UUID(*record.CommentID)
+		commentID = &tmp
+	}
+
+	return api.Attachment{
+		Id:          api.UUID(record.ID),
+		TaskId:      api.UUID(record.TaskID),
+		CommentId:   commentID,
+		UploadedBy:  api.UUID(record.UploadedBy),
+		FileName:    record.FileName,
+		ContentType: record.ContentType,
+		SizeBytes:   record.SizeBytes,
+		CreatedAt:   record.CreatedAt,
+	}
+}
+
+func detectContentType(head []byte) string {
+	detected := http.DetectContentType(head)
+	mediaType, _, err := mime.ParseMediaType(detected)
+	if err != nil {
+		return detected
+	}
+	return strings.ToLower(mediaType)
+}
+
+func sanitizeFileName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = strings.TrimSpace(path.Base(name))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == "/" {
+		return "file"
+	}
+	for len(name) > maxFileNameLen {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
+
+// limitReader считает прочитанные байты и возвращает ErrTooLarge при превышении лимита.
+type limitReader struct {
+	r         io.Reader
+	remaining int64
+	read      int64
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, ErrTooLarge
+	}
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, ErrTooLarge
+	}
+	return n, err
+}
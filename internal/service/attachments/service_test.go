@@ -0,0 +1,229 @@
+package attachments
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/blobstore"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+type AttachmentsSuite struct {
+	tests.IntegrationSuite
+	service    *Service
+	store      blobstore.BlobStore
+	ownerID    uuid.UUID
+	memberID   uuid.UUID
+	outsiderID uuid.UUID
+	teamID     uuid.UUID
+	taskID     uuid.UUID
+}
+
+func TestAttachmentsSuite(t *testing.T) {
+	const methodCtx = "attachments.TestAttachmentsSuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(AttachmentsSuite))
+}
+
+func (s *AttachmentsSuite) SetupTest() {
+	const methodCtx = "attachments.AttachmentsSuite.SetupTest"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-attach@example.com")
+	s.memberID = s.CreateUser("member-attach@example.com")
+	s.outsiderID = s.CreateUser("outsider-attach@example.com")
+
+	s.teamID = s.CreateTeam("Attach Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.memberID, "member")
+
+	s.taskID = s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Task", "")
+
+	store, err := blobstore.NewLocalStore(s.T().TempDir())
+	s.Require().NoError(err, methodCtx)
+	s.store = store
+
+	service, err := NewService(
+		repomysql.NewTaskAttachmentsRepo(s.DB),
+		repomysql.NewTasksRepo(s.DB),
+		repomysql.NewTeamMembersRepo(s.DB),
+		repomysql.NewCommentsRepo(s.DB),
+		store,
+		config.AttachmentsConfig{MaxSizeBytes: 64, AllowedMIMETypes: []string{"image/png", "text/plain"}},
+	)
+	s.Require().NoError(err, methodCtx)
+	s.service = service
+}
+
+func (s *AttachmentsSuite) TestUploadAndDownload() {
+	const methodCtx = "attachments.AttachmentsSuite.TestUploadAndDownload"
+
+	ctx := context.Background()
+	content := append(append([]byte{}, pngHeader...), []byte("pixels")...)
+
+	resp, err := s.service.Upload(ctx, s.memberID, s.taskID, nil, "../../img.png", bytes.NewReader(content))
+	s.Require().NoError(err, methodCtx)
+	s.Equal("image/png", resp.ContentType)
+	s.Equal("img.png", resp.FileName)
+	s.Equal(int64(len(content)), resp.SizeBytes)
+
+	meta, rc, err := s.service.Download(ctx, s.ownerID, s.taskID, resp.Id)
+	s.Require().NoError(err, methodCtx)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(content, data)
+	s.Equal(resp.Id, meta.Id)
+
+	list, err := s.service.List(ctx, s.ownerID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(list.Items, 1)
+}
+
+func (s *AttachmentsSuite) TestUploadToComment() {
+	const methodCtx = "attachments.AttachmentsSuite.TestUploadToComment"
+
+	ctx := context.Background()
+	commentID := s.CreateComment(s.taskID, s.memberID, "see file")
+
+	resp, err := s.service.Upload(ctx, s.memberID, s.taskID, &commentID, "a.txt", strings.NewReader("text"))
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(resp.CommentId)
+	s.Equal(commentID, *resp.CommentId)
+
+	missing := uuid.New()
+	_, err = s.service.Upload(ctx, s.memberID, s.taskID, &missing, "a.txt", strings.NewReader("text"))
+	s.ErrorIs(err, ErrNotFound)
+}
+
+func (s *AttachmentsSuite) TestUploadValidation() {
+	const methodCtx = "attachments.AttachmentsSuite.TestUploadValidation"
+
+	ctx := context.Background()
+
+	_, err := s.service.Upload(ctx, s.outsiderID, s.taskID, nil, "a.txt", strings.NewReader("text"))
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Upload(ctx, s.memberID, s.taskID, nil, "a.txt", strings.NewReader(""))
+	s.ErrorIs(err, ErrEmptyFile, methodCtx)
+
+	_, err = s.service.Upload(ctx, s.memberID, s.taskID, nil, "a.pdf", strings.NewReader("%PDF-1.4"))
+	s.ErrorIs(err, ErrUnsupportedType, methodCtx)
+
+	_, err = s.service.Upload(ctx, s.memberID, s.taskID, nil, "a.txt", strings.NewReader(strings.Repeat("a", 65)))
+	s.ErrorIs(err, ErrTooLarge, methodCtx)
+
+	list, err := s.service.List(ctx, s.ownerID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.Len(list.Items, 0)
+}
+
+func (s *AttachmentsSuite) TestDeleteAndPurge() {
+	const methodCtx = "attachments.AttachmentsSuite.TestDeleteAndPurge"
+
+	ctx := context.Background()
+
+	resp, err := s.service.Upload(ctx, s.memberID, s.taskID, nil, "a.txt", strings.NewReader("text"))
+	s.Require().NoError(err, methodCtx)
+
+	err = s.service.Delete(ctx, s.ownerID, s.taskID, resp.Id)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	s.Require().NoError(s.service.Delete(ctx, s.memberID, s.taskID, resp.Id), methodCtx)
+
+	_, _, err = s.service.Download(ctx, s.memberID, s.taskID, resp.Id)
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+
+	purged, err := s.service.PurgeOrphans(ctx)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(1, purged)
+
+	_, err = s.store.Get(ctx, "attachments/"+resp.Id.String())
+	s.ErrorIs(err, blobstore.ErrNotFound, methodCtx)
+}
+
+func (s *AttachmentsSuite) TestDeleteAfterLeavingTeam() {
+	const methodCtx = "attachments.AttachmentsSuite.TestDeleteAfterLeavingTeam"
+
+	ctx := context.Background()
+
+	resp, err := s.service.Upload(ctx, s.memberID, s.taskID, nil, "a.txt", strings.NewReader("text"))
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.DB.ExecContext(ctx, "DELETE FROM team_members WHERE team_id = ? AND user_id = ?", s.teamID.String(), s.memberID.String())
+	s.Require().NoError(err, methodCtx)
+
+	err = s.service.Delete(ctx, s.memberID, s.taskID, resp.Id)
+	s.ErrorIs(err, ErrForbidden, "бывший участник не удаляет вложения")
+
+	list, err := s.service.List(ctx, s.ownerID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.Len(list.Items, 1)
+}
+
+func (s *AttachmentsSuite) TestPurgeAfterTaskDeleted() {
+	const methodCtx = "attachments.AttachmentsSuite.TestPurgeAfterTaskDeleted"
+
+	ctx := context.Background()
+
+	_, err := s.service.Upload(ctx, s.memberID, s.taskID, nil, "a.txt", strings.NewReader("text"))
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.DB.ExecContext(ctx, "DELETE FROM tasks WHERE id = ?", s.taskID.String())
+	s.Require().NoError(err, methodCtx)
+
+	purged, err := s.service.PurgeOrphans(ctx)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(1, purged)
+}
+
+func (s *AttachmentsSuite) TestPurgeKeepsSharedObject() {
+	const methodCtx = "attachments.AttachmentsSuite.TestPurgeKeepsSharedObject"
+
+	ctx := context.Background()
+
+	resp, err := s.service.Upload(ctx, s.memberID, s.taskID, nil, "a.txt", strings.NewReader("text"))
+	s.Require().NoError(err, methodCtx)
+
+	copyTaskID := s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Copy", "")
+	_, err = s.DB.ExecContext(ctx,
+		`INSERT INTO task_attachments (id, task_id, target, uploaded_by, file_name, content_type, size_bytes, storage_key, created_at)
+		SELECT ?, ?, target, uploaded_by, file_name, content_type, size_bytes, storage_key, created_at FROM task_attachments WHERE id = ?`,
+		uuid.New().String(), copyTaskID.String(), resp.Id.String(),
+	)
+	s.Require().NoError(err, methodCtx)
+
+	s.Require().NoError(s.service.Delete(ctx, s.memberID, s.taskID, resp.Id), methodCtx)
+
+	purged, err := s.service.PurgeOrphans(ctx)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(1, purged)
+
+	content, err := s.store.Get(ctx, "attachments/"+resp.Id.String())
+	s.Require().NoError(err, "объект копии остается в хранилище")
+	_ = content.Close()
+}
@@ -0,0 +1,8 @@
+package calendar
+
+import "errors"
+
+var (
+	ErrNotFound         = errors.New("не найдено")
+	ErrInvalidComponent = errors.New("неизвестный тип записей календаря")
+)
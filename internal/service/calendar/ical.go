@@ -0,0 +1,148 @@
+package calendar
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+const (
+	icalTimeLayout = "20060102T150405Z"
+	icalLineLimit  = 75
+	icalProductID  = "-//mkk//tasks//RU"
+	icalUIDDomain  = "@mkk-tasks"
+)
+
+// icalEntry описывает задачу, подготовленную для записи в ленту.
+type icalEntry struct {
+	task repomysql.CalendarTaskRecord
+	url  string
+}
+
+// writeICal пишет ленту в формате iCalendar (RFC 5545): строки через CRLF,
+// экранирование текста и перенос строк длиннее 75 октетов.
+func writeICal(w io.Writer, component api.CalendarComponent, name string, stamp time.Time, entries []icalEntry) error {
+	out := &icalWriter{w: bufio.NewWriter(w)}
+
+	out.line("BEGIN:VCALENDAR")
+	out.line("VERSION:2.0")
+	out.line("PRODID:" + icalProductID)
+	out.line("CALSCALE:GREGORIAN")
+	out.line("METHOD:PUBLISH")
+	out.line("X-WR-CALNAME:" + escapeText(name))
+
+	for _, entry := range entries {
+		task := entry.task
+		lastModified := task.CreatedAt
+		if task.UpdatedAt != nil {
+			lastModified = *task.UpdatedAt
+		}
+		summary := task.Title + " [" + task.TeamName + "]"
+
+		if component == api.Vtodo {
+			out.line("BEGIN:VTODO")
+		} else {
+			out.line("BEGIN:VEVENT")
+		}
+		out.line("UID:" + task.ID.String() + icalUIDDomain)
+		out.line("DTSTAMP:" + formatTime(stamp))
+		out.line("LAST-MODIFIED:" + formatTime(lastModified))
+		out.line("SUMMARY:" + escapeText(summary))
+		if task.Description != nil && *task.Description != "" {
+			out.line("DESCRIPTION:" + escapeText(*task.Description))
+		}
+		if entry.url != "" {
+			out.line("URL:" + entry.url)
+		}
+		if component == api.Vtodo {
+			out.line("DUE:" + formatTime(task.DueAt))
+			out.line("STATUS:" + todoStatus(task.Status))
+			if task.CompletedAt != nil {
+				out.line("COMPLETED:" + formatTime(*task.CompletedAt))
+			}
+			out.line("END:VTODO")
+			continue
+		}
+		out.line("DTSTART:" + formatTime(task.DueAt))
+		out.line("DTEND:" + formatTime(task.DueAt))
+		out.line("STATUS:CONFIRMED")
+		out.line("CATEGORIES:" + escapeText(task.Status))
+		out.line("TRANSP:TRANSPARENT")
+		out.line("END:VEVENT")
+	}
+
+	out.line("END:VCALENDAR")
+	return out.flush()
+}
+
+// todoStatus сопоставляет статус задачи со статусом VTODO.
+func todoStatus(status string) string {
+	switch status {
+	case string(api.Done):
+		return "COMPLETED"
+	case string(api.InProgress):
+		return "IN-PROCESS"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+func formatTime(value time.Time) string {
+	return value.UTC().Format(icalTimeLayout)
+}
+
+// escapeText экранирует значение типа TEXT.
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		";", "\\;",
+		",", "\\,",
+		"\r\n", "\\n",
+		"\n", "\\n",
+		"\r", "\\n",
+	)
+	return replacer.Replace(value)
+}
+
+// icalWriter запоминает первую ошибку записи, чтобы не проверять каждую строку.
+type icalWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line пишет строку содержимого, перенося ее по границе символа каждые 75 октетов.
+func (i *icalWriter) line(value string) {
+	if i.err != nil {
+		return
+	}
+
+	limit := icalLineLimit
+	for len(value) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(value[cut]) {
+			cut--
+		}
+		if _, i.err = i.w.WriteString(value[:cut] + "\r\n "); i.err != nil {
+			return
+		}
+		value = value[cut:]
+		// Строка продолжения начинается с пробела, он входит в лимит.
+		limit = icalLineLimit - 1
+	}
+	_, i.err = i.w.WriteString(value + "\r\n")
+}
+
+func (i *icalWriter) flush() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.w.Flush()
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
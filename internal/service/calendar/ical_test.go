@@ -0,0 +1,92 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+func testEntry(title string, status string) icalEntry {
+	description := "строка 1\nстрока 2; a, b"
+	completedAt := time.Date(2025, time.March, 9, 8, 0, 0, 0, time.UTC)
+	record := repomysql.CalendarTaskRecord{
+		ID:          uuid.New(),
+		TeamName:    "Core",
+		Title:       title,
+		Description: &description,
+		Status:      status,
+		DueAt:       time.Date(2025, time.March, 10, 12, 30, 0, 0, time.UTC),
+		CreatedAt:   time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC),
+	}
+	if status == string(api.Done) {
+		record.CompletedAt = &completedAt
+	}
+	return icalEntry{task: record, url: "https://tasks.example.com/tasks/" + record.ID.String()}
+}
+
+func renderICal(t *testing.T, component api.CalendarComponent, entries ...icalEntry) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	stamp := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, writeICal(&buf, component, "Задачи", stamp, entries))
+	return buf.String()
+}
+
+func TestWriteICalEvents(t *testing.T) {
+	const methodCtx = "calendar.TestWriteICalEvents"
+
+	entry := testEntry("Релиз", string(api.InProgress))
+	payload := renderICal(t, api.Vevent, entry)
+
+	require.True(t, strings.HasPrefix(payload, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"), methodCtx)
+	require.True(t, strings.HasSuffix(payload, "END:VCALENDAR\r\n"), methodCtx)
+	require.Contains(t, payload, "UID:"+entry.task.ID.String()+"@mkk-tasks\r\n", methodCtx)
+	require.Contains(t, payload, "DTSTART:20250310T123000Z\r\n", methodCtx)
+	require.Contains(t, payload, "SUMMARY:Релиз [Core]\r\n", methodCtx)
+	require.Contains(t, payload, `DESCRIPTION:строка 1\nстрока 2\; a\, b`+"\r\n", methodCtx)
+	require.Contains(t, payload, "CATEGORIES:in_progress\r\n", methodCtx)
+	require.Contains(t, payload, "URL:"+entry.url+"\r\n", methodCtx)
+	require.NotContains(t, payload, "VTODO", methodCtx)
+}
+
+func TestWriteICalTodos(t *testing.T) {
+	const methodCtx = "calendar.TestWriteICalTodos"
+
+	payload := renderICal(t, api.Vtodo,
+		testEntry("a", string(api.Todo)),
+		testEntry("b", string(api.InProgress)),
+		testEntry("c", string(api.Done)),
+	)
+
+	require.Equal(t, 3, strings.Count(payload, "BEGIN:VTODO\r\n"), methodCtx)
+	require.Contains(t, payload, "STATUS:NEEDS-ACTION\r\n", methodCtx)
+	require.Contains(t, payload, "STATUS:IN-PROCESS\r\n", methodCtx)
+	require.Contains(t, payload, "STATUS:COMPLETED\r\n", methodCtx)
+	require.Contains(t, payload, "COMPLETED:20250309T080000Z\r\n", methodCtx)
+	require.Contains(t, payload, "DUE:20250310T123000Z\r\n", methodCtx)
+	require.NotContains(t, payload, "DTSTART", methodCtx)
+}
+
+func TestWriteICalFoldsLongLines(t *testing.T) {
+	const methodCtx = "calendar.TestWriteICalFoldsLongLines"
+
+	title := strings.TrimSpace(strings.Repeat("задача ", 40))
+	payload := renderICal(t, api.Vevent, testEntry(title, string(api.Todo)))
+
+	for _, line := range strings.Split(strings.TrimSuffix(payload, "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(line), 75, methodCtx)
+		require.True(t, utf8.ValidString(line), "перенос не разрывает символ")
+	}
+
+	unfolded := strings.ReplaceAll(payload, "\r\n ", "")
+	require.Contains(t, unfolded, "SUMMARY:"+title+" [Core]\r\n", methodCtx)
+}
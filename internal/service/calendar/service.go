@@ -0,0 +1,180 @@
+package calendar
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+const (
+	defaultPastDays = 30
+	maxFeedItems    = 1000
+	tokenBytes      = 32
+	feedName        = "Задачи"
+)
+
+// Service выпускает токены календарных лент и формирует ленты задач со сроками.
+type Service struct {
+	feeds     FeedsRepository
+	publicURL string
+	taskURL   string
+	pastDays  int
+	now       func() time.Time
+}
+
+// FeedsRepository описывает хранение лент и выборку задач для них.
+type FeedsRepository interface {
+	Get(ctx context.Context, userID uuid.UUID) (repomysql.CalendarFeedRecord, error)
+	GetUserByToken(ctx context.Context, tokenHash string) (uuid.UUID, error)
+	Save(ctx context.Context, record repomysql.CalendarFeedRecord) error
+	Delete(ctx context.Context, userID uuid.UUID) (bool, error)
+	ListTasks(ctx context.Context, userID uuid.UUID, dueAfter time.Time, limit int) ([]repomysql.CalendarTaskRecord, error)
+}
+
+// NewService создает сервис календарных лент.
+func NewService(feeds FeedsRepository, cfg config.CalendarConfig) (*Service, error) {
+	const methodCtx = "calendar.NewService"
+
+	slog.Debug("инициализация сервиса календарных лент", slog.String("context", methodCtx))
+
+	if feeds == nil {
+		return nil, fmt.Errorf("%s: feeds repo не задан", methodCtx)
+	}
+
+	pastDays := cfg.PastDays
+	if pastDays <= 0 {
+		pastDays = defaultPastDays
+	}
+
+	return &Service{
+		feeds:     feeds,
+		publicURL: strings.TrimRight(cfg.PublicURL, "/"),
+		taskURL:   cfg.TaskURL,
+		pastDays:  pastDays,
+		now:       time.Now,
+	}, nil
+}
+
+// Feed возвращает состояние ленты пользователя. Сам токен не хранится и не возвращается.
+func (s *Service) Feed(ctx context.Context, userID uuid.UUID) (api.CalendarFeed, error) {
+	const methodCtx = "calendar.Service.Feed"
+
+	slog.Debug("вызов получения календарной ленты", slog.String("context", methodCtx))
+
+	record, err := s.feeds.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.CalendarFeed{Enabled: false}, nil
+		}
+		return api.CalendarFeed{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.CalendarFeed{Enabled: true, CreatedAt: &record.CreatedAt}, nil
+}
+
+// Rotate выпускает новый токен ленты; предыдущий токен перестает действовать.
+func (s *Service) Rotate(ctx context.Context, userID uuid.UUID) (api.CalendarFeedToken, error) {
+	const methodCtx = "calendar.Service.Rotate"
+
+	slog.Debug("вызов выпуска токена календарной ленты", slog.String("context", methodCtx))
+
+	raw := make([]byte, tokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return api.CalendarFeedToken{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	now := s.now().UTC().Truncate(time.Second)
+	if err := s.feeds.Save(ctx, repomysql.CalendarFeedRecord{
+		UserID:    userID,
+		TokenHash: hashToken(token),
+		CreatedAt: now,
+	}); err != nil {
+		return api.CalendarFeedToken{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.CalendarFeedToken{
+		Token:     token,
+		Url:       s.publicURL + "/api/v1/calendar/" + token + "/tasks.ics",
+		CreatedAt: now,
+	}, nil
+}
+
+// Revoke отзывает ленту пользователя.
+func (s *Service) Revoke(ctx context.Context, userID uuid.UUID) error {
+	const methodCtx = "calendar.Service.Revoke"
+
+	slog.Debug("вызов отзыва календарной ленты", slog.String("context", methodCtx))
+
+	deleted, err := s.feeds.Delete(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !deleted {
+		return fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	}
+	return nil
+}
+
+// Render проверяет токен и возвращает функцию, которая пишет ленту владельца токена.
+// Неизвестный или отозванный токен считается ненайденным, чтобы не раскрывать существование лент.
+func (s *Service) Render(ctx context.Context, token string, component *api.CalendarComponent) (func(w io.Writer) error, error) {
+	const methodCtx = "calendar.Service.Render"
+
+	slog.Debug("вызов календарной ленты", slog.String("context", methodCtx))
+
+	kind := api.Vevent
+	if component != nil {
+		kind = *component
+	}
+	if kind != api.Vevent && kind != api.Vtodo {
+		return nil, fmt.Errorf("%s: %w", methodCtx, ErrInvalidComponent)
+	}
+
+	userID, err := s.feeds.GetUserByToken(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	now := s.now().UTC()
+	tasks, err := s.feeds.ListTasks(ctx, userID, now.AddDate(0, 0, -s.pastDays), maxFeedItems)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	entries := make([]icalEntry, 0, len(tasks))
+	for _, task := range tasks {
+		entry := icalEntry{task: task}
+		if s.taskURL != "" {
+			entry.url = strings.ReplaceAll(s.taskURL, "{id}", task.ID.String())
+		}
+		entries = append(entries, entry)
+	}
+
+	return func(w io.Writer) error {
+		return writeICal(w, kind, feedName, now, entries)
+	}, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,166 @@
+package calendar
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+type CalendarSuite struct {
+	tests.IntegrationSuite
+	service *Service
+	now     time.Time
+	ownerID uuid.UUID
+	userID  uuid.UUID
+	teamID  uuid.UUID
+	otherID uuid.UUID
+}
+
+func TestCalendarSuite(t *testing.T) {
+	const methodCtx = "calendar.TestCalendarSuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(CalendarSuite))
+}
+
+func (s *CalendarSuite) SetupTest() {
+	const methodCtx = "calendar.CalendarSuite.SetupTest"
+
+	s.TruncateTables(
+		"calendar_feeds",
+		"task_assignees",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-calendar@example.com")
+	s.userID = s.CreateUser("user-calendar@example.com")
+
+	s.teamID = s.CreateTeam("Calendar Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.userID, "member")
+	s.otherID = s.CreateTeam("Other Team", s.ownerID)
+	s.AddTeamMember(s.otherID, s.ownerID, "owner")
+	s.AddTeamMember(s.otherID, s.userID, "member")
+
+	service, err := NewService(repomysql.NewCalendarFeedsRepo(s.DB), config.CalendarConfig{
+		PublicURL: "https://tasks.example.com/",
+		TaskURL:   "https://tasks.example.com/tasks/{id}",
+		PastDays:  7,
+	})
+	s.Require().NoError(err, methodCtx)
+
+	s.now = time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return s.now }
+	s.service = service
+}
+
+func (s *CalendarSuite) TestRotateAndRevoke() {
+	const methodCtx = "calendar.CalendarSuite.TestRotateAndRevoke"
+
+	ctx := context.Background()
+	feed, err := s.service.Feed(ctx, s.userID)
+	s.Require().NoError(err, methodCtx)
+	s.False(feed.Enabled, methodCtx)
+
+	first, err := s.service.Rotate(ctx, s.userID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal("https://tasks.example.com/api/v1/calendar/"+first.Token+"/tasks.ics", first.Url)
+
+	var stored string
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT token_hash FROM calendar_feeds WHERE user_id = ?", s.userID.String()).Scan(&stored), methodCtx)
+	s.NotEqual(first.Token, stored, "токен хранится только в виде хеша")
+
+	second, err := s.service.Rotate(ctx, s.userID)
+	s.Require().NoError(err, methodCtx)
+	s.NotEqual(first.Token, second.Token, methodCtx)
+
+	_, err = s.service.Render(ctx, first.Token, nil)
+	s.ErrorIs(err, ErrNotFound, "перевыпуск отзывает прежний токен")
+	_, err = s.service.Render(ctx, second.Token, nil)
+	s.Require().NoError(err, methodCtx)
+
+	feed, err = s.service.Feed(ctx, s.userID)
+	s.Require().NoError(err, methodCtx)
+	s.True(feed.Enabled, methodCtx)
+
+	s.Require().NoError(s.service.Revoke(ctx, s.userID), methodCtx)
+	_, err = s.service.Render(ctx, second.Token, nil)
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+	s.ErrorIs(s.service.Revoke(ctx, s.userID), ErrNotFound, methodCtx)
+}
+
+func (s *CalendarSuite) TestRenderScopedToFeedOwner() {
+	const methodCtx = "calendar.CalendarSuite.TestRenderScopedToFeedOwner"
+
+	ctx := context.Background()
+	mine := s.createDueTask(s.teamID, &s.userID, "mine", "todo", s.now.Add(24*time.Hour))
+	otherTeam := s.createDueTask(s.otherID, &s.userID, "other team", "done", s.now.Add(-24*time.Hour))
+	notMine := s.createDueTask(s.teamID, &s.ownerID, "not mine", "todo", s.now.Add(24*time.Hour))
+	old := s.createDueTask(s.teamID, &s.userID, "old", "todo", s.now.AddDate(0, 0, -30))
+	archived := s.createDueTask(s.teamID, &s.userID, "archived", "done", s.now.Add(time.Hour))
+	_, err := s.DB.ExecContext(ctx, "UPDATE tasks SET archived_at = ? WHERE id = ?", s.now, archived.String())
+	s.Require().NoError(err, methodCtx)
+	s.CreateTask(s.teamID, s.ownerID, &s.userID, "todo", "no due", "")
+
+	token, err := s.service.Rotate(ctx, s.userID)
+	s.Require().NoError(err, methodCtx)
+
+	payload := s.render(token.Token, nil)
+	s.Contains(payload, "UID:"+mine.String()+"@mkk-tasks", methodCtx)
+	s.Contains(payload, "UID:"+otherTeam.String()+"@mkk-tasks", "ленты охватывают все команды пользователя")
+	s.Contains(payload, "URL:https://tasks.example.com/tasks/"+mine.String(), methodCtx)
+	s.NotContains(payload, notMine.String(), "чужие задачи не попадают в ленту")
+	s.NotContains(payload, old.String(), "задачи старше окна не попадают в ленту")
+	s.NotContains(payload, archived.String(), "архивные задачи не попадают в ленту")
+	s.NotContains(payload, "no due", methodCtx)
+	s.Equal(2, strings.Count(payload, "BEGIN:VEVENT"), methodCtx)
+
+	todo := api.Vtodo
+	payload = s.render(token.Token, &todo)
+	s.Equal(2, strings.Count(payload, "BEGIN:VTODO"), methodCtx)
+	s.Contains(payload, "STATUS:COMPLETED", methodCtx)
+
+	_, err = s.DB.ExecContext(ctx, "DELETE FROM team_members WHERE team_id = ? AND user_id = ?", s.otherID.String(), s.userID.String())
+	s.Require().NoError(err, methodCtx)
+	payload = s.render(token.Token, nil)
+	s.NotContains(payload, otherTeam.String(), "после выхода из команды ее задачи пропадают из ленты")
+
+	invalid := api.CalendarComponent("vjournal")
+	_, err = s.service.Render(ctx, token.Token, &invalid)
+	s.ErrorIs(err, ErrInvalidComponent, methodCtx)
+}
+
+func (s *CalendarSuite) render(token string, component *api.CalendarComponent) string {
+	const methodCtx = "calendar.CalendarSuite.render"
+
+	write, err := s.service.Render(context.Background(), token, component)
+	s.Require().NoError(err, methodCtx)
+
+	var buf bytes.Buffer
+	s.Require().NoError(write(&buf), methodCtx)
+	return buf.String()
+}
+
+func (s *CalendarSuite) createDueTask(teamID uuid.UUID, assigneeID *uuid.UUID, title string, status string, dueAt time.Time) uuid.UUID {
+	const methodCtx = "calendar.CalendarSuite.createDueTask"
+
+	taskID := s.CreateTask(teamID, s.ownerID, assigneeID, status, title, "")
+	_, err := s.DB.ExecContext(context.Background(), "UPDATE tasks SET due_at = ? WHERE id = ?", dueAt, taskID.String())
+	s.Require().NoError(err, methodCtx)
+	return taskID
+}
@@ -17,14 +17,16 @@ import (
 
 // Service реализует бизнес-логику комментариев.
 type Service struct {
+	db       *sql.DB
 	comments CommentsRepository
 	tasks    TasksRepository
 	members  MembersRepository
+	watchers WatchersRepository
 }
 
 // CommentsRepository описывает работу с комментариями.
 type CommentsRepository interface {
-	Create(ctx context.Context, record repomysql.CommentRecord) error
+	Create(ctx context.Context, exec repomysql.DBTX, record repomysql.CommentRecord) error
 	List(ctx context.Context, taskID uuid.UUID, page int, perPage int) ([]repomysql.CommentRecord, error)
 	Count(ctx context.Context, taskID uuid.UUID) (int, error)
 	Get(ctx context.Context, taskID uuid.UUID, commentID uuid.UUID) (repomysql.CommentRecord, error)
@@ -42,12 +44,20 @@ type MembersRepository interface {
 	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
 }
 
+// WatchersRepository описывает подписку пользователей на задачи.
+type WatchersRepository interface {
+	Add(ctx context.Context, exec repomysql.DBTX, taskID uuid.UUID, userID uuid.UUID, createdAt time.Time) error
+}
+
 // NewService создает сервис комментариев.
-func NewService(comments CommentsRepository, tasks TasksRepository, members MembersRepository) (*Service, error) {
+func NewService(db *sql.DB, comments CommentsRepository, tasks TasksRepository, members MembersRepository, watchers WatchersRepository) (*Service, error) {
 	const methodCtx = "comments.NewService"
 
 	slog.Debug("инициализация сервиса комментариев", slog.String("context", methodCtx))
 
+	if db == nil {
+		return nil, fmt.Errorf("%s: db не задан", methodCtx)
+	}
 	if comments == nil {
 		return nil, fmt.Errorf("%s: comments repo не задан", methodCtx)
 	}
@@ -57,8 +67,11 @@ func NewService(comments CommentsRepository, tasks TasksRepository, members Memb
 	if members == nil {
 		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
 	}
+	if watchers == nil {
+		return nil, fmt.Errorf("%s: watchers repo не задан", methodCtx)
+	}
 
-	return &Service{comments: comments, tasks: tasks, members: members}, nil
+	return &Service{db: db, comments: comments, tasks: tasks, members: members, watchers: watchers}, nil
 }
 
 // Create добавляет комментарий к задаче.
@@ -90,7 +103,14 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, taskID uuid.UUID
 	now := time.Now().UTC()
 	commentID := uuid.New()
 
-	if err := s.comments.Create(ctx, repomysql.CommentRecord{
+	// Комментарий и подписка автора сохраняются вместе, чтобы повтор запроса после ошибки не создал дубликат.
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Comment{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := s.comments.Create(ctx, tx, repomysql.CommentRecord{
 		ID:        commentID,
 		TaskID:    taskID,
 		UserID:    userID,
@@ -100,6 +120,14 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, taskID uuid.UUID
 		return api.Comment{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
+	if err := s.watchers.Add(ctx, tx, taskID, userID, now); err != nil {
+		return api.Comment{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.Comment{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
 	return api.Comment{
 		Id:        api.UUID(commentID),
 		TaskId:    api.UUID(taskID),
@@ -140,13 +168,7 @@ func (s *Service) List(ctx context.Context, userID uuid.UUID, taskID uuid.UUID,
 
 	items := make([]api.Comment, 0, len(records))
 	for _, record := range records {
-		items = append(items, api.Comment{
-			Id:        api.UUID(record.ID),
-			TaskId:    api.UUID(record.TaskID),
-			UserId:    api.UUID(record.UserID),
-			Body:      record.Body,
-			CreatedAt: record.CreatedAt,
-		})
+		items = append(items, CommentToAPI(record))
 	}
 
 	total, err := s.comments.Count(ctx, taskID)
@@ -185,13 +207,7 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, taskID uuid.UUID
 
 	comment.Body = req.Body
 
-	return api.Comment{
-		Id:        api.UUID(comment.ID),
-		TaskId:    api.UUID(taskID),
-		UserId:    api.UUID(comment.UserID),
-		Body:      comment.Body,
-		CreatedAt: comment.CreatedAt,
-	}, nil
+	return CommentToAPI(comment), nil
 }
 
 // Delete удаляет комментарий.
@@ -231,3 +247,14 @@ func normalizePagination(page int, perPage int) (int, int) {
 	}
 	return page, perPage
 }
+
+// CommentToAPI преобразует запись комментария в модель API. Используется также выгрузкой задач.
+func CommentToAPI(record repomysql.CommentRecord) api.Comment {
+	return api.Comment{
+		Id:        api.UUID(record.ID),
+		TaskId:    api.UUID(record.TaskID),
+		UserId:    api.UUID(record.UserID),
+		Body:      record.Body,
+		CreatedAt: record.CreatedAt,
+	}
+}
@@ -2,7 +2,9 @@ package comments
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
@@ -33,6 +35,10 @@ func (s *CommentsSuite) SetupTest() {
 	const methodCtx = "comments.CommentsSuite.SetupTest"
 
 	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -55,7 +61,8 @@ func (s *CommentsSuite) SetupTest() {
 	commentsRepo := repomysql.NewCommentsRepo(s.DB)
 	tasksRepo := repomysql.NewTasksRepo(s.DB)
 	membersRepo := repomysql.NewTeamMembersRepo(s.DB)
-	service, err := NewService(commentsRepo, tasksRepo, membersRepo)
+	watchersRepo := repomysql.NewTaskWatchersRepo(s.DB)
+	service, err := NewService(s.DB, commentsRepo, tasksRepo, membersRepo, watchersRepo)
 	s.Require().NoError(err, methodCtx)
 	s.service = service
 }
@@ -73,6 +80,39 @@ func (s *CommentsSuite) TestCreateCommentMember() {
 	s.Equal(s.memberID, resp.UserId)
 }
 
+func (s *CommentsSuite) TestCreateCommentRollsBackOnWatcherError() {
+	const methodCtx = "comments.CommentsSuite.TestCreateCommentRollsBackOnWatcherError"
+
+	ctx := context.Background()
+	s.service.watchers = failingWatchers{}
+
+	_, err := s.service.Create(ctx, s.memberID, s.taskID, api.CreateCommentRequest{Body: "hello"})
+	s.Error(err, methodCtx)
+
+	var count int
+	err = s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM task_comments WHERE task_id = ?", s.taskID.String()).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(0, count, "комментарий не сохраняется без подписки автора")
+}
+
+func (s *CommentsSuite) TestCreateCommentSubscribesAuthor() {
+	const methodCtx = "comments.CommentsSuite.TestCreateCommentSubscribesAuthor"
+
+	ctx := context.Background()
+	_, err := s.service.Create(ctx, s.memberID, s.taskID, api.CreateCommentRequest{Body: "hello"})
+	s.Require().NoError(err, methodCtx)
+
+	var count int
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM task_watchers WHERE task_id = ? AND user_id = ?",
+		s.taskID.String(),
+		s.memberID.String(),
+	).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(1, count)
+}
+
 func (s *CommentsSuite) TestCreateCommentForbidden() {
 	const methodCtx = "comments.CommentsSuite.TestCreateCommentForbidden"
 
@@ -173,3 +213,10 @@ func (s *CommentsSuite) TestDeleteCommentForbidden() {
 	s.Require().Error(err, methodCtx)
 	s.ErrorIs(err, ErrForbidden)
 }
+
+// failingWatchers имитирует ошибку подписки на задачу.
+type failingWatchers struct{}
+
+func (failingWatchers) Add(context.Context, repomysql.DBTX, uuid.UUID, uuid.UUID, time.Time) error {
+	return errors.New("подписка недоступна")
+}
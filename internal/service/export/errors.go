@@ -0,0 +1,9 @@
+package export
+
+import "errors"
+
+var (
+	ErrForbidden      = errors.New("доступ запрещен")
+	ErrInvalidFormat  = errors.New("неподдерживаемый формат выгрузки")
+	ErrInvalidInclude = errors.New("неизвестный раздел выгрузки")
+)
@@ -0,0 +1,200 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// csvColumns задает порядок колонок CSV; comments и history добавляются, если они запрошены.
+var csvColumns = []string{
+	"id",
+	"team_id",
+	"title",
+	"description",
+	"status",
+	"rank",
+	"sprint_id",
+	"assignee_id",
+	"assignee_ids",
+	"estimate_minutes",
+	"story_points",
+	"due_at",
+	"created_by",
+	"created_at",
+	"updated_at",
+	"completed_at",
+	"archived_at",
+}
+
+// rowWriter записывает строки выгрузки в конкретном формате.
+type rowWriter interface {
+	Begin() error
+	Write(row api.TaskExportRow) error
+	End() error
+}
+
+func newRowWriter(format api.TaskExportFormat, w io.Writer, withComments bool, withHistory bool) rowWriter {
+	switch format {
+	case api.TaskExportFormatCsv:
+		return &csvRowWriter{w: csv.NewWriter(w), withComments: withComments, withHistory: withHistory}
+	case api.TaskExportFormatJson:
+		return &jsonRowWriter{w: w, encoder: json.NewEncoder(w)}
+	default:
+		return &ndjsonRowWriter{encoder: json.NewEncoder(w)}
+	}
+}
+
+// ndjsonRowWriter пишет по одному JSON-объекту на строку.
+type ndjsonRowWriter struct {
+	encoder *json.Encoder
+}
+
+func (n *ndjsonRowWriter) Begin() error { return nil }
+
+func (n *ndjsonRowWriter) Write(row api.TaskExportRow) error { return n.encoder.Encode(row) }
+
+func (n *ndjsonRowWriter) End() error { return nil }
+
+// jsonRowWriter пишет JSON-массив, не собирая его в памяти.
+type jsonRowWriter struct {
+	w       io.Writer
+	encoder *json.Encoder
+	started bool
+}
+
+func (j *jsonRowWriter) Begin() error {
+	_, err := io.WriteString(j.w, "[")
+	return err
+}
+
+func (j *jsonRowWriter) Write(row api.TaskExportRow) error {
+	if j.started {
+		if _, err := io.WriteString(j.w, ","); err != nil {
+			return err
+		}
+	}
+	j.started = true
+	return j.encoder.Encode(row)
+}
+
+func (j *jsonRowWriter) End() error {
+	_, err := io.WriteString(j.w, "]\n")
+	return err
+}
+
+// csvRowWriter пишет CSV с заголовком. Списки исполнителей разделяются ";",
+// комментарии и история передаются JSON-строкой.
+type csvRowWriter struct {
+	w            *csv.Writer
+	withComments bool
+	withHistory  bool
+}
+
+func (c *csvRowWriter) Begin() error {
+	header := append([]string{}, csvColumns...)
+	if c.withComments {
+		header = append(header, "comments")
+	}
+	if c.withHistory {
+		header = append(header, "history")
+	}
+	return c.write(header)
+}
+
+func (c *csvRowWriter) Write(row api.TaskExportRow) error {
+	assigneeIDs := make([]string, 0, len(row.AssigneeIds))
+	for _, id := range row.AssigneeIds {
+		assigneeIDs = append(assigneeIDs, id.String())
+	}
+
+	record := []string{
+		row.Id.String(),
+		row.TeamId.String(),
+		row.Title,
+		stringValue(row.Description),
+		string(row.Status),
+		row.Rank,
+		"",
+		"",
+		strings.Join(assigneeIDs, ";"),
+		"",
+		"",
+		timeValue(row.DueAt),
+		row.CreatedBy.String(),
+		row.CreatedAt.UTC().Format(time.RFC3339),
+		timeValue(row.UpdatedAt),
+		timeValue(row.CompletedAt),
+		timeValue(row.ArchivedAt),
+	}
+	if row.SprintId != nil {
+		record[6] = row.SprintId.String()
+	}
+	if row.AssigneeId != nil {
+		record[7] = row.AssigneeId.String()
+	}
+	if row.EstimateMinutes != nil {
+		record[9] = strconv.Itoa(*row.EstimateMinutes)
+	}
+	if row.StoryPoints != nil {
+		record[10] = strconv.Itoa(*row.StoryPoints)
+	}
+
+	if c.withComments {
+		value, err := jsonValue(row.Comments)
+		if err != nil {
+			return err
+		}
+		record = append(record, value)
+	}
+	if c.withHistory {
+		value, err := jsonValue(row.History)
+		if err != nil {
+			return err
+		}
+		record = append(record, value)
+	}
+
+	return c.write(record)
+}
+
+func (c *csvRowWriter) End() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// write пишет запись и сразу передает ее в нижележащий буфер, чтобы пакеты уходили клиенту вовремя.
+func (c *csvRowWriter) write(record []string) error {
+	if err := c.w.Write(record); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func timeValue(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+func jsonValue(value interface{}) (string, error) {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
@@ -0,0 +1,143 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/service/tasks"
+)
+
+func testRow(title string) api.TaskExportRow {
+	estimate := 30
+	return api.TaskExportRow{
+		Id:              uuid.New(),
+		TeamId:          uuid.New(),
+		Title:           title,
+		Status:          api.Todo,
+		Rank:            "i",
+		AssigneeIds:     []api.UUID{uuid.New(), uuid.New()},
+		EstimateMinutes: &estimate,
+		CreatedBy:       uuid.New(),
+		CreatedAt:       time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func writeRows(t *testing.T, format api.TaskExportFormat, withComments bool, rows ...api.TaskExportRow) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := newRowWriter(format, &buf, withComments, false)
+	require.NoError(t, writer.Begin())
+	for _, row := range rows {
+		require.NoError(t, writer.Write(row))
+	}
+	require.NoError(t, writer.End())
+	return buf.Bytes()
+}
+
+func TestJSONRowWriter(t *testing.T) {
+	const methodCtx = "export.TestJSONRowWriter"
+
+	payload := writeRows(t, api.TaskExportFormatJson, false)
+	var rows []api.TaskExportRow
+	require.NoError(t, json.Unmarshal(payload, &rows), methodCtx)
+	require.Empty(t, rows, methodCtx)
+
+	payload = writeRows(t, api.TaskExportFormatJson, false, testRow("a"), testRow("b"))
+	require.NoError(t, json.Unmarshal(payload, &rows), methodCtx)
+	require.Len(t, rows, 2, methodCtx)
+	require.Equal(t, "b", rows[1].Title, methodCtx)
+}
+
+func TestNDJSONRowWriter(t *testing.T) {
+	const methodCtx = "export.TestNDJSONRowWriter"
+
+	payload := writeRows(t, api.TaskExportFormatNdjson, false, testRow("a"), testRow("b"))
+	lines := bytes.Split(bytes.TrimSpace(payload), []byte("\n"))
+	require.Len(t, lines, 2, methodCtx)
+
+	var row api.TaskExportRow
+	require.NoError(t, json.Unmarshal(lines[0], &row), methodCtx)
+	require.Equal(t, "a", row.Title, methodCtx)
+}
+
+func TestCSVRowWriter(t *testing.T) {
+	const methodCtx = "export.TestCSVRowWriter"
+
+	row := testRow("multi\nline")
+	comments := []api.Comment{{Id: uuid.New(), TaskId: row.Id, UserId: uuid.New(), Body: "ok", CreatedAt: row.CreatedAt}}
+	row.Comments = &comments
+
+	records, err := csv.NewReader(bytes.NewReader(writeRows(t, api.TaskExportFormatCsv, true, row))).ReadAll()
+	require.NoError(t, err, methodCtx)
+	require.Len(t, records, 2, methodCtx)
+	require.Equal(t, append(append([]string{}, csvColumns...), "comments"), records[0], methodCtx)
+
+	record := records[1]
+	require.Equal(t, "multi\nline", record[2], methodCtx)
+	require.Equal(t, row.Rank, record[5], methodCtx)
+	require.Equal(t, "", record[6], methodCtx)
+	require.Equal(t, "", record[7], methodCtx)
+	require.Equal(t, row.AssigneeIds[0].String()+";"+row.AssigneeIds[1].String(), record[8], methodCtx)
+	require.Equal(t, "30", record[9], methodCtx)
+	require.Equal(t, "", record[10], methodCtx)
+	require.Equal(t, "", record[11], methodCtx)
+	require.Equal(t, "2024-03-01T10:00:00Z", record[13], methodCtx)
+	require.Equal(t, "", record[14], methodCtx)
+	require.Equal(t, "", record[16], methodCtx)
+
+	var decoded []api.Comment
+	require.NoError(t, json.Unmarshal([]byte(record[17]), &decoded), methodCtx)
+	require.Equal(t, "ok", decoded[0].Body, methodCtx)
+}
+
+func TestTaskToRowMatchesTask(t *testing.T) {
+	const methodCtx = "export.TestTaskToRowMatchesTask"
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	description := "text"
+	estimate := 30
+	points := 5
+	sprintID := uuid.New()
+	assigneeID := uuid.New()
+	record := repomysql.TaskRecord{
+		ID:              uuid.New(),
+		TeamID:          uuid.New(),
+		Title:           "task",
+		Description:     &description,
+		Status:          "done",
+		Rank:            "i",
+		SprintID:        &sprintID,
+		AssigneeID:      &assigneeID,
+		AssigneeIDs:     []uuid.UUID{assigneeID},
+		EstimateMinutes: &estimate,
+		StoryPoints:     &points,
+		DueAt:           &now,
+		CreatedBy:       uuid.New(),
+		CreatedAt:       now,
+		UpdatedAt:       &now,
+		CompletedAt:     &now,
+		ArchivedAt:      &now,
+	}
+
+	var task, row map[string]interface{}
+	payload, err := json.Marshal(tasks.TaskToAPI(record))
+	require.NoError(t, err, methodCtx)
+	require.NoError(t, json.Unmarshal(payload, &task), methodCtx)
+	payload, err = json.Marshal(taskToRow(record))
+	require.NoError(t, err, methodCtx)
+	require.NoError(t, json.Unmarshal(payload, &row), methodCtx)
+
+	for field, value := range task {
+		require.Contains(t, row, field, "поле задачи отсутствует в выгрузке")
+		require.Equal(t, value, row[field], field)
+	}
+}
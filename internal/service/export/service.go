@@ -0,0 +1,255 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/service/comments"
+	"github.com/Seraf-seraf/mkk_test/internal/service/tasks"
+)
+
+// batchSize — сколько задач накапливается перед догрузкой исполнителей, комментариев и истории.
+// После каждого пакета буфер сбрасывается клиенту.
+const batchSize = 100
+
+// Service выгружает задачи команды потоком.
+type Service struct {
+	tasks     TasksRepository
+	assignees AssigneesRepository
+	comments  CommentsRepository
+	history   HistoryRepository
+	members   MembersRepository
+}
+
+// TasksRepository описывает потоковое чтение задач.
+type TasksRepository interface {
+	Stream(ctx context.Context, filter repomysql.TaskFilter, fn func(repomysql.TaskRecord) error) error
+}
+
+// AssigneesRepository описывает доступ к исполнителям задач.
+type AssigneesRepository interface {
+	ListByTasks(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+}
+
+// CommentsRepository описывает доступ к комментариям задач.
+type CommentsRepository interface {
+	ListByTasks(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]repomysql.CommentRecord, error)
+}
+
+// HistoryRepository описывает доступ к истории задач.
+type HistoryRepository interface {
+	ListByTasks(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]repomysql.TaskHistoryRecord, error)
+}
+
+// MembersRepository описывает доступ к участникам команды.
+type MembersRepository interface {
+	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// NewService создает сервис выгрузки задач.
+func NewService(tasks TasksRepository, assignees AssigneesRepository, comments CommentsRepository, history HistoryRepository, members MembersRepository) (*Service, error) {
+	const methodCtx = "export.NewService"
+
+	slog.Debug("инициализация сервиса выгрузки задач", slog.String("context", methodCtx))
+
+	if tasks == nil {
+		return nil, fmt.Errorf("%s: tasks repo не задан", methodCtx)
+	}
+	if assignees == nil {
+		return nil, fmt.Errorf("%s: assignees repo не задан", methodCtx)
+	}
+	if comments == nil {
+		return nil, fmt.Errorf("%s: comments repo не задан", methodCtx)
+	}
+	if history == nil {
+		return nil, fmt.Errorf("%s: history repo не задан", methodCtx)
+	}
+	if members == nil {
+		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
+	}
+
+	return &Service{tasks: tasks, assignees: assignees, comments: comments, history: history, members: members}, nil
+}
+
+// Export проверяет доступ и параметры выгрузки и возвращает функцию, которая пишет задачи в выбранном формате.
+// Ошибки доступа и параметров возвращаются до начала записи, чтобы обработчик мог ответить кодом ошибки;
+// функцию записи вызывают после отправки заголовков ответа. Фильтры совпадают со списком задач,
+// но без параметра archived архивные задачи выгружаются вместе с остальными.
+func (s *Service) Export(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.GetApiV1TeamsIdExportParams) (func(w io.Writer) error, error) {
+	const methodCtx = "export.Service.Export"
+
+	slog.Debug("вызов выгрузки задач", slog.String("context", methodCtx))
+
+	switch params.Format {
+	case api.TaskExportFormatCsv, api.TaskExportFormatNdjson, api.TaskExportFormatJson:
+	default:
+		return nil, fmt.Errorf("%s: %w", methodCtx, ErrInvalidFormat)
+	}
+
+	var withComments, withHistory bool
+	if params.Include != nil {
+		for _, include := range *params.Include {
+			switch include {
+			case api.Comments:
+				withComments = true
+			case api.History:
+				withHistory = true
+			default:
+				return nil, fmt.Errorf("%s: %w", methodCtx, ErrInvalidInclude)
+			}
+		}
+	}
+
+	member, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !member {
+		return nil, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	filter := repomysql.TaskFilter{TeamID: teamID, Archived: repomysql.TaskArchivedInclude}
+	if params.Status != nil {
+		status := string(*params.Status)
+		filter.Status = &status
+	}
+	if params.AssigneeId != nil {
+		filter.AssigneeIDs = *params.AssigneeId
+	}
+	if params.SprintId != nil {
+		sprintID := *params.SprintId
+		filter.SprintID = &sprintID
+	}
+	if params.Archived != nil {
+		filter.Archived = string(*params.Archived)
+	}
+
+	return func(w io.Writer) error {
+		buffered := bufio.NewWriter(w)
+		rows := newRowWriter(params.Format, buffered, withComments, withHistory)
+
+		if err := rows.Begin(); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+
+		batch := make([]repomysql.TaskRecord, 0, batchSize)
+		flush := func() error {
+			if err := s.writeBatch(ctx, rows, batch, withComments, withHistory); err != nil {
+				return err
+			}
+			batch = batch[:0]
+			if err := buffered.Flush(); err != nil {
+				return err
+			}
+			if flusher, ok := w.(interface{ Flush() }); ok {
+				flusher.Flush()
+			}
+			return nil
+		}
+
+		err := s.tasks.Stream(ctx, filter, func(record repomysql.TaskRecord) error {
+			batch = append(batch, record)
+			if len(batch) < batchSize {
+				return nil
+			}
+			return flush()
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := s.writeBatch(ctx, rows, batch, withComments, withHistory); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := rows.End(); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := buffered.Flush(); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		return nil
+	}, nil
+}
+
+// writeBatch догружает связанные данные пакета задач и записывает строки.
+func (s *Service) writeBatch(ctx context.Context, rows rowWriter, batch []repomysql.TaskRecord, withComments bool, withHistory bool) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]uuid.UUID, 0, len(batch))
+	for _, record := range batch {
+		taskIDs = append(taskIDs, record.ID)
+	}
+
+	assignees, err := s.assignees.ListByTasks(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+	var taskComments map[uuid.UUID][]repomysql.CommentRecord
+	if withComments {
+		if taskComments, err = s.comments.ListByTasks(ctx, taskIDs); err != nil {
+			return err
+		}
+	}
+	var history map[uuid.UUID][]repomysql.TaskHistoryRecord
+	if withHistory {
+		if history, err = s.history.ListByTasks(ctx, taskIDs); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range batch {
+		record.AssigneeIDs = assignees[record.ID]
+		row := taskToRow(record)
+		if withComments {
+			items := make([]api.Comment, 0, len(taskComments[record.ID]))
+			for _, comment := range taskComments[record.ID] {
+				items = append(items, comments.CommentToAPI(comment))
+			}
+			row.Comments = &items
+		}
+		if withHistory {
+			items := make([]api.TaskHistory, 0, len(history[record.ID]))
+			for _, entry := range history[record.ID] {
+				items = append(items, tasks.HistoryToAPI(entry))
+			}
+			row.History = &items
+		}
+		if err := rows.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// taskToRow строит строку выгрузки из модели задачи API, чтобы поля выгрузки и списка задач совпадали.
+func taskToRow(record repomysql.TaskRecord) api.TaskExportRow {
+	task := tasks.TaskToAPI(record)
+
+	return api.TaskExportRow{
+		Id:              task.Id,
+		TeamId:          task.TeamId,
+		Title:           task.Title,
+		Description:     task.Description,
+		Status:          task.Status,
+		Rank:            task.Rank,
+		SprintId:        task.SprintId,
+		AssigneeId:      task.AssigneeId,
+		AssigneeIds:     task.AssigneeIds,
+		EstimateMinutes: task.EstimateMinutes,
+		StoryPoints:     task.StoryPoints,
+		DueAt:           task.DueAt,
+		CreatedBy:       task.CreatedBy,
+		CreatedAt:       task.CreatedAt,
+		UpdatedAt:       task.UpdatedAt,
+		CompletedAt:     task.CompletedAt,
+		ArchivedAt:      task.ArchivedAt,
+	}
+}
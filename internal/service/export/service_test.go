@@ -0,0 +1,230 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+type ExportSuite struct {
+	tests.IntegrationSuite
+	service    *Service
+	ownerID    uuid.UUID
+	memberID   uuid.UUID
+	outsiderID uuid.UUID
+	teamID     uuid.UUID
+}
+
+func TestExportSuite(t *testing.T) {
+	const methodCtx = "export.TestExportSuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(ExportSuite))
+}
+
+func (s *ExportSuite) SetupTest() {
+	const methodCtx = "export.ExportSuite.SetupTest"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"sprints",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-export@example.com")
+	s.memberID = s.CreateUser("member-export@example.com")
+	s.outsiderID = s.CreateUser("outsider-export@example.com")
+
+	s.teamID = s.CreateTeam("Export Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.memberID, "member")
+
+	service, err := NewService(
+		repomysql.NewTasksRepo(s.DB),
+		repomysql.NewTaskAssigneesRepo(s.DB),
+		repomysql.NewCommentsRepo(s.DB),
+		repomysql.NewTaskHistoryRepo(s.DB),
+		repomysql.NewTeamMembersRepo(s.DB),
+	)
+	s.Require().NoError(err, methodCtx)
+	s.service = service
+}
+
+func (s *ExportSuite) export(userID uuid.UUID, params api.GetApiV1TeamsIdExportParams) []byte {
+	write, err := s.service.Export(context.Background(), userID, s.teamID, params)
+	s.Require().NoError(err)
+
+	var buf bytes.Buffer
+	s.Require().NoError(write(&buf))
+	return buf.Bytes()
+}
+
+func (s *ExportSuite) TestExportNDJSONWithIncludes() {
+	const methodCtx = "export.ExportSuite.TestExportNDJSONWithIncludes"
+
+	taskID := s.CreateTask(s.teamID, s.ownerID, &s.memberID, "todo", "Export me", "")
+	s.CreateComment(taskID, s.memberID, "first")
+	s.CreateTaskHistory(taskID, s.ownerID, `{"title": {"from": "old", "to": "Export me"}}`)
+	otherTeamID := s.CreateTeam("Other Team", s.outsiderID)
+	s.CreateTask(otherTeamID, s.outsiderID, nil, "todo", "Foreign", "")
+
+	include := []api.TaskExportInclude{api.Comments, api.History}
+	payload := s.export(s.memberID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatNdjson, Include: &include})
+
+	var rows []api.TaskExportRow
+	scanner := bufio.NewScanner(bytes.NewReader(payload))
+	for scanner.Scan() {
+		var row api.TaskExportRow
+		s.Require().NoError(json.Unmarshal(scanner.Bytes(), &row), methodCtx)
+		rows = append(rows, row)
+	}
+	s.Require().Len(rows, 1, methodCtx)
+	s.Equal(taskID, rows[0].Id, methodCtx)
+	s.Equal([]api.UUID{s.memberID}, rows[0].AssigneeIds, methodCtx)
+	s.Require().NotNil(rows[0].Comments, methodCtx)
+	s.Require().Len(*rows[0].Comments, 1, methodCtx)
+	s.Equal("first", (*rows[0].Comments)[0].Body, methodCtx)
+	s.Require().NotNil(rows[0].History, methodCtx)
+	s.Len(*rows[0].History, 1, methodCtx)
+}
+
+func (s *ExportSuite) TestExportJSONFilters() {
+	const methodCtx = "export.ExportSuite.TestExportJSONFilters"
+
+	s.CreateTask(s.teamID, s.ownerID, &s.memberID, "todo", "Todo", "")
+	doneID := s.CreateTask(s.teamID, s.ownerID, &s.memberID, "done", "Done", "")
+	s.CreateTask(s.teamID, s.ownerID, nil, "done", "Unassigned", "")
+
+	status := api.Done
+	assignees := []api.UUID{s.memberID}
+	payload := s.export(s.ownerID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatJson, Status: &status, AssigneeId: &assignees})
+
+	var rows []api.TaskExportRow
+	s.Require().NoError(json.Unmarshal(payload, &rows), methodCtx)
+	s.Require().Len(rows, 1, methodCtx)
+	s.Equal(doneID, rows[0].Id, methodCtx)
+	s.Nil(rows[0].Comments, methodCtx)
+	s.Nil(rows[0].History, methodCtx)
+
+	payload = s.export(s.ownerID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatJson, Status: ptrStatus(api.InProgress)})
+	s.Require().NoError(json.Unmarshal(payload, &rows), methodCtx)
+	s.Empty(rows, methodCtx)
+}
+
+func (s *ExportSuite) TestExportIncludesArchived() {
+	const methodCtx = "export.ExportSuite.TestExportIncludesArchived"
+
+	archivedID := s.CreateTask(s.teamID, s.ownerID, nil, "done", "Archived", "")
+	_, err := s.DB.ExecContext(context.Background(), "UPDATE tasks SET archived_at = completed_at WHERE id = ?", archivedID.String())
+	s.Require().NoError(err, methodCtx)
+
+	payload := s.export(s.ownerID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatJson})
+
+	var rows []api.TaskExportRow
+	s.Require().NoError(json.Unmarshal(payload, &rows), methodCtx)
+	s.Require().Len(rows, 1, methodCtx)
+	s.Equal(archivedID, rows[0].Id, methodCtx)
+	s.NotNil(rows[0].ArchivedAt, methodCtx)
+}
+
+func (s *ExportSuite) TestExportSprintAndArchivedFilters() {
+	const methodCtx = "export.ExportSuite.TestExportSprintAndArchivedFilters"
+
+	ctx := context.Background()
+	sprintID := uuid.New()
+	_, err := s.DB.ExecContext(ctx,
+		"INSERT INTO sprints (id, team_id, name, start_date, end_date, created_by) VALUES (?, ?, 'Sprint', '2024-03-01', '2024-03-14', ?)",
+		sprintID.String(), s.teamID.String(), s.ownerID.String(),
+	)
+	s.Require().NoError(err, methodCtx)
+
+	sprintTaskID := s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Sprint", "")
+	archivedID := s.CreateTask(s.teamID, s.ownerID, nil, "done", "Archived", "")
+	_, err = s.DB.ExecContext(ctx, "UPDATE tasks SET sprint_id = ? WHERE id IN (?, ?)", sprintID.String(), sprintTaskID.String(), archivedID.String())
+	s.Require().NoError(err, methodCtx)
+	_, err = s.DB.ExecContext(ctx, "UPDATE tasks SET archived_at = completed_at WHERE id = ?", archivedID.String())
+	s.Require().NoError(err, methodCtx)
+	s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Backlog", "")
+
+	payload := s.export(s.ownerID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatJson, SprintId: &sprintID})
+	var rows []api.TaskExportRow
+	s.Require().NoError(json.Unmarshal(payload, &rows), methodCtx)
+	s.Len(rows, 2, "задачи спринта вместе с архивными")
+
+	exclude := api.Exclude
+	payload = s.export(s.ownerID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatJson, SprintId: &sprintID, Archived: &exclude})
+	s.Require().NoError(json.Unmarshal(payload, &rows), methodCtx)
+	s.Require().Len(rows, 1, methodCtx)
+	s.Equal(sprintTaskID, rows[0].Id, methodCtx)
+
+	only := api.Only
+	payload = s.export(s.ownerID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatJson, Archived: &only})
+	s.Require().NoError(json.Unmarshal(payload, &rows), methodCtx)
+	s.Require().Len(rows, 1, methodCtx)
+	s.Equal(archivedID, rows[0].Id, methodCtx)
+}
+
+func (s *ExportSuite) TestExportCSV() {
+	const methodCtx = "export.ExportSuite.TestExportCSV"
+
+	taskID := s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Comma, title", "")
+	s.CreateComment(taskID, s.memberID, "note")
+
+	include := []api.TaskExportInclude{api.Comments}
+	payload := s.export(s.memberID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatCsv, Include: &include})
+
+	records, err := csv.NewReader(bytes.NewReader(payload)).ReadAll()
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(records, 2, methodCtx)
+	s.Equal("comments", records[0][len(records[0])-1], methodCtx)
+	s.Equal(taskID.String(), records[1][0], methodCtx)
+	s.Equal("Comma, title", records[1][2], methodCtx)
+
+	var comments []api.Comment
+	s.Require().NoError(json.Unmarshal([]byte(records[1][len(records[1])-1]), &comments), methodCtx)
+	s.Require().Len(comments, 1, methodCtx)
+	s.Equal("note", comments[0].Body, methodCtx)
+}
+
+func (s *ExportSuite) TestExportValidation() {
+	const methodCtx = "export.ExportSuite.TestExportValidation"
+
+	ctx := context.Background()
+
+	_, err := s.service.Export(ctx, s.outsiderID, s.teamID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatCsv})
+	s.Require().Error(err, methodCtx)
+	s.True(errors.Is(err, ErrForbidden), methodCtx)
+
+	_, err = s.service.Export(ctx, s.ownerID, s.teamID, api.GetApiV1TeamsIdExportParams{Format: "xlsx"})
+	s.Require().Error(err, methodCtx)
+	s.True(errors.Is(err, ErrInvalidFormat), methodCtx)
+
+	include := []api.TaskExportInclude{"worklogs"}
+	_, err = s.service.Export(ctx, s.ownerID, s.teamID, api.GetApiV1TeamsIdExportParams{Format: api.TaskExportFormatCsv, Include: &include})
+	s.Require().Error(err, methodCtx)
+	s.True(errors.Is(err, ErrInvalidInclude), methodCtx)
+}
+
+func ptrStatus(value api.TaskStatus) *api.TaskStatus {
+	return &value
+}
@@ -0,0 +1,12 @@
+package imports
+
+import "errors"
+
+var (
+	ErrForbidden      = errors.New("доступ запрещен")
+	ErrNotFound       = errors.New("не найдено")
+	ErrInvalidFormat  = errors.New("неподдерживаемый формат импорта")
+	ErrInvalidMapping = errors.New("некорректное сопоставление колонок")
+	ErrEmptyFile      = errors.New("файл пуст")
+	ErrTooLarge       = errors.New("файл превышает допустимый размер")
+)
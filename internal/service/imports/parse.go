@@ -0,0 +1,179 @@
+package imports
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// Поля задачи, доступные для импорта.
+const (
+	fieldTitle           = "title"
+	fieldDescription     = "description"
+	fieldStatus          = "status"
+	fieldAssigneeEmail   = "assignee_email"
+	fieldEstimateMinutes = "estimate_minutes"
+)
+
+var importFields = []string{fieldTitle, fieldDescription, fieldStatus, fieldAssigneeEmail, fieldEstimateMinutes}
+
+// importRow хранит значения строки по полям задачи. Number считается с 1 без заголовка.
+type importRow struct {
+	Number int
+	Values map[string]string
+}
+
+// parseMapping разбирает JSON-объект "поле задачи" -> "колонка". Поля без сопоставления
+// читаются из колонки с тем же именем.
+func parseMapping(raw string) (map[string]string, error) {
+	mapping := map[string]string{}
+	if strings.TrimSpace(raw) == "" {
+		return mapping, nil
+	}
+
+	if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidMapping, err.Error())
+	}
+	for field, column := range mapping {
+		if !knownField(field) {
+			return nil, fmt.Errorf("%w: неизвестное поле %s", ErrInvalidMapping, field)
+		}
+		if strings.TrimSpace(column) == "" {
+			return nil, fmt.Errorf("%w: пустая колонка для поля %s", ErrInvalidMapping, field)
+		}
+	}
+	return mapping, nil
+}
+
+func knownField(field string) bool {
+	for _, known := range importFields {
+		if known == field {
+			return true
+		}
+	}
+	return false
+}
+
+func sourceColumn(mapping map[string]string, field string) string {
+	if column, ok := mapping[field]; ok {
+		return column
+	}
+	return field
+}
+
+// parseRows разбирает исходные данные в строки импорта. Возвращает ошибку, если строк больше limit.
+func parseRows(format string, payload []byte, mapping map[string]string, limit int) ([]importRow, error) {
+	switch format {
+	case "csv":
+		return parseCSV(payload, mapping, limit)
+	case "ndjson":
+		return parseNDJSON(payload, mapping, limit)
+	default:
+		return nil, ErrInvalidFormat
+	}
+}
+
+func parseCSV(payload []byte, mapping map[string]string, limit int) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(payload))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("отсутствует заголовок CSV")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("заголовок CSV: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	titleColumn := sourceColumn(mapping, fieldTitle)
+	if _, ok := columns[titleColumn]; !ok {
+		return nil, fmt.Errorf("в заголовке CSV нет колонки %s", titleColumn)
+	}
+
+	var rows []importRow
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("строка %d: %w", number, err)
+		}
+		if len(rows) == limit {
+			return nil, fmt.Errorf("превышено максимальное число строк: %d", limit)
+		}
+
+		values := map[string]string{}
+		for _, field := range importFields {
+			index, ok := columns[sourceColumn(mapping, field)]
+			if ok && index < len(record) {
+				values[field] = strings.TrimSpace(record[index])
+			}
+		}
+		rows = append(rows, importRow{Number: number, Values: values})
+	}
+
+	return rows, nil
+}
+
+func parseNDJSON(payload []byte, mapping map[string]string, limit int) ([]importRow, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(payload))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(payload)+1)
+
+	var rows []importRow
+	number := 0
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		number++
+		if len(rows) == limit {
+			return nil, fmt.Errorf("превышено максимальное число строк: %d", limit)
+		}
+
+		var object map[string]interface{}
+		if err := json.Unmarshal(line, &object); err != nil {
+			return nil, fmt.Errorf("строка %d: некорректный JSON: %w", number, err)
+		}
+
+		values := map[string]string{}
+		for _, field := range importFields {
+			value, ok := object[sourceColumn(mapping, field)]
+			if !ok || value == nil {
+				continue
+			}
+			switch typed := value.(type) {
+			case string:
+				values[field] = strings.TrimSpace(typed)
+			case float64:
+				values[field] = strconv.FormatFloat(typed, 'f', -1, 64)
+			default:
+				values[field] = fmt.Sprint(typed)
+			}
+		}
+		rows = append(rows, importRow{Number: number, Values: values})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("чтение NDJSON: %w", err)
+	}
+
+	return rows, nil
+}
+
+func rowError(row importRow, field string, message string) repomysql.TaskImportRowError {
+	return repomysql.TaskImportRowError{Row: row.Number, Field: field, Error: message}
+}
@@ -0,0 +1,49 @@
+package imports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMapping(t *testing.T) {
+	const methodCtx = "imports.TestParseMapping"
+
+	mapping, err := parseMapping(`{"title": "Summary", "assignee_email": "Owner"}`)
+	require.NoError(t, err, methodCtx)
+	require.Equal(t, "Summary", sourceColumn(mapping, fieldTitle))
+	require.Equal(t, "status", sourceColumn(mapping, fieldStatus))
+
+	_, err = parseMapping(`{"team_id": "Team"}`)
+	require.ErrorIs(t, err, ErrInvalidMapping, methodCtx)
+
+	_, err = parseMapping(`["title"]`)
+	require.ErrorIs(t, err, ErrInvalidMapping, methodCtx)
+}
+
+func TestParseRows(t *testing.T) {
+	const methodCtx = "imports.TestParseRows"
+
+	rows, err := parseRows("csv", []byte("\ufeffSummary,status\n\"Quoted, title\",done\nShort\n"), map[string]string{fieldTitle: "Summary"}, 10)
+	require.NoError(t, err, methodCtx)
+	require.Len(t, rows, 2)
+	require.Equal(t, "Quoted, title", rows[0].Values[fieldTitle])
+	require.Equal(t, "done", rows[0].Values[fieldStatus])
+	require.Equal(t, 2, rows[1].Number)
+	require.NotContains(t, rows[1].Values, fieldStatus)
+
+	_, err = parseRows("csv", []byte("name\nx\n"), nil, 10)
+	require.Error(t, err, methodCtx)
+
+	rows, err = parseRows("ndjson", []byte("{\"title\": \"A\", \"estimate_minutes\": 45}\n\n{\"title\": \"B\"}\n"), nil, 10)
+	require.NoError(t, err, methodCtx)
+	require.Len(t, rows, 2)
+	require.Equal(t, "45", rows[0].Values[fieldEstimateMinutes])
+	require.Equal(t, 2, rows[1].Number)
+
+	_, err = parseRows("ndjson", []byte("{\"title\": \"A\"}\n{\"title\": \"B\"}\n"), nil, 1)
+	require.Error(t, err, methodCtx)
+
+	_, err = parseRows("ndjson", []byte("not json\n"), nil, 10)
+	require.Error(t, err, methodCtx)
+}
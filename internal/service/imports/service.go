@@ -0,0 +1,458 @@
+package imports
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+const (
+	maxPayloadBytes = 10 << 20
+	maxRows         = 10000
+	batchSize       = 100
+	maxTitleLength  = 255
+
+	defaultStaleAfter = 30 * time.Minute
+	finishTimeout     = 10 * time.Second
+)
+
+// Service реализует импорт задач фоновыми заданиями.
+type Service struct {
+	imports    ImportsRepository
+	tasks      TasksCreator
+	members    MembersRepository
+	users      UsersRepository
+	staleAfter time.Duration
+	now        func() time.Time
+}
+
+// ImportsRepository описывает хранение заданий импорта.
+type ImportsRepository interface {
+	Create(ctx context.Context, record repomysql.TaskImportRecord) error
+	Get(ctx context.Context, teamID uuid.UUID, importID uuid.UUID) (repomysql.TaskImportRecord, error)
+	ClaimNext(ctx context.Context, startedAt time.Time) (repomysql.TaskImportRecord, bool, error)
+	UpdateProgress(ctx context.Context, importID uuid.UUID, totalRows int, processedRows int, createdRows int, heartbeatAt time.Time) error
+	Finish(ctx context.Context, record repomysql.TaskImportRecord) error
+	FailStale(ctx context.Context, heartbeatBefore time.Time, message string, finishedAt time.Time) (int, error)
+}
+
+// TasksCreator описывает пакетное создание задач.
+type TasksCreator interface {
+	CreateBatch(ctx context.Context, records []repomysql.TaskRecord) error
+}
+
+// MembersRepository описывает доступ к участникам команды.
+type MembersRepository interface {
+	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// UsersRepository описывает поиск пользователей.
+type UsersRepository interface {
+	FindIDByEmail(ctx context.Context, email string) (uuid.UUID, bool, error)
+}
+
+// NewService создает сервис импорта задач. Нулевой срок, после которого выполняющееся задание
+// считается прерванным, заменяется значением по умолчанию.
+func NewService(imports ImportsRepository, tasks TasksCreator, members MembersRepository, users UsersRepository, cfg config.ImportsConfig) (*Service, error) {
+	const methodCtx = "imports.NewService"
+
+	slog.Debug("инициализация сервиса импорта задач", slog.String("context", methodCtx))
+
+	if imports == nil {
+		return nil, fmt.Errorf("%s: imports repo не задан", methodCtx)
+	}
+	if tasks == nil {
+		return nil, fmt.Errorf("%s: tasks сервис не задан", methodCtx)
+	}
+	if members == nil {
+		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
+	}
+	if users == nil {
+		return nil, fmt.Errorf("%s: users repo не задан", methodCtx)
+	}
+
+	staleAfter := time.Duration(cfg.StaleAfterSeconds) * time.Second
+	if staleAfter <= 0 {
+		staleAfter = defaultStaleAfter
+	}
+
+	return &Service{
+		imports:    imports,
+		tasks:      tasks,
+		members:    members,
+		users:      users,
+		staleAfter: staleAfter,
+		now:        time.Now,
+	}, nil
+}
+
+// Submit сохраняет файл импорта и ставит задание в очередь.
+func (s *Service) Submit(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, format api.TaskImportFormat, dryRun bool, mapping string, body io.Reader) (api.TaskImport, error) {
+	const methodCtx = "imports.Service.Submit"
+
+	slog.Debug("вызов создания задания импорта", slog.String("context", methodCtx))
+
+	if err := s.checkMember(ctx, teamID, userID); err != nil {
+		return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if format != api.TaskImportFormatCsv && format != api.TaskImportFormatNdjson {
+		return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidFormat)
+	}
+	parsedMapping, err := parseMapping(mapping)
+	if err != nil {
+		return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(body, maxPayloadBytes+1))
+	if err != nil {
+		return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if len(payload) > maxPayloadBytes {
+		return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, ErrTooLarge)
+	}
+	if len(strings.TrimSpace(string(payload))) == 0 {
+		return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, ErrEmptyFile)
+	}
+
+	record := repomysql.TaskImportRecord{
+		ID:        uuid.New(),
+		TeamID:    teamID,
+		CreatedBy: userID,
+		Format:    string(format),
+		Mapping:   parsedMapping,
+		DryRun:    dryRun,
+		Status:    repomysql.TaskImportPending,
+		Payload:   payload,
+		CreatedAt: s.now().UTC(),
+	}
+	if err := s.imports.Create(ctx, record); err != nil {
+		return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return importToAPI(record), nil
+}
+
+// Get возвращает статус и отчет задания импорта.
+func (s *Service) Get(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, importID uuid.UUID) (api.TaskImport, error) {
+	const methodCtx = "imports.Service.Get"
+
+	slog.Debug("вызов статуса задания импорта", slog.String("context", methodCtx))
+
+	if err := s.checkMember(ctx, teamID, userID); err != nil {
+		return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	record, err := s.imports.Get(ctx, teamID, importID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.TaskImport{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return importToAPI(record), nil
+}
+
+// ProcessPending завершает прерванные задания и выполняет ожидающие по очереди. Вызывается фоновым обработчиком.
+func (s *Service) ProcessPending(ctx context.Context) error {
+	const methodCtx = "imports.Service.ProcessPending"
+
+	if err := s.failStale(ctx); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	for ctx.Err() == nil {
+		record, ok, err := s.imports.ClaimNext(ctx, s.now().UTC())
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if !ok {
+			return nil
+		}
+
+		slog.Info("запуск задания импорта", slog.String("context", methodCtx), slog.String("import_id", record.ID.String()))
+
+		if err := s.run(ctx, record); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+	return nil
+}
+
+// run проверяет все строки, затем создает корректные задачи пакетами по batchSize.
+// Ошибки разбора файла, вставки и сохранения прогресса завершают задание статусом failed;
+// уже созданные пакеты остаются. Если задание тем временем завершил failStale, выполнение прекращается.
+func (s *Service) run(ctx context.Context, record repomysql.TaskImportRecord) error {
+	rows, err := parseRows(record.Format, record.Payload, record.Mapping, maxRows)
+	if err != nil {
+		return s.fail(ctx, record, err)
+	}
+	record.TotalRows = len(rows)
+
+	tasks, rowErrors, err := s.validate(ctx, record, rows)
+	if err != nil {
+		return s.fail(ctx, record, err)
+	}
+	record.RowErrors = rowErrors
+	record.ProcessedRows = len(rows) - len(tasks)
+
+	if err := s.imports.UpdateProgress(ctx, record.ID, record.TotalRows, record.ProcessedRows, 0, s.now().UTC()); err != nil {
+		return s.fail(ctx, record, err)
+	}
+
+	if record.DryRun {
+		record.ProcessedRows = record.TotalRows
+		return s.finish(ctx, record, repomysql.TaskImportCompleted)
+	}
+
+	for start := 0; start < len(tasks); start += batchSize {
+		end := start + batchSize
+		if end > len(tasks) {
+			end = len(tasks)
+		}
+		if err := s.tasks.CreateBatch(ctx, tasks[start:end]); err != nil {
+			return s.fail(ctx, record, err)
+		}
+		record.ProcessedRows += end - start
+		record.CreatedRows += end - start
+		if err := s.imports.UpdateProgress(ctx, record.ID, record.TotalRows, record.ProcessedRows, record.CreatedRows, s.now().UTC()); err != nil {
+			return s.fail(ctx, record, err)
+		}
+	}
+
+	return s.finish(ctx, record, repomysql.TaskImportCompleted)
+}
+
+// validate превращает строки в задачи. Email исполнителей разрешаются через UsersRepository
+// один раз на адрес; исполнитель должен состоять в команде.
+func (s *Service) validate(ctx context.Context, record repomysql.TaskImportRecord, rows []importRow) ([]repomysql.TaskRecord, []repomysql.TaskImportRowError, error) {
+	type resolved struct {
+		id    uuid.UUID
+		issue string
+	}
+	emails := map[string]resolved{}
+
+	var tasks []repomysql.TaskRecord
+	var rowErrors []repomysql.TaskImportRowError
+	for _, row := range rows {
+		now := s.now().UTC()
+		task := repomysql.TaskRecord{
+			ID:        uuid.New(),
+			TeamID:    record.TeamID,
+			Status:    string(api.Todo),
+			CreatedBy: record.CreatedBy,
+			CreatedAt: now,
+			UpdatedAt: &now,
+		}
+		valid := true
+		reject := func(field string, message string) {
+			rowErrors = append(rowErrors, rowError(row, field, message))
+			valid = false
+		}
+
+		task.Title = row.Values[fieldTitle]
+		if task.Title == "" {
+			reject(fieldTitle, "заголовок не задан")
+		} else if len([]rune(task.Title)) > maxTitleLength {
+			reject(fieldTitle, "заголовок длиннее 255 символов")
+		}
+
+		if description := row.Values[fieldDescription]; description != "" {
+			task.Description = &description
+		}
+
+		if status := row.Values[fieldStatus]; status != "" {
+			switch api.TaskStatus(status) {
+			case api.Todo, api.InProgress, api.Done:
+				task.Status = status
+			default:
+				reject(fieldStatus, "неизвестный статус "+status)
+			}
+		}
+		if task.Status == string(api.Done) {
+			task.CompletedAt = &now
+		}
+
+		if estimate := row.Values[fieldEstimateMinutes]; estimate != "" {
+			minutes, err := strconv.Atoi(estimate)
+			if err != nil || minutes < 0 {
+				reject(fieldEstimateMinutes, "оценка должна быть неотрицательным целым числом")
+			} else {
+				task.EstimateMinutes = &minutes
+			}
+		}
+
+		for _, email := range strings.Split(row.Values[fieldAssigneeEmail], ";") {
+			email = strings.ToLower(strings.TrimSpace(email))
+			if email == "" {
+				continue
+			}
+			result, ok := emails[email]
+			if !ok {
+				userID, found, err := s.users.FindIDByEmail(ctx, email)
+				if err != nil {
+					return nil, nil, err
+				}
+				switch {
+				case !found:
+					result.issue = "пользователь " + email + " не найден"
+				default:
+					member, err := s.members.IsMember(ctx, record.TeamID, userID)
+					if err != nil {
+						return nil, nil, err
+					}
+					if !member {
+						result.issue = "пользователь " + email + " не состоит в команде"
+					}
+					result.id = userID
+				}
+				emails[email] = result
+			}
+			if result.issue != "" {
+				reject(fieldAssigneeEmail, result.issue)
+				continue
+			}
+			if task.AssigneeID == nil {
+				primary := result.id
+				task.AssigneeID = &primary
+			}
+			if !containsUUID(task.AssigneeIDs, result.id) {
+				task.AssigneeIDs = append(task.AssigneeIDs, result.id)
+			}
+		}
+
+		if valid {
+			tasks = append(tasks, task)
+		}
+	}
+
+	return tasks, rowErrors, nil
+}
+
+func (s *Service) fail(ctx context.Context, record repomysql.TaskImportRecord, cause error) error {
+	const methodCtx = "imports.Service.fail"
+
+	if errors.Is(cause, repomysql.ErrImportNotRunning) {
+		return s.abandon(record)
+	}
+
+	slog.Warn("задание импорта завершилось ошибкой",
+		slog.String("context", methodCtx),
+		slog.String("import_id", record.ID.String()),
+		slog.String("error", cause.Error()),
+	)
+
+	message := cause.Error()
+	record.ErrorMessage = &message
+
+	// Задание могло прерваться из-за отмены ctx при остановке: итог сохраняется в отдельном контексте.
+	finishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), finishTimeout)
+	defer cancel()
+	return s.finish(finishCtx, record, repomysql.TaskImportFailed)
+}
+
+// abandon прекращает выполнение задания, которое уже завершено другим путем, не перезаписывая его итог.
+func (s *Service) abandon(record repomysql.TaskImportRecord) error {
+	const methodCtx = "imports.Service.abandon"
+
+	slog.Warn("задание импорта уже не выполняется, обработка прекращена",
+		slog.String("context", methodCtx),
+		slog.String("import_id", record.ID.String()),
+		slog.Int("created_rows", record.CreatedRows),
+	)
+	return nil
+}
+
+// failStale завершает задания, прогресс которых не обновлялся дольше staleAfter: процесс, запустивший их,
+// был остановлен или упал, не сохранив итог.
+func (s *Service) failStale(ctx context.Context) error {
+	const methodCtx = "imports.Service.failStale"
+
+	now := s.now().UTC()
+	count, err := s.imports.FailStale(ctx, now.Add(-s.staleAfter), "задание прервано: выполнение не завершилось", now)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		slog.Warn("прерванные задания импорта завершены ошибкой",
+			slog.String("context", methodCtx),
+			slog.Int("count", count),
+		)
+	}
+	return nil
+}
+
+func (s *Service) finish(ctx context.Context, record repomysql.TaskImportRecord, status string) error {
+	finishedAt := s.now().UTC()
+	record.Status = status
+	record.FinishedAt = &finishedAt
+	if err := s.imports.Finish(ctx, record); err != nil {
+		if errors.Is(err, repomysql.ErrImportNotRunning) {
+			return s.abandon(record)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) checkMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
+	member, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func containsUUID(items []uuid.UUID, target uuid.UUID) bool {
+	for _, item := range items {
+		if item == target {
+			return true
+		}
+	}
+	return false
+}
+
+func importToAPI(record repomysql.TaskImportRecord) api.TaskImport {
+	rowErrors := make([]api.TaskImportRowError, 0, len(record.RowErrors))
+	for _, item := range record.RowErrors {
+		rowError := api.TaskImportRowError{Row: item.Row, Error: item.Error}
+		if item.Field != "" {
+			field := item.Field
+			rowError.Field = &field
+		}
+		rowErrors = append(rowErrors, rowError)
+	}
+
+	return api.TaskImport{
+		Id:            api.UUID(record.ID),
+		TeamId:        api.UUID(record.TeamID),
+		CreatedBy:     api.UUID(record.CreatedBy),
+		Format:        api.TaskImportFormat(record.Format),
+		DryRun:        record.DryRun,
+		Status:        api.TaskImportStatus(record.Status),
+		TotalRows:     record.TotalRows,
+		ProcessedRows: record.ProcessedRows,
+		CreatedRows:   record.CreatedRows,
+		Errors:        rowErrors,
+		Error:         record.ErrorMessage,
+		CreatedAt:     record.CreatedAt,
+		StartedAt:     record.StartedAt,
+		FinishedAt:    record.FinishedAt,
+	}
+}
@@ -0,0 +1,263 @@
+package imports
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/service/tasks"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+type ImportsSuite struct {
+	tests.IntegrationSuite
+	service    *Service
+	ownerID    uuid.UUID
+	memberID   uuid.UUID
+	outsiderID uuid.UUID
+	teamID     uuid.UUID
+}
+
+func TestImportsSuite(t *testing.T) {
+	const methodCtx = "imports.TestImportsSuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(ImportsSuite))
+}
+
+func (s *ImportsSuite) SetupTest() {
+	const methodCtx = "imports.ImportsSuite.SetupTest"
+
+	s.TruncateTables(
+		"task_imports",
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-import@example.com")
+	s.memberID = s.CreateUser("member-import@example.com")
+	s.outsiderID = s.CreateUser("outsider-import@example.com")
+
+	s.teamID = s.CreateTeam("Import Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.memberID, "member")
+
+	tasksSvc, err := tasks.NewService(
+		s.DB,
+		repomysql.NewTasksRepo(s.DB),
+		repomysql.NewTaskAssigneesRepo(s.DB),
+		repomysql.NewTeamMembersRepo(s.DB),
+		repomysql.NewTeamsRepo(s.DB),
+		repomysql.NewTaskHistoryRepo(s.DB),
+		repomysql.NewTaskWatchersRepo(s.DB),
+		repomysql.NewTaskViewsRepo(s.DB),
+		repomysql.NewTaskLinksRepo(s.DB),
+		repomysql.NewTaskAttachmentsRepo(s.DB),
+		nil,
+	)
+	s.Require().NoError(err, methodCtx)
+
+	service, err := NewService(
+		repomysql.NewTaskImportsRepo(s.DB),
+		tasksSvc,
+		repomysql.NewTeamMembersRepo(s.DB),
+		repomysql.NewUsersRepo(s.DB),
+		config.ImportsConfig{},
+	)
+	s.Require().NoError(err, methodCtx)
+	s.service = service
+}
+
+const importCSV = `Summary,status,assignee_email,estimate_minutes
+First,todo,member-import@example.com;owner-import@example.com,30
+,todo,,
+Third,unknown,,
+Fourth,done,nobody@example.com,
+Fifth,in_progress,outsider-import@example.com,-5
+`
+
+func (s *ImportsSuite) TestDryRunReport() {
+	const methodCtx = "imports.ImportsSuite.TestDryRunReport"
+
+	ctx := context.Background()
+	job, err := s.service.Submit(ctx, s.memberID, s.teamID, api.TaskImportFormatCsv, true, `{"title": "Summary"}`, strings.NewReader(importCSV))
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Pending, job.Status)
+
+	s.Require().NoError(s.service.ProcessPending(ctx), methodCtx)
+
+	job, err = s.service.Get(ctx, s.memberID, s.teamID, job.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Completed, job.Status)
+	s.Equal(5, job.TotalRows)
+	s.Equal(5, job.ProcessedRows)
+	s.Equal(0, job.CreatedRows)
+
+	rowsWithErrors := map[int][]string{}
+	for _, item := range job.Errors {
+		s.Require().NotNil(item.Field)
+		rowsWithErrors[item.Row] = append(rowsWithErrors[item.Row], *item.Field)
+	}
+	s.Equal([]string{"title"}, rowsWithErrors[2])
+	s.Equal([]string{"status"}, rowsWithErrors[3])
+	s.Equal([]string{"assignee_email"}, rowsWithErrors[4])
+	s.ElementsMatch([]string{"estimate_minutes", "assignee_email"}, rowsWithErrors[5])
+	s.NotContains(rowsWithErrors, 1)
+
+	var count int
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks").Scan(&count))
+	s.Equal(0, count)
+}
+
+func (s *ImportsSuite) TestImportCreatesValidRows() {
+	const methodCtx = "imports.ImportsSuite.TestImportCreatesValidRows"
+
+	ctx := context.Background()
+	payload := `{"title": "One", "assignee_email": "member-import@example.com", "estimate_minutes": 15}
+{"title": "Two", "status": "done"}
+
+{"status": "todo"}
+`
+	job, err := s.service.Submit(ctx, s.ownerID, s.teamID, api.TaskImportFormatNdjson, false, "", strings.NewReader(payload))
+	s.Require().NoError(err, methodCtx)
+	s.Require().NoError(s.service.ProcessPending(ctx), methodCtx)
+
+	job, err = s.service.Get(ctx, s.ownerID, s.teamID, job.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Completed, job.Status)
+	s.Equal(3, job.TotalRows)
+	s.Equal(2, job.CreatedRows)
+	s.Require().Len(job.Errors, 1)
+	s.Equal(3, job.Errors[0].Row)
+
+	var assignee string
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT assignee_id FROM tasks WHERE title = 'One'").Scan(&assignee))
+	s.Equal(s.memberID.String(), assignee)
+
+	var created int
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM task_history WHERE event = 'created'").Scan(&created))
+	s.Equal(2, created)
+}
+
+func (s *ImportsSuite) TestStaleRunningJobFailed() {
+	const methodCtx = "imports.ImportsSuite.TestStaleRunningJobFailed"
+
+	ctx := context.Background()
+	stale, err := s.service.Submit(ctx, s.ownerID, s.teamID, api.TaskImportFormatNdjson, false, "", strings.NewReader(`{"title": "One"}`))
+	s.Require().NoError(err, methodCtx)
+	fresh, err := s.service.Submit(ctx, s.ownerID, s.teamID, api.TaskImportFormatNdjson, false, "", strings.NewReader(`{"title": "Two"}`))
+	s.Require().NoError(err, methodCtx)
+
+	now := time.Now().UTC()
+	_, err = s.DB.ExecContext(ctx, "UPDATE task_imports SET status = 'running', started_at = ?, heartbeat_at = ? WHERE id = ?", now.Add(-2*time.Hour), now.Add(-2*time.Hour), stale.Id.String())
+	s.Require().NoError(err, methodCtx)
+	_, err = s.DB.ExecContext(ctx, "UPDATE task_imports SET status = 'running', started_at = ?, heartbeat_at = ? WHERE id = ?", now.Add(-2*time.Hour), now, fresh.Id.String())
+	s.Require().NoError(err, methodCtx)
+
+	s.Require().NoError(s.service.ProcessPending(ctx), methodCtx)
+
+	job, err := s.service.Get(ctx, s.ownerID, s.teamID, stale.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Failed, job.Status)
+	s.Require().NotNil(job.Error, methodCtx)
+	s.NotNil(job.FinishedAt)
+
+	job, err = s.service.Get(ctx, s.ownerID, s.teamID, fresh.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Running, job.Status, "долгое задание с недавним прогрессом не трогается")
+}
+
+func (s *ImportsSuite) TestRunStopsAfterStaleFail() {
+	const methodCtx = "imports.ImportsSuite.TestRunStopsAfterStaleFail"
+
+	ctx := context.Background()
+	job, err := s.service.Submit(ctx, s.ownerID, s.teamID, api.TaskImportFormatNdjson, false, "", strings.NewReader(`{"title": "One"}`))
+	s.Require().NoError(err, methodCtx)
+
+	repo := repomysql.NewTaskImportsRepo(s.DB)
+	record, ok, err := repo.ClaimNext(ctx, time.Now().UTC())
+	s.Require().NoError(err, methodCtx)
+	s.Require().True(ok, methodCtx)
+
+	count, err := repo.FailStale(ctx, time.Now().UTC().Add(time.Minute), "задание прервано", time.Now().UTC())
+	s.Require().NoError(err, methodCtx)
+	s.Require().Equal(1, count, methodCtx)
+
+	s.Require().NoError(s.service.run(ctx, record), methodCtx)
+
+	job, err = s.service.Get(ctx, s.ownerID, s.teamID, job.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Failed, job.Status, "обработчик не перезаписывает итог, сохраненный failStale")
+
+	var created int
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE team_id = ?", s.teamID.String()).Scan(&created), methodCtx)
+	s.Equal(0, created, "после потери задания пакеты не вставляются")
+}
+
+func (s *ImportsSuite) TestFailSavedAfterCancel() {
+	const methodCtx = "imports.ImportsSuite.TestFailSavedAfterCancel"
+
+	ctx := context.Background()
+	job, err := s.service.Submit(ctx, s.ownerID, s.teamID, api.TaskImportFormatNdjson, false, "", strings.NewReader(`{"title": "One"}`))
+	s.Require().NoError(err, methodCtx)
+
+	record, ok, err := repomysql.NewTaskImportsRepo(s.DB).ClaimNext(ctx, time.Now().UTC())
+	s.Require().NoError(err, methodCtx)
+	s.Require().True(ok, methodCtx)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	s.Require().NoError(s.service.run(canceled, record), methodCtx)
+
+	job, err = s.service.Get(ctx, s.ownerID, s.teamID, job.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Failed, job.Status, "итог сохраняется после отмены контекста")
+}
+
+func (s *ImportsSuite) TestSubmitValidation() {
+	const methodCtx = "imports.ImportsSuite.TestSubmitValidation"
+
+	ctx := context.Background()
+
+	_, err := s.service.Submit(ctx, s.outsiderID, s.teamID, api.TaskImportFormatCsv, false, "", strings.NewReader(importCSV))
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Submit(ctx, s.memberID, s.teamID, api.TaskImportFormatCsv, false, `{"created_by": "x"}`, strings.NewReader(importCSV))
+	s.ErrorIs(err, ErrInvalidMapping, methodCtx)
+
+	_, err = s.service.Submit(ctx, s.memberID, s.teamID, api.TaskImportFormatCsv, false, "", strings.NewReader("  "))
+	s.ErrorIs(err, ErrEmptyFile, methodCtx)
+
+	_, err = s.service.Submit(ctx, s.memberID, s.teamID, "xlsx", false, "", strings.NewReader(importCSV))
+	s.ErrorIs(err, ErrInvalidFormat, methodCtx)
+
+	job, err := s.service.Submit(ctx, s.memberID, s.teamID, api.TaskImportFormatCsv, false, "", strings.NewReader("name\nx\n"))
+	s.Require().NoError(err, methodCtx)
+	s.Require().NoError(s.service.ProcessPending(ctx), methodCtx)
+	job, err = s.service.Get(ctx, s.memberID, s.teamID, job.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Failed, job.Status)
+	s.Require().NotNil(job.Error)
+
+	_, err = s.service.Get(ctx, s.outsiderID, s.teamID, job.Id)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Get(ctx, s.memberID, s.teamID, uuid.New())
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
@@ -0,0 +1,260 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/breaker"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/mailer"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+const (
+	defaultDueSoon       = 24 * time.Hour
+	defaultEscalateAfter = 48 * time.Hour
+	defaultBatchSize     = 100
+
+	dueLayout = "2006-01-02 15:04 UTC"
+)
+
+// Service рассылает напоминания о сроках задач и хранит настройки уведомлений пользователей.
+type Service struct {
+	reminders RemindersRepository
+	settings  SettingsRepository
+	mailer    mailer.Mailer
+	breaker   breaker.Breaker
+	dueSoon   time.Duration
+	escalate  time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+// RemindersRepository описывает учет отправленных напоминаний и выбор получателей.
+type RemindersRepository interface {
+	ListPending(ctx context.Context, filter repomysql.ReminderFilter) ([]repomysql.ReminderCandidate, error)
+	Claim(ctx context.Context, taskID uuid.UUID, threshold string, dueAt time.Time, sentAt time.Time) (bool, error)
+	Release(ctx context.Context, taskID uuid.UUID, threshold string, dueAt time.Time) error
+	Delivered(ctx context.Context, taskID uuid.UUID, threshold string, dueAt time.Time) ([]string, error)
+	MarkDelivered(ctx context.Context, taskID uuid.UUID, threshold string, dueAt time.Time, email string, sentAt time.Time) error
+	AssigneeEmails(ctx context.Context, taskID uuid.UUID) ([]string, error)
+	ManagerEmails(ctx context.Context, teamID uuid.UUID) ([]string, error)
+}
+
+// SettingsRepository описывает хранение настроек уведомлений.
+type SettingsRepository interface {
+	Get(ctx context.Context, userID uuid.UUID) (repomysql.NotificationSettingsRecord, error)
+	Save(ctx context.Context, record repomysql.NotificationSettingsRecord) error
+}
+
+// NewService создает сервис напоминаний.
+func NewService(reminders RemindersRepository, settings SettingsRepository, mailer mailer.Mailer, breaker breaker.Breaker, cfg config.RemindersConfig) (*Service, error) {
+	const methodCtx = "reminders.NewService"
+
+	slog.Debug("инициализация сервиса напоминаний", slog.String("context", methodCtx))
+
+	if reminders == nil {
+		return nil, fmt.Errorf("%s: reminders repo не задан", methodCtx)
+	}
+	if settings == nil {
+		return nil, fmt.Errorf("%s: settings repo не задан", methodCtx)
+	}
+	if mailer == nil {
+		return nil, fmt.Errorf("%s: mailer не задан", methodCtx)
+	}
+	if breaker == nil {
+		return nil, fmt.Errorf("%s: breaker не задан", methodCtx)
+	}
+
+	dueSoon := time.Duration(cfg.DueSoonHours) * time.Hour
+	if dueSoon <= 0 {
+		dueSoon = defaultDueSoon
+	}
+	escalate := time.Duration(cfg.EscalateAfterHours) * time.Hour
+	if escalate <= 0 {
+		escalate = defaultEscalateAfter
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	return &Service{
+		reminders: reminders,
+		settings:  settings,
+		mailer:    mailer,
+		breaker:   breaker,
+		dueSoon:   dueSoon,
+		escalate:  escalate,
+		batchSize: batchSize,
+		now:       time.Now,
+	}, nil
+}
+
+// ProcessDue отправляет напоминания по незавершенным задачам. Вызывается фоновым обработчиком.
+// Исполнители получают письмо, когда до срока остается меньше dueSoon и когда срок истек;
+// owner и admin команды — когда просрочка превышает escalate. Каждое напоминание отправляется
+// один раз на задачу, порог и срок. Доставка учитывается по получателям: при ошибке отправки
+// напоминание повторяется при следующем запуске только для тех, кому письмо еще не ушло.
+// Ошибка по одной задаче записывается в журнал и не прерывает обработку остальных.
+func (s *Service) ProcessDue(ctx context.Context) error {
+	const methodCtx = "reminders.Service.ProcessDue"
+
+	now := s.now().UTC()
+	filters := []repomysql.ReminderFilter{
+		{Threshold: repomysql.ReminderDueSoon, DueBefore: now.Add(s.dueSoon), DueAfter: &now},
+		{Threshold: repomysql.ReminderOverdue, DueBefore: now},
+		{Threshold: repomysql.ReminderEscalation, DueBefore: now.Add(-s.escalate)},
+	}
+
+	for _, filter := range filters {
+		filter.Limit = s.batchSize
+		candidates, err := s.reminders.ListPending(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		for _, candidate := range candidates {
+			if err := s.remind(ctx, filter.Threshold, candidate, now); err != nil {
+				slog.Warn("не удалось отправить напоминание",
+					slog.String("context", methodCtx),
+					slog.String("task_id", candidate.TaskID.String()),
+					slog.String("threshold", filter.Threshold),
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Settings возвращает настройки уведомлений пользователя.
+func (s *Service) Settings(ctx context.Context, userID uuid.UUID) (api.NotificationSettings, error) {
+	const methodCtx = "reminders.Service.Settings"
+
+	slog.Debug("вызов получения настроек уведомлений", slog.String("context", methodCtx))
+
+	record, err := s.settings.Get(ctx, userID)
+	if err != nil {
+		return api.NotificationSettings{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.NotificationSettings{DueReminders: record.DueReminders}, nil
+}
+
+// UpdateSettings изменяет переданные настройки уведомлений пользователя.
+func (s *Service) UpdateSettings(ctx context.Context, userID uuid.UUID, req api.UpdateNotificationSettingsRequest) (api.NotificationSettings, error) {
+	const methodCtx = "reminders.Service.UpdateSettings"
+
+	slog.Debug("вызов изменения настроек уведомлений", slog.String("context", methodCtx))
+
+	record, err := s.settings.Get(ctx, userID)
+	if err != nil {
+		return api.NotificationSettings{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if req.DueReminders != nil {
+		record.DueReminders = *req.DueReminders
+		record.UpdatedAt = s.now().UTC()
+		if err := s.settings.Save(ctx, record); err != nil {
+			return api.NotificationSettings{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	return api.NotificationSettings{DueReminders: record.DueReminders}, nil
+}
+
+// remind отмечает напоминание и рассылает его получателям; при ошибке отметка снимается,
+// а уже доставленные письма остаются учтенными и при повторе не отправляются.
+func (s *Service) remind(ctx context.Context, threshold string, candidate repomysql.ReminderCandidate, now time.Time) error {
+	const methodCtx = "reminders.Service.remind"
+
+	claimed, err := s.reminders.Claim(ctx, candidate.TaskID, threshold, candidate.DueAt, now)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !claimed {
+		return nil
+	}
+
+	if err := s.send(ctx, threshold, candidate, now); err != nil {
+		if releaseErr := s.reminders.Release(ctx, candidate.TaskID, threshold, candidate.DueAt); releaseErr != nil {
+			slog.Warn("не удалось снять отметку напоминания",
+				slog.String("context", methodCtx),
+				slog.String("task_id", candidate.TaskID.String()),
+				slog.String("error", releaseErr.Error()),
+			)
+		}
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	slog.Info("отправлено напоминание о сроке задачи",
+		slog.String("context", methodCtx),
+		slog.String("task_id", candidate.TaskID.String()),
+		slog.String("threshold", threshold),
+	)
+	return nil
+}
+
+func (s *Service) send(ctx context.Context, threshold string, candidate repomysql.ReminderCandidate, now time.Time) error {
+	var recipients []string
+	var err error
+	if threshold == repomysql.ReminderEscalation {
+		recipients, err = s.reminders.ManagerEmails(ctx, candidate.TeamID)
+	} else {
+		recipients, err = s.reminders.AssigneeEmails(ctx, candidate.TaskID)
+	}
+	if err != nil {
+		return err
+	}
+
+	delivered, err := s.reminders.Delivered(ctx, candidate.TaskID, threshold, candidate.DueAt)
+	if err != nil {
+		return err
+	}
+	skip := make(map[string]struct{}, len(delivered))
+	for _, email := range delivered {
+		skip[email] = struct{}{}
+	}
+
+	subject, body := s.message(threshold, candidate)
+	var failed []error
+	for _, email := range recipients {
+		if _, ok := skip[email]; ok {
+			continue
+		}
+		err := s.breaker.Execute(func() error {
+			return s.mailer.Send(ctx, mailer.Message{To: email, Subject: subject, Body: body})
+		})
+		if err != nil {
+			failed = append(failed, fmt.Errorf("ошибка отправки письма %s: %w", email, err))
+			continue
+		}
+		if err := s.reminders.MarkDelivered(ctx, candidate.TaskID, threshold, candidate.DueAt, email, now); err != nil {
+			failed = append(failed, err)
+		}
+	}
+	return errors.Join(failed...)
+}
+
+func (s *Service) message(threshold string, candidate repomysql.ReminderCandidate) (string, string) {
+	due := candidate.DueAt.UTC().Format(dueLayout)
+
+	switch threshold {
+	case repomysql.ReminderDueSoon:
+		return "Приближается срок задачи",
+			fmt.Sprintf("Срок задачи «%s» в команде %s истекает %s.", candidate.Title, candidate.TeamName, due)
+	case repomysql.ReminderOverdue:
+		return "Срок задачи истек",
+			fmt.Sprintf("Срок задачи «%s» в команде %s истек %s.", candidate.Title, candidate.TeamName, due)
+	default:
+		return "Эскалация: просроченная задача",
+			fmt.Sprintf("Задача «%s» в команде %s просрочена более чем на %d ч. (срок %s).", candidate.Title, candidate.TeamName, int(s.escalate.Hours()), due)
+	}
+}
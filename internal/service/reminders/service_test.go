@@ -0,0 +1,222 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/breaker"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/mailer"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+type RemindersSuite struct {
+	tests.IntegrationSuite
+	service    *Service
+	mailer     *mailer.MockMailer
+	now        time.Time
+	ownerID    uuid.UUID
+	adminID    uuid.UUID
+	assigneeID uuid.UUID
+	optedOutID uuid.UUID
+	teamID     uuid.UUID
+}
+
+func TestRemindersSuite(t *testing.T) {
+	const methodCtx = "reminders.TestRemindersSuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(RemindersSuite))
+}
+
+func (s *RemindersSuite) SetupTest() {
+	const methodCtx = "reminders.RemindersSuite.SetupTest"
+
+	s.TruncateTables(
+		"task_reminder_deliveries",
+		"task_reminders",
+		"user_notification_settings",
+		"task_assignees",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-reminders@example.com")
+	s.adminID = s.CreateUser("admin-reminders@example.com")
+	s.assigneeID = s.CreateUser("assignee-reminders@example.com")
+	s.optedOutID = s.CreateUser("opted-out-reminders@example.com")
+
+	s.teamID = s.CreateTeam("Reminders Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.adminID, "admin")
+	s.AddTeamMember(s.teamID, s.assigneeID, "member")
+	s.AddTeamMember(s.teamID, s.optedOutID, "member")
+
+	cb, err := breaker.New("reminders-test")
+	s.Require().NoError(err, methodCtx)
+
+	s.mailer = mailer.NewMockMailer()
+	service, err := NewService(
+		repomysql.NewTaskRemindersRepo(s.DB),
+		repomysql.NewNotificationSettingsRepo(s.DB),
+		s.mailer,
+		cb,
+		config.RemindersConfig{DueSoonHours: 24, EscalateAfterHours: 48},
+	)
+	s.Require().NoError(err, methodCtx)
+
+	s.now = time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return s.now }
+	s.service = service
+}
+
+func (s *RemindersSuite) TestProcessDue() {
+	const methodCtx = "reminders.RemindersSuite.TestProcessDue"
+
+	ctx := context.Background()
+	disabled := false
+	_, err := s.service.UpdateSettings(ctx, s.optedOutID, api.UpdateNotificationSettingsRequest{DueReminders: &disabled})
+	s.Require().NoError(err, methodCtx)
+
+	soon := s.createDueTask("soon", "todo", s.now.Add(2*time.Hour))
+	s.addAssignee(soon, s.optedOutID)
+	s.createDueTask("later", "todo", s.now.Add(72*time.Hour))
+	s.createDueTask("overdue", "in_progress", s.now.Add(-3*time.Hour))
+	s.createDueTask("stale", "todo", s.now.Add(-72*time.Hour))
+	s.createDueTask("done", "done", s.now.Add(-72*time.Hour))
+
+	s.Require().NoError(s.service.ProcessDue(ctx), methodCtx)
+
+	messages := s.mailer.Messages()
+	s.Require().Len(messages, 5, methodCtx)
+	recipients := map[string][]string{}
+	for _, msg := range messages {
+		recipients[msg.Subject] = append(recipients[msg.Subject], msg.To)
+	}
+	s.Equal([]string{"assignee-reminders@example.com"}, recipients["Приближается срок задачи"], "отключившие напоминания не получают писем")
+	s.Len(recipients["Срок задачи истек"], 2)
+	s.ElementsMatch([]string{"owner-reminders@example.com", "admin-reminders@example.com"}, recipients["Эскалация: просроченная задача"])
+
+	s.Require().NoError(s.service.ProcessDue(ctx), methodCtx)
+	s.Len(s.mailer.Messages(), 5, "повторный запуск не дублирует напоминания")
+
+	_, err = s.DB.ExecContext(ctx, "UPDATE tasks SET due_at = ? WHERE id = ?", s.now.Add(3*time.Hour), soon.String())
+	s.Require().NoError(err, methodCtx)
+	s.Require().NoError(s.service.ProcessDue(ctx), methodCtx)
+	s.Len(s.mailer.Messages(), 6, "после переноса срока напоминание отправляется заново")
+}
+
+func (s *RemindersSuite) TestProcessDueRetriesFailedSend() {
+	const methodCtx = "reminders.RemindersSuite.TestProcessDueRetriesFailedSend"
+
+	ctx := context.Background()
+	taskID := s.createDueTask("overdue", "todo", s.now.Add(-time.Hour))
+
+	s.mailer.SetError(errors.New("smtp недоступен"))
+	s.Require().NoError(s.service.ProcessDue(ctx), "ошибка отправки не прерывает обработку")
+
+	var claimed int
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM task_reminders WHERE task_id = ?", taskID.String()).Scan(&claimed), methodCtx)
+	s.Equal(0, claimed, "неотправленное напоминание не отмечается")
+
+	s.mailer.Reset()
+	s.Require().NoError(s.service.ProcessDue(ctx), methodCtx)
+	s.Len(s.mailer.Messages(), 1, methodCtx)
+}
+
+func (s *RemindersSuite) TestProcessDueResendsOnlyUndelivered() {
+	const methodCtx = "reminders.RemindersSuite.TestProcessDueResendsOnlyUndelivered"
+
+	ctx := context.Background()
+	failing := s.createDueTask("partial", "todo", s.now.Add(-2*time.Hour))
+	s.addAssignee(failing, s.adminID)
+	s.createDueTask("next", "todo", s.now.Add(-time.Hour))
+
+	s.service.mailer = &recipientFailMailer{next: s.mailer, failTo: "admin-reminders@example.com"}
+	s.Require().NoError(s.service.ProcessDue(ctx), methodCtx)
+
+	messages := s.mailer.Messages()
+	s.Require().Len(messages, 2, "ошибка по одной задаче не прерывает обработку следующих")
+	for _, msg := range messages {
+		s.Equal("assignee-reminders@example.com", msg.To, methodCtx)
+	}
+
+	var claimed int
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM task_reminders WHERE task_id = ?", failing.String()).Scan(&claimed), methodCtx)
+	s.Equal(0, claimed, "частично отправленное напоминание повторяется")
+
+	s.service.mailer = s.mailer
+	s.mailer.Reset()
+	s.Require().NoError(s.service.ProcessDue(ctx), methodCtx)
+	messages = s.mailer.Messages()
+	s.Require().Len(messages, 1, "повтор не дублирует уже доставленные письма")
+	s.Equal("admin-reminders@example.com", messages[0].To, methodCtx)
+
+	s.mailer.Reset()
+	s.Require().NoError(s.service.ProcessDue(ctx), methodCtx)
+	s.Empty(s.mailer.Messages(), methodCtx)
+}
+
+func (s *RemindersSuite) TestSettings() {
+	const methodCtx = "reminders.RemindersSuite.TestSettings"
+
+	ctx := context.Background()
+	settings, err := s.service.Settings(ctx, s.assigneeID)
+	s.Require().NoError(err, methodCtx)
+	s.True(settings.DueReminders, "напоминания включены по умолчанию")
+
+	disabled := false
+	settings, err = s.service.UpdateSettings(ctx, s.assigneeID, api.UpdateNotificationSettingsRequest{DueReminders: &disabled})
+	s.Require().NoError(err, methodCtx)
+	s.False(settings.DueReminders)
+
+	settings, err = s.service.UpdateSettings(ctx, s.assigneeID, api.UpdateNotificationSettingsRequest{})
+	s.Require().NoError(err, methodCtx)
+	s.False(settings.DueReminders, "пустой запрос не меняет настройки")
+}
+
+func (s *RemindersSuite) createDueTask(title string, status string, dueAt time.Time) uuid.UUID {
+	const methodCtx = "reminders.RemindersSuite.createDueTask"
+
+	taskID := s.CreateTask(s.teamID, s.ownerID, &s.assigneeID, status, title, "")
+	_, err := s.DB.ExecContext(context.Background(), "UPDATE tasks SET due_at = ? WHERE id = ?", dueAt, taskID.String())
+	s.Require().NoError(err, methodCtx)
+	return taskID
+}
+
+func (s *RemindersSuite) addAssignee(taskID uuid.UUID, userID uuid.UUID) {
+	const methodCtx = "reminders.RemindersSuite.addAssignee"
+
+	_, err := s.DB.ExecContext(
+		context.Background(),
+		"INSERT INTO task_assignees (task_id, user_id, is_primary, created_at) VALUES (?, ?, 0, ?)",
+		taskID.String(),
+		userID.String(),
+		s.now,
+	)
+	s.Require().NoError(err, methodCtx)
+}
+
+// recipientFailMailer отклоняет письма одному адресату и передает остальные дальше.
+type recipientFailMailer struct {
+	next   mailer.Mailer
+	failTo string
+}
+
+func (m *recipientFailMailer) Send(ctx context.Context, msg mailer.Message) error {
+	if msg.To == m.failTo {
+		return errors.New("адресат недоступен")
+	}
+	return m.next.Send(ctx, msg)
+}
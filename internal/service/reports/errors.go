@@ -4,5 +4,7 @@ import "errors"
 
 var (
 	ErrForbidden      = errors.New("доступ запрещен")
+	ErrInvalidPeriod  = errors.New("некорректный период отчета")
+	ErrInvalidGroupBy = errors.New("некорректная группировка отчета")
 	ErrNotImplemented = errors.New("не реализовано")
 )
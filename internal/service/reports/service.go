@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/Seraf-seraf/mkk_test/internal/api"
 	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
@@ -15,6 +17,7 @@ import (
 // Service реализует бизнес-логику отчетов.
 type Service struct {
 	reports ReportsRepository
+	members MembersRepository
 }
 
 // ReportsRepository описывает запросы отчетов.
@@ -22,10 +25,17 @@ type ReportsRepository interface {
 	TeamSummary(ctx context.Context) ([]repomysql.TeamSummaryRecord, error)
 	TopCreators(ctx context.Context, month string) ([]repomysql.TopCreatorRecord, error)
 	InvalidAssignees(ctx context.Context) ([]repomysql.InvalidAssigneeRecord, error)
+	Timesheet(ctx context.Context, filter repomysql.TimesheetFilter) ([]repomysql.TimesheetRecord, error)
+	Velocity(ctx context.Context, filter repomysql.VelocityFilter) ([]repomysql.VelocityRecord, error)
+}
+
+// MembersRepository описывает доступ к участникам команды.
+type MembersRepository interface {
+	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
 }
 
 // NewService создает сервис отчетов.
-func NewService(reports ReportsRepository) (*Service, error) {
+func NewService(reports ReportsRepository, members MembersRepository) (*Service, error) {
 	const methodCtx = "reports.NewService"
 
 	slog.Debug("инициализация сервиса отчетов", slog.String("context", methodCtx))
@@ -33,8 +43,11 @@ func NewService(reports ReportsRepository) (*Service, error) {
 	if reports == nil {
 		return nil, fmt.Errorf("%s: reports repo не задан", methodCtx)
 	}
+	if members == nil {
+		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
+	}
 
-	return &Service{reports: reports}, nil
+	return &Service{reports: reports, members: members}, nil
 }
 
 // TeamSummary возвращает отчет по командам.
@@ -122,3 +135,126 @@ func (s *Service) InvalidAssignees(ctx context.Context, _ uuid.UUID) ([]api.Inva
 
 	return items, nil
 }
+
+// Timesheet возвращает табель учета времени за период [from, to] по дням включительно.
+// Без фильтров показывает всех пользователей во всех командах текущего пользователя.
+func (s *Service) Timesheet(ctx context.Context, userID uuid.UUID, from time.Time, to time.Time, teamID *uuid.UUID, targetUserID *uuid.UUID) (api.TimesheetReport, error) {
+	const methodCtx = "reports.Service.Timesheet"
+
+	slog.Debug("вызов отчета timesheet", slog.String("context", methodCtx))
+
+	from = truncateDay(from)
+	to = truncateDay(to)
+	if to.Before(from) {
+		return api.TimesheetReport{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidPeriod)
+	}
+
+	if teamID != nil {
+		member, err := s.members.IsMember(ctx, *teamID, userID)
+		if err != nil {
+			return api.TimesheetReport{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if !member {
+			return api.TimesheetReport{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+		}
+	}
+
+	records, err := s.reports.Timesheet(ctx, repomysql.TimesheetFilter{
+		RequesterID: userID,
+		From:        from,
+		To:          to.AddDate(0, 0, 1),
+		TeamID:      teamID,
+		UserID:      targetUserID,
+	})
+	if err != nil {
+		return api.TimesheetReport{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.TimesheetEntry, 0, len(records))
+	for _, record := range records {
+		items = append(items, api.TimesheetEntry{
+			TeamId:        api.UUID(record.TeamID),
+			TeamName:      record.TeamName,
+			UserId:        api.UUID(record.UserID),
+			TotalMinutes:  record.TotalMinutes,
+			WorklogsCount: record.WorklogsCount,
+		})
+	}
+
+	return api.TimesheetReport{
+		From:  openapi_types.Date{Time: from},
+		To:    openapi_types.Date{Time: to},
+		Items: items,
+	}, nil
+}
+
+// Velocity возвращает завершенные story points за период [from, to] по дням включительно,
+// сгруппированные по неделям или спринтам. Без team_id показывает все команды текущего пользователя.
+func (s *Service) Velocity(ctx context.Context, userID uuid.UUID, groupBy api.VelocityGroupBy, from time.Time, to time.Time, teamID *uuid.UUID) (api.VelocityReport, error) {
+	const methodCtx = "reports.Service.Velocity"
+
+	slog.Debug("вызов отчета velocity", slog.String("context", methodCtx))
+
+	if groupBy != api.VelocityGroupByWeek && groupBy != api.VelocityGroupBySprint {
+		return api.VelocityReport{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidGroupBy)
+	}
+
+	from = truncateDay(from)
+	to = truncateDay(to)
+	if to.Before(from) {
+		return api.VelocityReport{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidPeriod)
+	}
+
+	if teamID != nil {
+		member, err := s.members.IsMember(ctx, *teamID, userID)
+		if err != nil {
+			return api.VelocityReport{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if !member {
+			return api.VelocityReport{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+		}
+	}
+
+	records, err := s.reports.Velocity(ctx, repomysql.VelocityFilter{
+		RequesterID: userID,
+		GroupBy:     string(groupBy),
+		From:        from,
+		To:          to.AddDate(0, 0, 1),
+		TeamID:      teamID,
+	})
+	if err != nil {
+		return api.VelocityReport{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.VelocityEntry, 0, len(records))
+	for _, record := range records {
+		entry := api.VelocityEntry{
+			TeamId:           api.UUID(record.TeamID),
+			TeamName:         record.TeamName,
+			SprintName:       record.SprintName,
+			CompletedPoints:  record.CompletedPoints,
+			CompletedTasks:   record.CompletedTasks,
+			UnestimatedTasks: record.UnestimatedTasks,
+		}
+		if record.WeekStart != nil {
+			entry.WeekStart = &openapi_types.Date{Time: *record.WeekStart}
+		}
+		if record.SprintID != nil {
+			sprintID := api.UUID(*record.SprintID)
+			entry.SprintId = &sprintID
+		}
+		items = append(items, entry)
+	}
+
+	return api.VelocityReport{
+		GroupBy: groupBy,
+		From:    openapi_types.Date{Time: from},
+		To:      openapi_types.Date{Time: to},
+		Items:   items,
+	}, nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
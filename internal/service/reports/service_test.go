@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/Seraf-seraf/mkk_test/internal/api"
 	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
 	"github.com/Seraf-seraf/mkk_test/internal/tests"
 )
@@ -33,6 +34,9 @@ func (s *ReportsSuite) SetupTest() {
 	const methodCtx = "reports.ReportsSuite.SetupTest"
 
 	s.TruncateTables(
+		"sprints",
+		"task_assignees",
+		"task_worklogs",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -53,7 +57,7 @@ func (s *ReportsSuite) SetupTest() {
 	s.AddTeamMember(s.teamBID, s.ownerID, "owner")
 
 	repo := repomysql.NewReportsRepo(s.DB)
-	service, err := NewService(repo)
+	service, err := NewService(repo, repomysql.NewTeamMembersRepo(s.DB))
 	s.Require().NoError(err, methodCtx)
 	s.service = service
 }
@@ -171,6 +175,78 @@ func (s *ReportsSuite) TestInvalidAssignees() {
 	s.True(found, methodCtx)
 }
 
+func (s *ReportsSuite) TestTimesheet() {
+	const methodCtx = "reports.ReportsSuite.TestTimesheet"
+
+	ctx := context.Background()
+	outsiderID := s.CreateUser("outsider-report@example.com")
+	teamCID := s.CreateTeam("Gamma", outsiderID)
+	s.AddTeamMember(teamCID, outsiderID, "owner")
+
+	taskA := s.CreateTask(s.teamAID, s.ownerID, nil, "todo", "A", "")
+	taskB := s.CreateTask(s.teamBID, s.ownerID, nil, "todo", "B", "")
+	taskC := s.CreateTask(teamCID, outsiderID, nil, "todo", "C", "")
+
+	day := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	thirty, twenty, ninety := 30, 20, 90
+	s.insertWorklog(taskA, s.ownerID, day, &thirty)
+	s.insertWorklog(taskA, s.ownerID, day.Add(2*time.Hour), &twenty)
+	s.insertWorklog(taskA, s.memberID, day, &ninety)
+	s.insertWorklog(taskA, s.memberID, day.AddDate(0, 1, 0), &ninety)
+	s.insertWorklog(taskA, s.memberID, day, nil)
+	s.insertWorklog(taskB, s.ownerID, day, &twenty)
+	s.insertWorklog(taskC, outsiderID, day, &ninety)
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	resp, err := s.service.Timesheet(ctx, s.ownerID, from, to, nil, nil)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 3)
+
+	totals := map[string]int{}
+	for _, item := range resp.Items {
+		totals[item.TeamName+":"+item.UserId.String()] = item.TotalMinutes
+	}
+	s.Equal(50, totals["Alpha:"+s.ownerID.String()])
+	s.Equal(90, totals["Alpha:"+s.memberID.String()])
+	s.Equal(20, totals["Beta:"+s.ownerID.String()])
+
+	resp, err = s.service.Timesheet(ctx, s.memberID, from, to, nil, &s.ownerID)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 1)
+	s.Equal(2, resp.Items[0].WorklogsCount)
+
+	_, err = s.service.Timesheet(ctx, s.memberID, from, to, &s.teamBID, nil)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Timesheet(ctx, s.ownerID, to, from, nil, nil)
+	s.ErrorIs(err, ErrInvalidPeriod, methodCtx)
+}
+
+func (s *ReportsSuite) insertWorklog(taskID uuid.UUID, userID uuid.UUID, startedAt time.Time, duration *int) {
+	const methodCtx = "reports.ReportsSuite.insertWorklog"
+
+	var durationValue interface{}
+	if duration != nil {
+		durationValue = *duration
+	}
+
+	_, err := s.DB.ExecContext(
+		context.Background(),
+		"INSERT INTO task_worklogs (id, task_id, user_id, started_at, duration_minutes, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		uuid.New().String(),
+		taskID.String(),
+		userID.String(),
+		startedAt,
+		durationValue,
+		startedAt,
+	)
+	if err != nil {
+		s.T().Fatalf("%s: %v", methodCtx, err)
+	}
+}
+
 func (s *ReportsSuite) insertTaskWithTimes(teamID uuid.UUID, creatorID uuid.UUID, status string, createdAt time.Time, completedAt *time.Time) {
 	const methodCtx = "reports.ReportsSuite.insertTaskWithTimes"
 
@@ -199,3 +275,130 @@ func (s *ReportsSuite) insertTaskWithTimes(teamID uuid.UUID, creatorID uuid.UUID
 		s.T().Fatalf("%s: %v", methodCtx, err)
 	}
 }
+
+func (s *ReportsSuite) TestInvalidCoAssignees() {
+	const methodCtx = "reports.ReportsSuite.TestInvalidCoAssignees"
+
+	ctx := context.Background()
+	coAssignee := s.CreateUser("invalid-co-assignee@example.com")
+	taskID := s.CreateTask(s.teamAID, s.ownerID, &s.ownerID, "todo", "Pair", "")
+
+	_, err := s.DB.ExecContext(
+		ctx,
+		"INSERT INTO task_assignees (task_id, user_id, is_primary, created_at) VALUES (?, ?, 0, ?)",
+		taskID.String(),
+		coAssignee.String(),
+		time.Now().UTC(),
+	)
+	s.Require().NoError(err, methodCtx)
+
+	resp, err := s.service.InvalidAssignees(ctx, s.ownerID)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp, 1)
+	s.Equal(taskID, resp[0].TaskId)
+	s.Equal(coAssignee, resp[0].AssigneeId)
+}
+
+func (s *ReportsSuite) TestVelocity() {
+	const methodCtx = "reports.ReportsSuite.TestVelocity"
+
+	ctx := context.Background()
+	sprintID := uuid.New()
+	_, err := s.DB.ExecContext(
+		ctx,
+		"INSERT INTO sprints (id, team_id, name, start_date, end_date, state, created_by, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		sprintID.String(),
+		s.teamAID.String(),
+		"Sprint 1",
+		"2025-03-10",
+		"2025-03-21",
+		"active",
+		s.ownerID.String(),
+		time.Now().UTC(),
+	)
+	s.Require().NoError(err, methodCtx)
+
+	five, eight, thirteen := 5, 8, 13
+	s.insertDoneTask(s.teamAID, &five, time.Date(2025, 3, 11, 12, 0, 0, 0, time.UTC), &sprintID)
+	reestimated := s.insertDoneTask(s.teamAID, &eight, time.Date(2025, 3, 12, 10, 0, 0, 0, time.UTC), &sprintID)
+	s.insertDoneTask(s.teamAID, nil, time.Date(2025, 3, 18, 12, 0, 0, 0, time.UTC), nil)
+	s.insertDoneTask(s.teamBID, &thirteen, time.Date(2025, 3, 11, 12, 0, 0, 0, time.UTC), nil)
+	s.insertTaskWithTimes(s.teamAID, s.ownerID, "todo", time.Date(2025, 3, 11, 12, 0, 0, 0, time.UTC), nil)
+
+	s.insertPointsHistory(reestimated, `{"story_points": {"from": null, "to": 3}}`, time.Date(2025, 3, 12, 9, 0, 0, 0, time.UTC))
+	s.insertPointsHistory(reestimated, `{"story_points": {"from": 3, "to": 8}}`, time.Date(2025, 3, 13, 9, 0, 0, 0, time.UTC))
+
+	from := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 23, 0, 0, 0, 0, time.UTC)
+
+	weekly, err := s.service.Velocity(ctx, s.memberID, api.VelocityGroupByWeek, from, to, nil)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(weekly.Items, 2, "команда Beta недоступна участнику")
+	s.Equal("2025-03-10", weekly.Items[0].WeekStart.String())
+	s.Equal(8, weekly.Items[0].CompletedPoints, "используется оценка на момент завершения")
+	s.Equal(2, weekly.Items[0].CompletedTasks)
+	s.Equal("2025-03-17", weekly.Items[1].WeekStart.String())
+	s.Equal(0, weekly.Items[1].CompletedPoints)
+	s.Equal(1, weekly.Items[1].UnestimatedTasks)
+
+	bySprint, err := s.service.Velocity(ctx, s.memberID, api.VelocityGroupBySprint, from, to, nil)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(bySprint.Items, 1, methodCtx)
+	s.Equal(sprintID, *bySprint.Items[0].SprintId)
+	s.Equal("Sprint 1", *bySprint.Items[0].SprintName)
+	s.Equal(8, bySprint.Items[0].CompletedPoints)
+
+	_, err = s.service.Velocity(ctx, s.memberID, api.VelocityGroupByWeek, from, to, &s.teamBID)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Velocity(ctx, s.memberID, api.VelocityGroupBy("month"), from, to, nil)
+	s.ErrorIs(err, ErrInvalidGroupBy, methodCtx)
+
+	_, err = s.service.Velocity(ctx, s.memberID, api.VelocityGroupByWeek, to, from, nil)
+	s.ErrorIs(err, ErrInvalidPeriod, methodCtx)
+}
+
+func (s *ReportsSuite) insertDoneTask(teamID uuid.UUID, points *int, completedAt time.Time, sprintID *uuid.UUID) uuid.UUID {
+	const methodCtx = "reports.ReportsSuite.insertDoneTask"
+
+	id := uuid.New()
+
+	var pointsValue, sprintValue interface{}
+	if points != nil {
+		pointsValue = *points
+	}
+	if sprintID != nil {
+		sprintValue = sprintID.String()
+	}
+
+	_, err := s.DB.ExecContext(
+		context.Background(),
+		"INSERT INTO tasks (id, team_id, title, status, sprint_id, story_points, created_by, created_at, completed_at) VALUES (?, ?, ?, 'done', ?, ?, ?, ?, ?)",
+		id.String(),
+		teamID.String(),
+		"done",
+		sprintValue,
+		pointsValue,
+		s.ownerID.String(),
+		completedAt.Add(-24*time.Hour),
+		completedAt,
+	)
+	s.Require().NoError(err, methodCtx)
+
+	return id
+}
+
+func (s *ReportsSuite) insertPointsHistory(taskID uuid.UUID, changes string, changedAt time.Time) {
+	const methodCtx = "reports.ReportsSuite.insertPointsHistory"
+
+	_, err := s.DB.ExecContext(
+		context.Background(),
+		"INSERT INTO task_history (id, task_id, changed_by, changes, changed_at) VALUES (?, ?, ?, ?, ?)",
+		uuid.New().String(),
+		taskID.String(),
+		s.ownerID.String(),
+		changes,
+		changedAt,
+	)
+	s.Require().NoError(err, methodCtx)
+}
@@ -0,0 +1,15 @@
+package sprints
+
+import "errors"
+
+var (
+	ErrForbidden    = errors.New("доступ запрещен")
+	ErrNotFound     = errors.New("не найдено")
+	ErrInvalidName  = errors.New("название спринта не может быть пустым")
+	ErrInvalidDates = errors.New("дата окончания спринта раньше даты начала")
+	ErrInvalidTask  = errors.New("задача не относится к команде спринта")
+	ErrInvalidNext  = errors.New("некорректный спринт для переноса задач")
+	ErrSprintClosed = errors.New("спринт закрыт")
+	ErrInvalidState = errors.New("недопустимая смена состояния спринта")
+	ErrActiveExists = errors.New("в команде уже есть активный спринт")
+)
@@ -0,0 +1,540 @@
+package sprints
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/service/tasks"
+)
+
+// Service управляет спринтами команды и составом их задач.
+type Service struct {
+	db      *sql.DB
+	sprints SprintsRepository
+	tasks   TasksRepository
+	history HistoryRepository
+	members MembersRepository
+	cache   Cache
+}
+
+// SprintsRepository описывает хранение спринтов.
+type SprintsRepository interface {
+	Create(ctx context.Context, record repomysql.SprintRecord) error
+	Get(ctx context.Context, sprintID uuid.UUID) (repomysql.SprintRecord, error)
+	GetForUpdate(ctx context.Context, tx *sql.Tx, sprintID uuid.UUID) (repomysql.SprintRecord, error)
+	CountActive(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, excludeID uuid.UUID) (int, error)
+	List(ctx context.Context, teamID uuid.UUID, state *string) ([]repomysql.SprintRecord, error)
+	Update(ctx context.Context, exec repomysql.DBTX, record repomysql.SprintRecord) error
+	ListUnfinishedTasks(ctx context.Context, tx *sql.Tx, sprintID uuid.UUID) ([]uuid.UUID, error)
+	SetTasksSprint(ctx context.Context, tx *sql.Tx, taskIDs []uuid.UUID, sprintID *uuid.UUID, updatedAt time.Time) error
+}
+
+// TasksRepository описывает доступ к задачам.
+type TasksRepository interface {
+	GetForUpdate(ctx context.Context, tx *sql.Tx, taskID uuid.UUID) (repomysql.TaskRecord, error)
+}
+
+// HistoryRepository описывает запись истории задач.
+type HistoryRepository interface {
+	Add(ctx context.Context, exec repomysql.DBTX, record repomysql.TaskHistoryRecord) error
+}
+
+// MembersRepository описывает доступ к участникам команды.
+type MembersRepository interface {
+	GetRole(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (string, bool, error)
+}
+
+// Cache описывает сброс кеша списков задач.
+type Cache interface {
+	InvalidateTeam(ctx context.Context, teamID uuid.UUID) error
+}
+
+// NewService создает сервис спринтов.
+func NewService(db *sql.DB, sprints SprintsRepository, tasks TasksRepository, history HistoryRepository, members MembersRepository, cache Cache) (*Service, error) {
+	const methodCtx = "sprints.NewService"
+
+	slog.Debug("инициализация сервиса спринтов", slog.String("context", methodCtx))
+
+	if db == nil {
+		return nil, fmt.Errorf("%s: db не задан", methodCtx)
+	}
+	if sprints == nil {
+		return nil, fmt.Errorf("%s: sprints repo не задан", methodCtx)
+	}
+	if tasks == nil {
+		return nil, fmt.Errorf("%s: tasks repo не задан", methodCtx)
+	}
+	if history == nil {
+		return nil, fmt.Errorf("%s: history repo не задан", methodCtx)
+	}
+	if members == nil {
+		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
+	}
+
+	return &Service{db: db, sprints: sprints, tasks: tasks, history: history, members: members, cache: cache}, nil
+}
+
+// List возвращает спринты команды, при необходимости только в заданном состоянии.
+func (s *Service) List(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.GetApiV1TeamsIdSprintsParams) (api.SprintsListResponse, error) {
+	const methodCtx = "sprints.Service.List"
+
+	slog.Debug("вызов списка спринтов", slog.String("context", methodCtx))
+
+	if _, err := s.role(ctx, teamID, userID); err != nil {
+		return api.SprintsListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	var state *string
+	if params.State != nil {
+		value := string(*params.State)
+		state = &value
+	}
+
+	records, err := s.sprints.List(ctx, teamID, state)
+	if err != nil {
+		return api.SprintsListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.Sprint, 0, len(records))
+	for _, record := range records {
+		items = append(items, sprintToAPI(record))
+	}
+	return api.SprintsListResponse{Items: items}, nil
+}
+
+// Create создает спринт в состоянии planned. Доступно owner и admin команды.
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.CreateSprintRequest) (api.Sprint, error) {
+	const methodCtx = "sprints.Service.Create"
+
+	slog.Debug("вызов создания спринта", slog.String("context", methodCtx))
+
+	if err := s.checkManager(ctx, teamID, userID); err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidName)
+	}
+	if req.EndDate.Before(req.StartDate.Time) {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidDates)
+	}
+
+	record := repomysql.SprintRecord{
+		ID:        uuid.New(),
+		TeamID:    teamID,
+		Name:      name,
+		Goal:      req.Goal,
+		StartDate: req.StartDate.Time,
+		EndDate:   req.EndDate.Time,
+		State:     repomysql.SprintPlanned,
+		CreatedBy: userID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := s.sprints.Create(ctx, record); err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return sprintToAPI(record), nil
+}
+
+// Get возвращает спринт участнику его команды.
+func (s *Service) Get(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID) (api.Sprint, error) {
+	const methodCtx = "sprints.Service.Get"
+
+	slog.Debug("вызов получения спринта", slog.String("context", methodCtx))
+
+	record, err := s.sprints.Get(ctx, sprintID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if _, err := s.role(ctx, record.TeamID, userID); err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return sprintToAPI(record), nil
+}
+
+// Update изменяет название, цель и даты спринта. Закрытый спринт не изменяется.
+func (s *Service) Update(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID, req api.UpdateSprintRequest) (api.Sprint, error) {
+	const methodCtx = "sprints.Service.Update"
+
+	slog.Debug("вызов обновления спринта", slog.String("context", methodCtx))
+
+	record, err := s.sprints.Get(ctx, sprintID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.checkManager(ctx, record.TeamID, userID); err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if record.State == repomysql.SprintClosed {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, ErrSprintClosed)
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidName)
+		}
+		record.Name = name
+	}
+	if req.Goal != nil {
+		record.Goal = req.Goal
+	}
+	if req.StartDate != nil {
+		record.StartDate = req.StartDate.Time
+	}
+	if req.EndDate != nil {
+		record.EndDate = req.EndDate.Time
+	}
+	if record.EndDate.Before(record.StartDate) {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidDates)
+	}
+
+	now := time.Now().UTC()
+	record.UpdatedAt = &now
+
+	if err := s.sprints.Update(ctx, nil, record); err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return sprintToAPI(record), nil
+}
+
+// Start переводит спринт из planned в active. В команде может быть только один активный спринт.
+func (s *Service) Start(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID) (api.Sprint, error) {
+	const methodCtx = "sprints.Service.Start"
+
+	slog.Debug("вызов начала спринта", slog.String("context", methodCtx))
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	record, err := s.lockManaged(ctx, tx, userID, sprintID)
+	if err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if record.State != repomysql.SprintPlanned {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidState)
+	}
+
+	active, err := s.sprints.CountActive(ctx, tx, record.TeamID, record.ID)
+	if err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if active > 0 {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, ErrActiveExists)
+	}
+
+	now := time.Now().UTC()
+	record.State = repomysql.SprintActive
+	record.UpdatedAt = &now
+
+	if err := s.sprints.Update(ctx, tx, record); err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.Sprint{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return sprintToAPI(record), nil
+}
+
+// Close закрывает спринт. Незавершенные задачи переносятся в следующий спринт той же команды
+// или, если он не задан, в бэклог; каждый перенос записывается в историю задачи.
+func (s *Service) Close(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID, req api.CloseSprintRequest) (api.CloseSprintResponse, error) {
+	const methodCtx = "sprints.Service.Close"
+
+	slog.Debug("вызов закрытия спринта", slog.String("context", methodCtx))
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	record, err := s.lockManaged(ctx, tx, userID, sprintID)
+	if err != nil {
+		return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if record.State == repomysql.SprintClosed {
+		return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidState)
+	}
+
+	if req.NextSprintId != nil {
+		if *req.NextSprintId == record.ID {
+			return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidNext)
+		}
+		next, err := s.sprints.GetForUpdate(ctx, tx, *req.NextSprintId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidNext)
+			}
+			return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if next.TeamID != record.TeamID || next.State == repomysql.SprintClosed {
+			return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidNext)
+		}
+	}
+
+	taskIDs, err := s.sprints.ListUnfinishedTasks(ctx, tx, record.ID)
+	if err != nil {
+		return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	now := time.Now().UTC()
+	if err := s.moveTasks(ctx, tx, userID, taskIDs, &record.ID, req.NextSprintId, now); err != nil {
+		return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	record.State = repomysql.SprintClosed
+	record.UpdatedAt = &now
+	record.ClosedAt = &now
+	if err := s.sprints.Update(ctx, tx, record); err != nil {
+		return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.CloseSprintResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if len(taskIDs) > 0 {
+		s.invalidateTeam(ctx, methodCtx, record.TeamID)
+	}
+
+	moved := make([]api.UUID, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		moved = append(moved, api.UUID(taskID))
+	}
+	return api.CloseSprintResponse{Sprint: sprintToAPI(record), MovedTaskIds: moved}, nil
+}
+
+// AddTasks добавляет задачи команды в незакрытый спринт. Задачи из другого спринта переносятся,
+// уже входящие в спринт пропускаются.
+func (s *Service) AddTasks(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID, req api.SprintTasksRequest) error {
+	const methodCtx = "sprints.Service.AddTasks"
+
+	slog.Debug("вызов добавления задач в спринт", slog.String("context", methodCtx), slog.Int("count", len(req.TaskIds)))
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	record, err := s.lockMember(ctx, tx, userID, sprintID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if record.State == repomysql.SprintClosed {
+		return fmt.Errorf("%s: %w", methodCtx, ErrSprintClosed)
+	}
+
+	now := time.Now().UTC()
+	seen := make(map[uuid.UUID]struct{}, len(req.TaskIds))
+	for _, taskID := range req.TaskIds {
+		if _, ok := seen[taskID]; ok {
+			continue
+		}
+		seen[taskID] = struct{}{}
+
+		task, err := s.tasks.GetForUpdate(ctx, tx, taskID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("%s: %w", methodCtx, ErrInvalidTask)
+			}
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if task.TeamID != record.TeamID {
+			return fmt.Errorf("%s: %w", methodCtx, ErrInvalidTask)
+		}
+		if task.SprintID != nil && *task.SprintID == record.ID {
+			continue
+		}
+		if err := s.moveTasks(ctx, tx, userID, []uuid.UUID{task.ID}, task.SprintID, &record.ID, now); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	s.invalidateTeam(ctx, methodCtx, record.TeamID)
+
+	return nil
+}
+
+// RemoveTask возвращает задачу из незакрытого спринта в бэклог.
+func (s *Service) RemoveTask(ctx context.Context, userID uuid.UUID, sprintID uuid.UUID, taskID uuid.UUID) error {
+	const methodCtx = "sprints.Service.RemoveTask"
+
+	slog.Debug("вызов удаления задачи из спринта", slog.String("context", methodCtx))
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	record, err := s.lockMember(ctx, tx, userID, sprintID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if record.State == repomysql.SprintClosed {
+		return fmt.Errorf("%s: %w", methodCtx, ErrSprintClosed)
+	}
+
+	task, err := s.tasks.GetForUpdate(ctx, tx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if task.SprintID == nil || *task.SprintID != record.ID {
+		return fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	}
+
+	if err := s.moveTasks(ctx, tx, userID, []uuid.UUID{task.ID}, task.SprintID, nil, time.Now().UTC()); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	s.invalidateTeam(ctx, methodCtx, record.TeamID)
+
+	return nil
+}
+
+// moveTasks переносит задачи из спринта from в спринт to (nil — бэклог) и пишет историю.
+func (s *Service) moveTasks(ctx context.Context, tx *sql.Tx, userID uuid.UUID, taskIDs []uuid.UUID, from *uuid.UUID, to *uuid.UUID, now time.Time) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+	if err := s.sprints.SetTasksSprint(ctx, tx, taskIDs, to, now); err != nil {
+		return err
+	}
+	for _, taskID := range taskIDs {
+		if err := s.history.Add(ctx, tx, repomysql.TaskHistoryRecord{
+			ID:            uuid.New(),
+			TaskID:        taskID,
+			ChangedBy:     userID,
+			Event:         string(api.Updated),
+			Changes:       map[string]interface{}{"sprint_id": map[string]interface{}{"from": from, "to": to}},
+			SchemaVersion: tasks.HistorySchemaVersion,
+			ChangedAt:     now,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lockMember блокирует спринт и проверяет членство пользователя в его команде.
+func (s *Service) lockMember(ctx context.Context, tx *sql.Tx, userID uuid.UUID, sprintID uuid.UUID) (repomysql.SprintRecord, error) {
+	record, err := s.sprints.GetForUpdate(ctx, tx, sprintID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repomysql.SprintRecord{}, ErrNotFound
+		}
+		return repomysql.SprintRecord{}, err
+	}
+	if _, err := s.role(ctx, record.TeamID, userID); err != nil {
+		return repomysql.SprintRecord{}, err
+	}
+	return record, nil
+}
+
+// lockManaged блокирует спринт и проверяет право управлять спринтами команды.
+func (s *Service) lockManaged(ctx context.Context, tx *sql.Tx, userID uuid.UUID, sprintID uuid.UUID) (repomysql.SprintRecord, error) {
+	record, err := s.sprints.GetForUpdate(ctx, tx, sprintID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repomysql.SprintRecord{}, ErrNotFound
+		}
+		return repomysql.SprintRecord{}, err
+	}
+	if err := s.checkManager(ctx, record.TeamID, userID); err != nil {
+		return repomysql.SprintRecord{}, err
+	}
+	return record, nil
+}
+
+// checkManager проверяет, что пользователь — owner или admin команды.
+func (s *Service) checkManager(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
+	role, err := s.role(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if role != "owner" && role != "admin" {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// role возвращает роль участника команды; для не участника возвращается ErrForbidden.
+func (s *Service) role(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (string, error) {
+	role, ok, err := s.members.GetRole(ctx, teamID, userID)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrForbidden
+	}
+	return role, nil
+}
+
+// invalidateTeam сбрасывает кеш списков задач команды. Ошибка кеша не отменяет изменение.
+func (s *Service) invalidateTeam(ctx context.Context, methodCtx string, teamID uuid.UUID) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.InvalidateTeam(ctx, teamID); err != nil {
+		slog.Warn("ошибка сброса кеша задач",
+			slog.String("context", methodCtx),
+			slog.String("team_id", teamID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func sprintToAPI(record repomysql.SprintRecord) api.Sprint {
+	return api.Sprint{
+		Id:        record.ID,
+		TeamId:    record.TeamID,
+		Name:      record.Name,
+		Goal:      record.Goal,
+		StartDate: openapi_types.Date{Time: record.StartDate},
+		EndDate:   openapi_types.Date{Time: record.EndDate},
+		State:     api.SprintState(record.State),
+		CreatedBy: record.CreatedBy,
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+		ClosedAt:  record.ClosedAt,
+	}
+}
@@ -0,0 +1,221 @@
+package sprints
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+type SprintsSuite struct {
+	tests.IntegrationSuite
+	service    *Service
+	tasksRepo  *repomysql.TasksRepo
+	ownerID    uuid.UUID
+	memberID   uuid.UUID
+	outsiderID uuid.UUID
+	teamID     uuid.UUID
+}
+
+func TestSprintsSuite(t *testing.T) {
+	const methodCtx = "sprints.TestSprintsSuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(SprintsSuite))
+}
+
+func (s *SprintsSuite) SetupTest() {
+	const methodCtx = "sprints.SprintsSuite.SetupTest"
+
+	s.TruncateTables(
+		"sprints",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-sprints@example.com")
+	s.memberID = s.CreateUser("member-sprints@example.com")
+	s.outsiderID = s.CreateUser("outsider-sprints@example.com")
+
+	s.teamID = s.CreateTeam("Sprints Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.memberID, "member")
+
+	s.tasksRepo = repomysql.NewTasksRepo(s.DB)
+	service, err := NewService(
+		s.DB,
+		repomysql.NewSprintsRepo(s.DB),
+		s.tasksRepo,
+		repomysql.NewTaskHistoryRepo(s.DB),
+		repomysql.NewTeamMembersRepo(s.DB),
+		nil,
+	)
+	s.Require().NoError(err, methodCtx)
+	s.service = service
+}
+
+func (s *SprintsSuite) TestCreateAndStart() {
+	const methodCtx = "sprints.SprintsSuite.TestCreateAndStart"
+
+	ctx := context.Background()
+
+	_, err := s.service.Create(ctx, s.memberID, s.teamID, s.sprintRequest("Sprint 1", 0))
+	s.ErrorIs(err, ErrForbidden, "участник без прав не создает спринты")
+
+	invalid := s.sprintRequest("Sprint 1", 0)
+	invalid.EndDate = openapi_types.Date{Time: invalid.StartDate.AddDate(0, 0, -1)}
+	_, err = s.service.Create(ctx, s.ownerID, s.teamID, invalid)
+	s.ErrorIs(err, ErrInvalidDates, methodCtx)
+
+	first, err := s.service.Create(ctx, s.ownerID, s.teamID, s.sprintRequest(" Sprint 1 ", 0))
+	s.Require().NoError(err, methodCtx)
+	s.Equal("Sprint 1", first.Name)
+	s.Equal(api.Planned, first.State)
+
+	second, err := s.service.Create(ctx, s.ownerID, s.teamID, s.sprintRequest("Sprint 2", 14))
+	s.Require().NoError(err, methodCtx)
+
+	started, err := s.service.Start(ctx, s.ownerID, first.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Active, started.State)
+
+	_, err = s.service.Start(ctx, s.ownerID, second.Id)
+	s.ErrorIs(err, ErrActiveExists, methodCtx)
+
+	_, err = s.service.Start(ctx, s.ownerID, first.Id)
+	s.ErrorIs(err, ErrInvalidState, methodCtx)
+
+	state := api.Planned
+	list, err := s.service.List(ctx, s.memberID, s.teamID, api.GetApiV1TeamsIdSprintsParams{State: &state})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(list.Items, 1, methodCtx)
+	s.Equal(second.Id, list.Items[0].Id)
+
+	_, err = s.service.Get(ctx, s.outsiderID, first.Id)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+}
+
+func (s *SprintsSuite) TestTasksAndClose() {
+	const methodCtx = "sprints.SprintsSuite.TestTasksAndClose"
+
+	ctx := context.Background()
+	current, err := s.service.Create(ctx, s.ownerID, s.teamID, s.sprintRequest("Current", 0))
+	s.Require().NoError(err, methodCtx)
+	next, err := s.service.Create(ctx, s.ownerID, s.teamID, s.sprintRequest("Next", 14))
+	s.Require().NoError(err, methodCtx)
+
+	open := s.CreateTask(s.teamID, s.memberID, nil, "in_progress", "open", "")
+	done := s.CreateTask(s.teamID, s.memberID, nil, "done", "done", "")
+	removed := s.CreateTask(s.teamID, s.memberID, nil, "todo", "removed", "")
+
+	otherTeam := s.CreateTeam("Other Team", s.outsiderID)
+	foreign := s.CreateTask(otherTeam, s.outsiderID, nil, "todo", "foreign", "")
+
+	err = s.service.AddTasks(ctx, s.memberID, current.Id, api.SprintTasksRequest{TaskIds: []api.UUID{foreign}})
+	s.ErrorIs(err, ErrInvalidTask, methodCtx)
+
+	err = s.service.AddTasks(ctx, s.memberID, current.Id, api.SprintTasksRequest{TaskIds: []api.UUID{open, done, removed, open}})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(&current.Id, s.taskSprint(open))
+
+	err = s.service.RemoveTask(ctx, s.memberID, current.Id, removed)
+	s.Require().NoError(err, methodCtx)
+	s.Nil(s.taskSprint(removed))
+
+	err = s.service.RemoveTask(ctx, s.memberID, current.Id, removed)
+	s.ErrorIs(err, ErrNotFound, "задача уже не в спринте")
+
+	_, err = s.service.Close(ctx, s.memberID, current.Id, api.CloseSprintRequest{})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Close(ctx, s.ownerID, current.Id, api.CloseSprintRequest{NextSprintId: &current.Id})
+	s.ErrorIs(err, ErrInvalidNext, methodCtx)
+
+	resp, err := s.service.Close(ctx, s.ownerID, current.Id, api.CloseSprintRequest{NextSprintId: &next.Id})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Closed, resp.Sprint.State)
+	s.NotNil(resp.Sprint.ClosedAt)
+	s.Equal([]api.UUID{open}, resp.MovedTaskIds)
+	s.Equal(&next.Id, s.taskSprint(open))
+	s.Equal(&current.Id, s.taskSprint(done), "завершенные задачи остаются в закрытом спринте")
+
+	var entries int
+	s.Require().NoError(s.DB.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM task_history WHERE task_id = ? AND JSON_CONTAINS_PATH(changes, 'one', '$.sprint_id')",
+		open.String(),
+	).Scan(&entries), methodCtx)
+	s.Equal(2, entries, "добавление и перенос при закрытии записываются в историю")
+
+	err = s.service.AddTasks(ctx, s.memberID, current.Id, api.SprintTasksRequest{TaskIds: []api.UUID{removed}})
+	s.ErrorIs(err, ErrSprintClosed, methodCtx)
+
+	_, err = s.service.Update(ctx, s.ownerID, current.Id, api.UpdateSprintRequest{Name: ptrString("Renamed")})
+	s.ErrorIs(err, ErrSprintClosed, methodCtx)
+
+	resp, err = s.service.Close(ctx, s.ownerID, next.Id, api.CloseSprintRequest{})
+	s.Require().NoError(err, methodCtx)
+	s.Equal([]api.UUID{open}, resp.MovedTaskIds)
+	s.Nil(s.taskSprint(open), "без следующего спринта задачи возвращаются в бэклог")
+}
+
+func (s *SprintsSuite) TestUpdate() {
+	const methodCtx = "sprints.SprintsSuite.TestUpdate"
+
+	ctx := context.Background()
+	sprint, err := s.service.Create(ctx, s.ownerID, s.teamID, s.sprintRequest("Sprint", 0))
+	s.Require().NoError(err, methodCtx)
+
+	goal := "Release"
+	updated, err := s.service.Update(ctx, s.ownerID, sprint.Id, api.UpdateSprintRequest{Name: ptrString("Sprint A"), Goal: &goal})
+	s.Require().NoError(err, methodCtx)
+	s.Equal("Sprint A", updated.Name)
+	s.Require().NotNil(updated.Goal)
+	s.Equal(goal, *updated.Goal)
+	s.NotNil(updated.UpdatedAt)
+
+	end := openapi_types.Date{Time: sprint.StartDate.AddDate(0, 0, -1)}
+	_, err = s.service.Update(ctx, s.ownerID, sprint.Id, api.UpdateSprintRequest{EndDate: &end})
+	s.ErrorIs(err, ErrInvalidDates, methodCtx)
+
+	_, err = s.service.Update(ctx, s.memberID, sprint.Id, api.UpdateSprintRequest{Goal: &goal})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Update(ctx, s.ownerID, uuid.New(), api.UpdateSprintRequest{Goal: &goal})
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *SprintsSuite) sprintRequest(name string, offsetDays int) api.CreateSprintRequest {
+	start := time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC).AddDate(0, 0, offsetDays)
+	return api.CreateSprintRequest{
+		Name:      name,
+		StartDate: openapi_types.Date{Time: start},
+		EndDate:   openapi_types.Date{Time: start.AddDate(0, 0, 13)},
+	}
+}
+
+func (s *SprintsSuite) taskSprint(taskID uuid.UUID) *uuid.UUID {
+	const methodCtx = "sprints.SprintsSuite.taskSprint"
+
+	tx, err := s.DB.BeginTx(context.Background(), nil)
+	s.Require().NoError(err, methodCtx)
+	defer func() { _ = tx.Rollback() }()
+
+	record, err := s.tasksRepo.GetForUpdate(context.Background(), tx, taskID)
+	s.Require().NoError(err, methodCtx)
+	return record.SprintID
+}
+
+func ptrString(value string) *string {
+	return &value
+}
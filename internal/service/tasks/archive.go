@@ -0,0 +1,108 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+const defaultArchiveBatchSize = 500
+
+// ArchivePoliciesRepository описывает чтение политик архивации команд.
+type ArchivePoliciesRepository interface {
+	ListArchivePolicies(ctx context.Context) ([]repomysql.TeamArchivePolicyRecord, error)
+}
+
+// ArchiveRepository описывает пакетную архивацию задач.
+type ArchiveRepository interface {
+	ArchiveDone(ctx context.Context, teamID uuid.UUID, completedBefore time.Time, archivedAt time.Time, limit int) (int, error)
+}
+
+// Archiver архивирует задачи, завершенные дольше срока из политики команды.
+type Archiver struct {
+	policies  ArchivePoliciesRepository
+	tasks     ArchiveRepository
+	cache     Cache
+	batchSize int
+	now       func() time.Time
+}
+
+// NewArchiver создает обработчик архивации. Нулевой batchSize заменяется значением по умолчанию.
+func NewArchiver(policies ArchivePoliciesRepository, tasks ArchiveRepository, cache Cache, batchSize int) (*Archiver, error) {
+	const methodCtx = "tasks.NewArchiver"
+
+	slog.Debug("инициализация архивации задач", slog.String("context", methodCtx))
+
+	if policies == nil {
+		return nil, fmt.Errorf("%s: policies repo не задан", methodCtx)
+	}
+	if tasks == nil {
+		return nil, fmt.Errorf("%s: tasks repo не задан", methodCtx)
+	}
+	if batchSize <= 0 {
+		batchSize = defaultArchiveBatchSize
+	}
+
+	return &Archiver{
+		policies:  policies,
+		tasks:     tasks,
+		cache:     cache,
+		batchSize: batchSize,
+		now:       time.Now,
+	}, nil
+}
+
+// Run архивирует завершенные задачи всех команд с включенной политикой. Вызывается фоновым обработчиком.
+// Задачи обновляются пачками по batchSize отдельными запросами, чтобы не держать долгие блокировки InnoDB.
+func (a *Archiver) Run(ctx context.Context) error {
+	const methodCtx = "tasks.Archiver.Run"
+
+	policies, err := a.policies.ListArchivePolicies(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	now := a.now().UTC().Truncate(time.Second)
+	for _, policy := range policies {
+		completedBefore := now.AddDate(0, 0, -policy.AfterDays)
+		total := 0
+		for {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("%s: %w", methodCtx, err)
+			}
+			archived, err := a.tasks.ArchiveDone(ctx, policy.TeamID, completedBefore, now, a.batchSize)
+			if err != nil {
+				return fmt.Errorf("%s: %w", methodCtx, err)
+			}
+			total += archived
+			if archived < a.batchSize {
+				break
+			}
+		}
+		if total == 0 {
+			continue
+		}
+
+		slog.Info("задачи заархивированы",
+			slog.String("context", methodCtx),
+			slog.String("team_id", policy.TeamID.String()),
+			slog.Int("count", total),
+		)
+		if a.cache != nil {
+			if err := a.cache.InvalidateTeam(ctx, policy.TeamID); err != nil {
+				slog.Warn("ошибка сброса кеша задач",
+					slog.String("context", methodCtx),
+					slog.String("team_id", policy.TeamID.String()),
+					slog.String("error", err.Error()),
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// LinksRepository описывает хранение связей между задачами.
+type LinksRepository interface {
+	Add(ctx context.Context, exec repomysql.DBTX, record repomysql.TaskLinkRecord) error
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]repomysql.TaskLinkRecord, error)
+}
+
+// AttachmentsRepository описывает доступ к метаданным вложений при клонировании.
+type AttachmentsRepository interface {
+	Create(ctx context.Context, exec repomysql.DBTX, record repomysql.AttachmentRecord) error
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]repomysql.AttachmentRecord, error)
+}
+
+// Clone создает копию задачи в ее команде или в другой команде пользователя.
+// Копия начинает в todo вне спринта; исполнители и наблюдатели, не состоящие в целевой команде,
+// не копируются. Скопированные вложения ссылаются на те же объекты хранилища.
+func (s *Service) Clone(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, req api.CloneTaskRequest) (api.Task, error) {
+	const methodCtx = "tasks.Service.Clone"
+
+	slog.Debug("вызов клонирования задачи", slog.String("context", methodCtx))
+
+	if req.Title != nil && strings.TrimSpace(*req.Title) == "" {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidTitle)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	source, err := s.tasks.GetForUpdate(ctx, tx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	targetTeamID := source.TeamID
+	if req.TeamId != nil {
+		targetTeamID = *req.TeamId
+	}
+	for _, teamID := range []uuid.UUID{source.TeamID, targetTeamID} {
+		member, err := s.members.IsMember(ctx, teamID, userID)
+		if err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if !member {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+		}
+	}
+
+	now := time.Now().UTC()
+	record := repomysql.TaskRecord{
+		ID:              uuid.New(),
+		TeamID:          targetTeamID,
+		Title:           source.Title,
+		Status:          string(api.Todo),
+		EstimateMinutes: source.EstimateMinutes,
+		StoryPoints:     source.StoryPoints,
+		DueAt:           source.DueAt,
+		CreatedBy:       userID,
+		CreatedAt:       now,
+		UpdatedAt:       &now,
+	}
+	if req.Title != nil {
+		record.Title = strings.TrimSpace(*req.Title)
+	}
+	if boolOption(req.CopyDescription, true) {
+		record.Description = source.Description
+	}
+
+	if boolOption(req.CopyAssignees, true) {
+		sourceAssignees, err := s.assignees.ListByTask(ctx, tx, taskID)
+		if err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		kept, err := s.filterMembers(ctx, targetTeamID, sourceAssignees)
+		if err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		var primary *uuid.UUID
+		if source.AssigneeID != nil && containsUUID(kept, *source.AssigneeID) {
+			primary = source.AssigneeID
+		}
+		record.AssigneeID, record.AssigneeIDs = mergeAssignees(primary, kept)
+	}
+
+	record.Rank, err = s.appendRank(ctx, tx, record.TeamID, record.Status)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.tasks.Create(ctx, tx, record); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if len(record.AssigneeIDs) > 0 {
+		if err := s.assignees.Replace(ctx, tx, record.ID, record.AssigneeID, record.AssigneeIDs, now); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	if err := s.history.Add(ctx, tx, repomysql.TaskHistoryRecord{
+		ID:            uuid.New(),
+		TaskID:        record.ID,
+		ChangedBy:     userID,
+		Event:         string(api.Created),
+		Changes:       taskSnapshot(record),
+		SchemaVersion: HistorySchemaVersion,
+		ChangedAt:     now,
+	}); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.watchers.Add(ctx, tx, record.ID, userID, now); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.watchAssignees(ctx, tx, record.ID, record.AssigneeIDs, now); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if boolOption(req.CopyWatchers, false) {
+		if err := s.cloneWatchers(ctx, tx, taskID, record.ID, targetTeamID, now); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+	if boolOption(req.CopyAttachments, false) {
+		if err := s.cloneAttachments(ctx, tx, taskID, record.ID, userID, now); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	if err := s.links.Add(ctx, tx, repomysql.TaskLinkRecord{
+		TaskID:       record.ID,
+		LinkedTaskID: taskID,
+		Type:         repomysql.TaskLinkRelatesTo,
+		CreatedBy:    userID,
+		CreatedAt:    now,
+	}); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	s.invalidateTeams(ctx, methodCtx, targetTeamID)
+
+	return TaskToAPI(record), nil
+}
+
+// Links возвращает связи задачи. Доступно участникам команды задачи.
+func (s *Service) Links(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.TaskLinksListResponse, error) {
+	const methodCtx = "tasks.Service.Links"
+
+	slog.Debug("вызов списка связей задачи", slog.String("context", methodCtx))
+
+	teamID, err := s.tasks.GetTeamID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.TaskLinksListResponse{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.TaskLinksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	member, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return api.TaskLinksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !member {
+		return api.TaskLinksListResponse{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	records, err := s.links.ListByTask(ctx, taskID)
+	if err != nil {
+		return api.TaskLinksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.TaskLink, 0, len(records))
+	for _, record := range records {
+		items = append(items, api.TaskLink{
+			TaskId:       record.TaskID,
+			LinkedTaskId: record.LinkedTaskID,
+			Type:         api.TaskLinkType(record.Type),
+			CreatedBy:    record.CreatedBy,
+			CreatedAt:    record.CreatedAt,
+		})
+	}
+
+	return api.TaskLinksListResponse{Items: items}, nil
+}
+
+// cloneWatchers подписывает на копию наблюдателей исходной задачи из целевой команды.
+func (s *Service) cloneWatchers(ctx context.Context, tx *sql.Tx, sourceID uuid.UUID, targetID uuid.UUID, teamID uuid.UUID, now time.Time) error {
+	watchers, err := s.watchers.ListByTask(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(watchers))
+	for _, watcher := range watchers {
+		userIDs = append(userIDs, watcher.UserID)
+	}
+	kept, err := s.filterMembers(ctx, teamID, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, watcherID := range kept {
+		if err := s.watchers.Add(ctx, tx, targetID, watcherID, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cloneAttachments копирует метаданные вложений задачи; вложения комментариев не копируются.
+func (s *Service) cloneAttachments(ctx context.Context, tx *sql.Tx, sourceID uuid.UUID, targetID uuid.UUID, userID uuid.UUID, now time.Time) error {
+	attachments, err := s.attachments.ListByTask(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range attachments {
+		if attachment.Target != repomysql.AttachmentTargetTask {
+			continue
+		}
+		attachment.ID = uuid.New()
+		attachment.TaskID = targetID
+		attachment.UploadedBy = userID
+		attachment.CreatedAt = now
+		if err := s.attachments.Create(ctx, tx, attachment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterMembers оставляет пользователей, состоящих в команде, сохраняя порядок.
+func (s *Service) filterMembers(ctx context.Context, teamID uuid.UUID, userIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var kept []uuid.UUID
+	for _, userID := range userIDs {
+		ok, err := s.members.IsMember(ctx, teamID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			kept = append(kept, userID)
+		}
+	}
+	return kept, nil
+}
+
+func boolOption(value *bool, fallback bool) bool {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
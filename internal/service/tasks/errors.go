@@ -3,8 +3,19 @@ package tasks
 import "errors"
 
 var (
-	ErrForbidden       = errors.New("доступ запрещен")
-	ErrNotFound        = errors.New("не найдено")
-	ErrInvalidAssignee = errors.New("исполнитель не состоит в команде")
-	ErrNotImplemented  = errors.New("не реализовано")
+	ErrForbidden            = errors.New("доступ запрещен")
+	ErrNotFound             = errors.New("не найдено")
+	ErrInvalidAssignee      = errors.New("исполнитель не состоит в команде")
+	ErrInvalidEstimate      = errors.New("оценка не может быть отрицательной")
+	ErrInvalidStoryPoints   = errors.New("story points не могут быть отрицательными")
+	ErrSameTeam             = errors.New("задача уже находится в этой команде")
+	ErrNotImplemented       = errors.New("не реализовано")
+	ErrRevertConflict       = errors.New("поле изменено после записи истории")
+	ErrRevertInvalid        = errors.New("запись истории нельзя откатить")
+	ErrInvalidHistoryFilter = errors.New("некорректный фильтр истории")
+	ErrTeamRequired         = errors.New("не задана команда")
+	ErrViewTeamMismatch     = errors.New("представление относится к другой команде")
+	ErrInvalidMove          = errors.New("некорректное перемещение задачи")
+	ErrInvalidTitle         = errors.New("заголовок не задан")
+	ErrAssigneeRequired     = errors.New("не задан исполнитель для переназначения")
 )
@@ -0,0 +1,93 @@
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// HistorySchemaVersion — текущая версия формата changes: {"<поле>": {"from": ..., "to": ...}}.
+const HistorySchemaVersion = 1
+
+// historyFields перечисляет поля, по которым можно фильтровать историю.
+var historyFields = map[api.TaskHistoryField]struct{}{
+	api.TaskHistoryFieldTitle:           {},
+	api.TaskHistoryFieldDescription:     {},
+	api.TaskHistoryFieldStatus:          {},
+	api.TaskHistoryFieldAssigneeId:      {},
+	api.TaskHistoryFieldAssigneeIds:     {},
+	api.TaskHistoryFieldEstimateMinutes: {},
+	api.TaskHistoryFieldStoryPoints:     {},
+	api.TaskHistoryFieldDueAt:           {},
+	api.TaskHistoryFieldTeamId:          {},
+	api.TaskHistoryFieldSprintId:        {},
+}
+
+// buildHistoryFilter проверяет параметры запроса истории и нормализует пагинацию.
+func buildHistoryFilter(taskID uuid.UUID, params api.GetApiV1TasksIdHistoryParams) (repomysql.TaskHistoryFilter, error) {
+	filter := repomysql.TaskHistoryFilter{
+		TaskID:    taskID,
+		ChangedBy: params.ChangedBy,
+		From:      params.From,
+		To:        params.To,
+	}
+
+	if params.Field != nil {
+		if _, ok := historyFields[*params.Field]; !ok {
+			return repomysql.TaskHistoryFilter{}, fmt.Errorf("%w: field", ErrInvalidHistoryFilter)
+		}
+		field := string(*params.Field)
+		filter.Field = &field
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return repomysql.TaskHistoryFilter{}, fmt.Errorf("%w: from >= to", ErrInvalidHistoryFilter)
+	}
+
+	var page, perPage int
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.PerPage != nil {
+		perPage = *params.PerPage
+	}
+	filter.Page, filter.PerPage = normalizePagination(page, perPage)
+
+	return filter, nil
+}
+
+// taskSnapshot описывает начальные значения полей задачи для события created.
+func taskSnapshot(record repomysql.TaskRecord) map[string]interface{} {
+	return map[string]interface{}{
+		"title":            map[string]interface{}{"from": nil, "to": record.Title},
+		"description":      map[string]interface{}{"from": nil, "to": record.Description},
+		"status":           map[string]interface{}{"from": nil, "to": record.Status},
+		"assignee_id":      map[string]interface{}{"from": nil, "to": record.AssigneeID},
+		"assignee_ids":     map[string]interface{}{"from": nil, "to": toAPIUUIDs(record.AssigneeIDs)},
+		"estimate_minutes": map[string]interface{}{"from": nil, "to": record.EstimateMinutes},
+		"story_points":     map[string]interface{}{"from": nil, "to": record.StoryPoints},
+		"due_at":           map[string]interface{}{"from": nil, "to": record.DueAt},
+	}
+}
+
+// HistoryToAPI преобразует запись истории задачи в модель API. Используется также выгрузкой задач.
+func HistoryToAPI(record repomysql.TaskHistoryRecord) api.TaskHistory {
+	changes := make(map[string]api.TaskFieldChange, len(record.Changes))
+	for field, value := range record.Changes {
+		change, _ := value.(map[string]interface{})
+		changes[field] = api.TaskFieldChange{From: change["from"], To: change["to"]}
+	}
+
+	return api.TaskHistory{
+		Id:            api.UUID(record.ID),
+		TaskId:        api.UUID(record.TaskID),
+		ChangedBy:     api.UUID(record.ChangedBy),
+		Event:         api.TaskHistoryEvent(record.Event),
+		Changes:       changes,
+		SchemaVersion: record.SchemaVersion,
+		RevertsId:     toAPUUIDPtr(record.RevertsID),
+		ChangedAt:     record.ChangedAt,
+	}
+}
@@ -0,0 +1,115 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// ListMine возвращает задачи, где пользователь назначен исполнителем или является автором,
+// во всех командах, в которых он состоит. Список не кешируется: кеш задач ведется по командам.
+func (s *Service) ListMine(ctx context.Context, userID uuid.UUID, params api.GetApiV1MeTasksParams) (api.MyTasksListResponse, error) {
+	const methodCtx = "tasks.Service.ListMine"
+
+	slog.Debug("вызов списка задач пользователя", slog.String("context", methodCtx))
+
+	var page, perPage int
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.PerPage != nil {
+		perPage = *params.PerPage
+	}
+	page, perPage = normalizePagination(page, perPage)
+
+	teams, err := s.teams.ListByUser(ctx, userID)
+	if err != nil {
+		return api.MyTasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	teamNames := make(map[uuid.UUID]string, len(teams))
+	teamIDs := make([]uuid.UUID, 0, len(teams))
+	for _, team := range teams {
+		teamNames[team.ID] = team.Name
+		teamIDs = append(teamIDs, team.ID)
+	}
+
+	filter := repomysql.UserTaskFilter{
+		UserID:   userID,
+		TeamIDs:  teamIDs,
+		Archived: string(api.Exclude),
+		Sort:     string(api.CreatedAtDesc),
+		Page:     page,
+		PerPage:  perPage,
+	}
+	if params.Status != nil {
+		status := string(*params.Status)
+		filter.Status = &status
+	}
+	if params.Archived != nil {
+		filter.Archived = string(*params.Archived)
+	}
+	if params.Sort != nil {
+		filter.Sort = string(*params.Sort)
+	}
+
+	records, err := s.tasks.ListByUser(ctx, filter)
+	if err != nil {
+		return api.MyTasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	taskIDs := make([]uuid.UUID, 0, len(records))
+	for _, record := range records {
+		taskIDs = append(taskIDs, record.ID)
+	}
+	assignees, err := s.assignees.ListByTasks(ctx, taskIDs)
+	if err != nil {
+		return api.MyTasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.MyTask, 0, len(records))
+	for _, record := range records {
+		record.AssigneeIDs = assignees[record.ID]
+		items = append(items, myTaskToAPI(record, teamNames[record.TeamID]))
+	}
+
+	total, err := s.tasks.CountByUser(ctx, filter)
+	if err != nil {
+		return api.MyTasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.MyTasksListResponse{
+		Items:   items,
+		Page:    page,
+		PerPage: perPage,
+		Total:   total,
+	}, nil
+}
+
+func myTaskToAPI(record repomysql.TaskRecord, teamName string) api.MyTask {
+	return api.MyTask{
+		Id:              record.ID,
+		TeamId:          record.TeamID,
+		TeamName:        teamName,
+		Title:           record.Title,
+		Description:     record.Description,
+		Status:          api.TaskStatus(record.Status),
+		Rank:            record.Rank,
+		SprintId:        toAPUUIDPtr(record.SprintID),
+		AssigneeId:      toAPUUIDPtr(record.AssigneeID),
+		AssigneeIds:     toAPIUUIDs(record.AssigneeIDs),
+		EstimateMinutes: record.EstimateMinutes,
+		StoryPoints:     record.StoryPoints,
+		DueAt:           record.DueAt,
+		CreatedBy:       record.CreatedBy,
+		CreatedAt:       record.CreatedAt,
+		UpdatedAt:       record.UpdatedAt,
+		CompletedAt:     record.CompletedAt,
+		ArchivedAt:      record.ArchivedAt,
+	}
+}
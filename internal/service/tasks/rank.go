@@ -0,0 +1,323 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// Ранги задач — строки из цифр base36 в стиле LexoRank: порядок задается побайтовым сравнением,
+// а между любыми двумя рангами, не оканчивающимися на "0", можно вставить новый.
+const (
+	rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	rankBase   = len(rankDigits)
+	// maxRankLength ограничивает рост рангов при частых вставках в одно место; при превышении колонка перебалансируется.
+	maxRankLength = 32
+	// rankSpacing — минимальный зазор между соседними рангами после перебалансировки.
+	rankSpacing = 1024
+	// rankMinWidth — минимальная ширина рангов после перебалансировки.
+	rankMinWidth = 6
+)
+
+// reorder перемещает задачу на доске ее команды: в другую колонку (status) и/или между соседями.
+// Соседи должны находиться в целевой колонке; если заданы оба, они должны идти подряд.
+// Смена колонки проверяется политикой редактирования и попадает в историю, смена позиции — нет.
+func (s *Service) reorder(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, req api.MoveTaskRequest) (api.Task, error) {
+	const methodCtx = "tasks.Service.reorder"
+
+	slog.Debug("вызов перемещения задачи на доске", slog.String("context", methodCtx))
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	current, err := s.tasks.GetForUpdate(ctx, tx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	member, err := s.members.IsMember(ctx, current.TeamID, userID)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !member {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	current.AssigneeIDs, err = s.assignees.ListByTask(ctx, tx, taskID)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	newStatus := current.Status
+	if req.Status != nil {
+		newStatus = string(*req.Status)
+	}
+
+	changes := map[string]interface{}{}
+	if newStatus != current.Status {
+		changes["status"] = map[string]interface{}{"from": current.Status, "to": newStatus}
+		if err := s.checkEditPolicy(ctx, current, userID, changes); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	column, err := s.tasks.ListColumn(ctx, tx, current.TeamID, newStatus)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	others := column[:0]
+	for _, item := range column {
+		if item.ID != current.ID {
+			others = append(others, item)
+		}
+	}
+
+	position, err := rankPosition(others, req.AfterId, req.BeforeId)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	rank, err := s.placeRank(ctx, tx, others, position)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	now := time.Now().UTC()
+	if newStatus != current.Status {
+		current.Status = newStatus
+		current.CompletedAt = nil
+		current.ArchivedAt = nil
+		if newStatus == string(api.Done) {
+			current.CompletedAt = &now
+		}
+	}
+	current.Rank = rank
+	current.UpdatedAt = &now
+
+	if err := s.tasks.Update(ctx, tx, current); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if len(changes) > 0 {
+		if err := s.history.Add(ctx, tx, repomysql.TaskHistoryRecord{
+			ID:            uuid.New(),
+			TaskID:        current.ID,
+			ChangedBy:     userID,
+			Event:         string(api.Updated),
+			Changes:       changes,
+			SchemaVersion: HistorySchemaVersion,
+			ChangedAt:     now,
+		}); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	s.invalidateTeams(ctx, methodCtx, current.TeamID)
+
+	return TaskToAPI(current), nil
+}
+
+// appendRank возвращает ранг для новой задачи в конце колонки.
+func (s *Service) appendRank(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, status string) (string, error) {
+	last, err := s.tasks.LastRank(ctx, tx, teamID, status)
+	if err != nil {
+		return "", err
+	}
+	if rank, ok := rankBetween(last, ""); ok {
+		return rank, nil
+	}
+
+	column, err := s.tasks.ListColumn(ctx, tx, teamID, status)
+	if err != nil {
+		return "", err
+	}
+	return s.placeRank(ctx, tx, column, len(column))
+}
+
+// placeRank возвращает ранг для вставки перед column[position]. Если между соседями нет места,
+// колонка перебалансируется: остальные задачи получают новые ранги с зазором для вставки.
+// Задачи без ранга (созданные до его появления) тоже приводят к перебалансировке.
+func (s *Service) placeRank(ctx context.Context, tx *sql.Tx, column []repomysql.TaskRankRecord, position int) (string, error) {
+	const methodCtx = "tasks.Service.placeRank"
+
+	var prev, next string
+	if position > 0 {
+		prev = column[position-1].Rank
+	}
+	if position < len(column) {
+		next = column[position].Rank
+	}
+	if position == len(column) || next != "" {
+		if rank, ok := rankBetween(prev, next); ok {
+			return rank, nil
+		}
+	}
+
+	slog.Info("перебалансировка рангов колонки", slog.String("context", methodCtx), slog.Int("count", len(column)))
+
+	ranks := spreadRanks(len(column) + 1)
+	for i, item := range column {
+		rank := ranks[i]
+		if i >= position {
+			rank = ranks[i+1]
+		}
+		if rank == item.Rank {
+			continue
+		}
+		if err := s.tasks.UpdateRank(ctx, tx, item.ID, rank); err != nil {
+			return "", err
+		}
+	}
+	return ranks[position], nil
+}
+
+// rankPosition возвращает позицию вставки в колонку по соседям. Без соседей задача встает в конец.
+func rankPosition(column []repomysql.TaskRankRecord, afterID *uuid.UUID, beforeID *uuid.UUID) (int, error) {
+	indexOf := func(id uuid.UUID) int {
+		for i, item := range column {
+			if item.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	switch {
+	case afterID != nil && beforeID != nil:
+		after, before := indexOf(*afterID), indexOf(*beforeID)
+		if after < 0 || before < 0 || after+1 != before {
+			return 0, ErrInvalidMove
+		}
+		return before, nil
+	case afterID != nil:
+		after := indexOf(*afterID)
+		if after < 0 {
+			return 0, ErrInvalidMove
+		}
+		return after + 1, nil
+	case beforeID != nil:
+		before := indexOf(*beforeID)
+		if before < 0 {
+			return 0, ErrInvalidMove
+		}
+		return before, nil
+	default:
+		return len(column), nil
+	}
+}
+
+// rankBetween возвращает ранг строго между prev и next. Пустой prev означает начало колонки,
+// пустой next — конец. Если места нет или ранг получается слишком длинным, ok == false.
+func rankBetween(prev string, next string) (string, bool) {
+	if next != "" && prev >= next {
+		return "", false
+	}
+	if !validRank(prev) || !validRank(next) {
+		return "", false
+	}
+
+	rank := rankMidpoint(prev, next)
+	if len(rank) > maxRankLength || rank <= prev || (next != "" && rank >= next) {
+		return "", false
+	}
+	return rank, true
+}
+
+// rankMidpoint вычисляет середину между prev и next, считая их дробной частью числа в base36.
+func rankMidpoint(prev string, next string) string {
+	if next != "" {
+		n := 0
+		for n < len(next) && rankDigitAt(prev, n) == next[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(prev) {
+				rest = prev[n:]
+			}
+			return next[:n] + rankMidpoint(rest, next[n:])
+		}
+	}
+
+	low := 0
+	if prev != "" {
+		low = strings.IndexByte(rankDigits, prev[0])
+	}
+	high := rankBase
+	if next != "" {
+		high = strings.IndexByte(rankDigits, next[0])
+	}
+	if high-low > 1 {
+		return string(rankDigits[(low+high)/2])
+	}
+	if next != "" && len(next) > 1 {
+		return next[:1]
+	}
+
+	rest := ""
+	if len(prev) > 1 {
+		rest = prev[1:]
+	}
+	return string(rankDigits[low]) + rankMidpoint(rest, "")
+}
+
+// spreadRanks возвращает n равномерно распределенных возрастающих рангов для перебалансировки колонки.
+func spreadRanks(n int) []string {
+	width := rankMinWidth
+	capacity := int64(1)
+	for i := 0; i < width; i++ {
+		capacity *= int64(rankBase)
+	}
+	for capacity/int64(n+1) < rankSpacing {
+		width++
+		capacity *= int64(rankBase)
+	}
+
+	step := capacity / int64(n+1)
+	ranks := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		value := strconv.FormatInt(step*int64(i), rankBase)
+		value = strings.Repeat("0", width-len(value)) + value
+		ranks = append(ranks, strings.TrimRight(value, "0"))
+	}
+	return ranks
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return rankDigits[0]
+}
+
+func validRank(rank string) bool {
+	if strings.HasSuffix(rank, "0") {
+		return false
+	}
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(rankDigits, rank[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package tasks
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankBetween(t *testing.T) {
+	const methodCtx = "tasks.TestRankBetween"
+
+	cases := []struct {
+		prev string
+		next string
+	}{
+		{"", ""},
+		{"", "1"},
+		{"a", "b"},
+		{"a", "a1"},
+		{"az", "b1"},
+		{"z", ""},
+		{"zzz", ""},
+		{"0001", "0002"},
+	}
+	for _, tc := range cases {
+		rank, ok := rankBetween(tc.prev, tc.next)
+		require.True(t, ok, "%s: %q..%q", methodCtx, tc.prev, tc.next)
+		require.True(t, validRank(rank), "%s: %q", methodCtx, rank)
+		require.Less(t, tc.prev, rank, methodCtx)
+		if tc.next != "" {
+			require.Less(t, rank, tc.next, methodCtx)
+		}
+	}
+
+	for _, tc := range []struct {
+		prev string
+		next string
+	}{
+		{"b", "a"},
+		{"a", "a"},
+		{"a0", "b"},
+		{"A", ""},
+	} {
+		_, ok := rankBetween(tc.prev, tc.next)
+		require.False(t, ok, "%s: %q..%q", methodCtx, tc.prev, tc.next)
+	}
+}
+
+func TestRankBetweenLimit(t *testing.T) {
+	const methodCtx = "tasks.TestRankBetweenLimit"
+
+	next := "i"
+	steps := 0
+	for {
+		rank, ok := rankBetween("", next)
+		if !ok {
+			break
+		}
+		require.LessOrEqual(t, len(rank), maxRankLength, methodCtx)
+		next = rank
+		steps++
+	}
+	require.Greater(t, steps, maxRankLength, "%s: места должно хватать на много вставок", methodCtx)
+	require.Less(t, steps, 8*maxRankLength, methodCtx)
+}
+
+func TestSpreadRanks(t *testing.T) {
+	const methodCtx = "tasks.TestSpreadRanks"
+
+	for _, n := range []int{1, 2, 10, 1000, 100000} {
+		ranks := spreadRanks(n)
+		require.Len(t, ranks, n, methodCtx)
+		require.True(t, sort.StringsAreSorted(ranks), methodCtx)
+		for i, rank := range ranks {
+			require.True(t, validRank(rank), "%s: %q", methodCtx, rank)
+			require.NotEmpty(t, rank, methodCtx)
+			if i > 0 {
+				require.NotEqual(t, ranks[i-1], rank, methodCtx)
+				_, ok := rankBetween(ranks[i-1], rank)
+				require.True(t, ok, "%s: между соседними рангами должно оставаться место", methodCtx)
+			}
+		}
+	}
+}
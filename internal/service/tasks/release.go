@@ -0,0 +1,175 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// OpenTasksByAssignee возвращает открытые задачи команды, где пользователь назначен исполнителем,
+// блокируя их в транзакции вызывающего.
+func (s *Service) OpenTasksByAssignee(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, userID uuid.UUID) ([]uuid.UUID, error) {
+	const methodCtx = "tasks.Service.OpenTasksByAssignee"
+
+	taskIDs, err := s.tasks.ListOpenByAssignee(ctx, tx, teamID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return taskIDs, nil
+}
+
+// ReleaseAssignee снимает пользователя с задачи в транзакции вызывающего и, если задан replacement,
+// назначает вместо него replacement. Если пользователь был основным исполнителем, основным становится
+// replacement или следующий соисполнитель. Изменение пишется в историю от имени actorID.
+// Возвращает false, если пользователь уже не назначен на задачу. Членство replacement в команде
+// проверяет вызывающий; кеш команды сбрасывается через InvalidateTeam после фиксации транзакции.
+func (s *Service) ReleaseAssignee(ctx context.Context, tx *sql.Tx, actorID uuid.UUID, taskID uuid.UUID, userID uuid.UUID, replacement *uuid.UUID) (bool, error) {
+	const methodCtx = "tasks.Service.ReleaseAssignee"
+
+	current, err := s.tasks.GetForUpdate(ctx, tx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	current.AssigneeIDs, err = s.assignees.ListByTask(ctx, tx, taskID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	wasPrimary := current.AssigneeID != nil && *current.AssigneeID == userID
+	if !wasPrimary && !containsUUID(current.AssigneeIDs, userID) {
+		return false, nil
+	}
+
+	var rest []uuid.UUID
+	for _, id := range current.AssigneeIDs {
+		if id != userID {
+			rest = append(rest, id)
+		}
+	}
+	primary := current.AssigneeID
+	if wasPrimary {
+		primary = replacement
+	} else if replacement != nil {
+		rest = append(rest, *replacement)
+	}
+	newAssignee, newAssignees := mergeAssignees(primary, rest)
+
+	changes := map[string]interface{}{}
+	if !uuidPtrEqual(newAssignee, current.AssigneeID) {
+		changes["assignee_id"] = map[string]interface{}{"from": current.AssigneeID, "to": newAssignee}
+	}
+	if !sameUUIDSet(newAssignees, current.AssigneeIDs) {
+		changes["assignee_ids"] = map[string]interface{}{"from": current.AssigneeIDs, "to": newAssignees}
+	}
+
+	now := time.Now().UTC()
+	current.AssigneeID = newAssignee
+	current.AssigneeIDs = newAssignees
+	current.UpdatedAt = &now
+
+	if err := s.tasks.Update(ctx, tx, current); err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.assignees.Replace(ctx, tx, current.ID, newAssignee, newAssignees, now); err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.history.Add(ctx, tx, repomysql.TaskHistoryRecord{
+		ID:            uuid.New(),
+		TaskID:        current.ID,
+		ChangedBy:     actorID,
+		Event:         string(api.Updated),
+		Changes:       changes,
+		SchemaVersion: HistorySchemaVersion,
+		ChangedAt:     now,
+	}); err != nil {
+		return false, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if replacement != nil {
+		if err := s.watchers.Add(ctx, tx, current.ID, *replacement, now); err != nil {
+			return false, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	return true, nil
+}
+
+// InvalidateTeam сбрасывает кеш списков задач команды после изменений, сделанных в чужой транзакции.
+func (s *Service) InvalidateTeam(ctx context.Context, teamID uuid.UUID) {
+	const methodCtx = "tasks.Service.InvalidateTeam"
+
+	s.invalidateTeams(ctx, methodCtx, teamID)
+}
+
+// RepairAssignees применяет стратегию ко всем задачам команды, где исполнитель не состоит в команде:
+// такие исполнители снимаются или заменяются участником из запроса. Доступно owner и admin.
+func (s *Service) RepairAssignees(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.RepairAssigneesRequest) (api.RepairAssigneesResult, error) {
+	const methodCtx = "tasks.Service.RepairAssignees"
+
+	slog.Debug("вызов исправления исполнителей", slog.String("context", methodCtx))
+
+	role, ok, err := s.members.GetRole(ctx, teamID, userID)
+	if err != nil {
+		return api.RepairAssigneesResult{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !ok || (role != "owner" && role != "admin") {
+		return api.RepairAssigneesResult{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	var replacement *uuid.UUID
+	if req.Strategy == api.RepairAssigneesRequestStrategyReassign {
+		if req.AssigneeId == nil {
+			return api.RepairAssigneesResult{}, fmt.Errorf("%s: %w", methodCtx, ErrAssigneeRequired)
+		}
+		if err := s.checkAssignees(ctx, teamID, []uuid.UUID{*req.AssigneeId}); err != nil {
+			return api.RepairAssigneesResult{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		replacement = req.AssigneeId
+	}
+
+	records, err := s.tasks.ListInvalidAssignees(ctx, teamID)
+	if err != nil {
+		return api.RepairAssigneesResult{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if len(records) == 0 {
+		return api.RepairAssigneesResult{TeamId: api.UUID(teamID)}, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.RepairAssigneesResult{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	updated := map[uuid.UUID]struct{}{}
+	for _, record := range records {
+		released, err := s.ReleaseAssignee(ctx, tx, userID, record.TaskID, record.AssigneeID, replacement)
+		if err != nil {
+			return api.RepairAssigneesResult{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if released {
+			updated[record.TaskID] = struct{}{}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.RepairAssigneesResult{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	s.invalidateTeams(ctx, methodCtx, teamID)
+
+	return api.RepairAssigneesResult{
+		TeamId:       api.UUID(teamID),
+		TasksUpdated: len(updated),
+	}, nil
+}
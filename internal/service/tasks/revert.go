@@ -0,0 +1,372 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// Revert откатывает изменение из истории: каждое поле записи возвращается к значению "from".
+// Если текущее значение поля отличается от "to", значит его изменила более поздняя правка,
+// и откат отклоняется с ErrRevertConflict. Создание задачи и перенос между командами не откатываются.
+func (s *Service) Revert(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, historyID uuid.UUID) (api.Task, error) {
+	const methodCtx = "tasks.Service.Revert"
+
+	slog.Debug("вызов отката изменения задачи", slog.String("context", methodCtx))
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	current, err := s.tasks.GetForUpdate(ctx, tx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	member, err := s.members.IsMember(ctx, current.TeamID, userID)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !member {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	entry, err := s.history.Get(ctx, tx, taskID, historyID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if entry.Event == string(api.Created) || len(entry.Changes) == 0 {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrRevertInvalid)
+	}
+
+	current.AssigneeIDs, err = s.assignees.ListByTask(ctx, tx, taskID)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	reverted := current
+	if err := applyInverse(&reverted, entry.Changes); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	changes := diffTasks(current, reverted)
+	if err := s.checkEditPolicy(ctx, current, userID, changes); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	assigneesChanged := changes["assignee_id"] != nil || changes["assignee_ids"] != nil
+	if assigneesChanged {
+		if err := s.checkAssignees(ctx, current.TeamID, reverted.AssigneeIDs); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	now := time.Now().UTC()
+	reverted.UpdatedAt = &now
+	if reverted.Status != current.Status {
+		reverted.CompletedAt = nil
+		reverted.ArchivedAt = nil
+		if reverted.Status == "done" {
+			reverted.CompletedAt = &now
+		}
+		reverted.Rank, err = s.appendRank(ctx, tx, reverted.TeamID, reverted.Status)
+		if err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	if err := s.tasks.Update(ctx, tx, reverted); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if assigneesChanged {
+		if err := s.assignees.Replace(ctx, tx, reverted.ID, reverted.AssigneeID, reverted.AssigneeIDs, now); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := s.watchAssignees(ctx, tx, reverted.ID, reverted.AssigneeIDs, now); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	revertsID := entry.ID
+	if err := s.history.Add(ctx, tx, repomysql.TaskHistoryRecord{
+		ID:            uuid.New(),
+		TaskID:        reverted.ID,
+		ChangedBy:     userID,
+		Event:         string(api.Reverted),
+		Changes:       changes,
+		SchemaVersion: HistorySchemaVersion,
+		RevertsID:     &revertsID,
+		ChangedAt:     now,
+	}); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	s.invalidateTeams(ctx, methodCtx, reverted.TeamID)
+
+	return TaskToAPI(reverted), nil
+}
+
+// applyInverse возвращает поля задачи к значениям "from", проверяя, что текущие значения равны "to".
+func applyInverse(task *repomysql.TaskRecord, changes map[string]interface{}) error {
+	fields := make([]string, 0, len(changes))
+	for field := range changes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		change, ok := changes[field].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+		}
+		from, to := change["from"], change["to"]
+
+		switch field {
+		case "title":
+			fromValue, err := historyString(from)
+			if err != nil || fromValue == nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			toValue, err := historyString(to)
+			if err != nil || toValue == nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			if task.Title != *toValue {
+				return fmt.Errorf("%w: %s", ErrRevertConflict, field)
+			}
+			task.Title = *fromValue
+		case "description":
+			fromValue, err := historyString(from)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			toValue, err := historyString(to)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			if !stringPtrEqual(task.Description, toValue) {
+				return fmt.Errorf("%w: %s", ErrRevertConflict, field)
+			}
+			task.Description = fromValue
+		case "status":
+			fromValue, err := historyString(from)
+			if err != nil || fromValue == nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			toValue, err := historyString(to)
+			if err != nil || toValue == nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			if task.Status != *toValue {
+				return fmt.Errorf("%w: %s", ErrRevertConflict, field)
+			}
+			task.Status = *fromValue
+		case "assignee_id":
+			fromValue, err := historyUUID(from)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			toValue, err := historyUUID(to)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			if !uuidPtrEqual(task.AssigneeID, toValue) {
+				return fmt.Errorf("%w: %s", ErrRevertConflict, field)
+			}
+			task.AssigneeID = fromValue
+			_, hasSet := changes["assignee_ids"]
+			if !hasSet && (fromValue == nil || !containsUUID(task.AssigneeIDs, *fromValue)) {
+				// Записи без assignee_ids меняли только основного исполнителя.
+				var rest []uuid.UUID
+				for _, id := range task.AssigneeIDs {
+					if toValue == nil || id != *toValue {
+						rest = append(rest, id)
+					}
+				}
+				task.AssigneeID, task.AssigneeIDs = mergeAssignees(fromValue, rest)
+			}
+		case "assignee_ids":
+			fromValue, err := historyUUIDs(from)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			toValue, err := historyUUIDs(to)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			if !sameUUIDSet(task.AssigneeIDs, toValue) {
+				return fmt.Errorf("%w: %s", ErrRevertConflict, field)
+			}
+			task.AssigneeIDs = fromValue
+		case "estimate_minutes":
+			fromValue, err := historyInt(from)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			toValue, err := historyInt(to)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			if !intPtrEqual(task.EstimateMinutes, toValue) {
+				return fmt.Errorf("%w: %s", ErrRevertConflict, field)
+			}
+			task.EstimateMinutes = fromValue
+		case "story_points":
+			fromValue, err := historyInt(from)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			toValue, err := historyInt(to)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			if !intPtrEqual(task.StoryPoints, toValue) {
+				return fmt.Errorf("%w: %s", ErrRevertConflict, field)
+			}
+			task.StoryPoints = fromValue
+		case "due_at":
+			fromValue, err := historyTime(from)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			toValue, err := historyTime(to)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+			}
+			if !timePtrEqual(task.DueAt, toValue) {
+				return fmt.Errorf("%w: %s", ErrRevertConflict, field)
+			}
+			task.DueAt = fromValue
+		default:
+			return fmt.Errorf("%w: %s", ErrRevertInvalid, field)
+		}
+	}
+
+	if _, ok := changes["assignee_ids"]; ok {
+		primary := task.AssigneeID
+		if primary != nil && !containsUUID(task.AssigneeIDs, *primary) {
+			primary = nil
+		}
+		task.AssigneeID, task.AssigneeIDs = mergeAssignees(primary, task.AssigneeIDs)
+	}
+
+	return nil
+}
+
+// diffTasks описывает изменения между двумя состояниями задачи в формате истории.
+func diffTasks(before repomysql.TaskRecord, after repomysql.TaskRecord) map[string]interface{} {
+	changes := map[string]interface{}{}
+	if before.Title != after.Title {
+		changes["title"] = map[string]interface{}{"from": before.Title, "to": after.Title}
+	}
+	if !stringPtrEqual(before.Description, after.Description) {
+		changes["description"] = map[string]interface{}{"from": before.Description, "to": after.Description}
+	}
+	if before.Status != after.Status {
+		changes["status"] = map[string]interface{}{"from": before.Status, "to": after.Status}
+	}
+	if !uuidPtrEqual(before.AssigneeID, after.AssigneeID) {
+		changes["assignee_id"] = map[string]interface{}{"from": before.AssigneeID, "to": after.AssigneeID}
+	}
+	if !sameUUIDSet(before.AssigneeIDs, after.AssigneeIDs) {
+		changes["assignee_ids"] = map[string]interface{}{"from": before.AssigneeIDs, "to": after.AssigneeIDs}
+	}
+	if !intPtrEqual(before.EstimateMinutes, after.EstimateMinutes) {
+		changes["estimate_minutes"] = map[string]interface{}{"from": before.EstimateMinutes, "to": after.EstimateMinutes}
+	}
+	if !intPtrEqual(before.StoryPoints, after.StoryPoints) {
+		changes["story_points"] = map[string]interface{}{"from": before.StoryPoints, "to": after.StoryPoints}
+	}
+	if !timePtrEqual(before.DueAt, after.DueAt) {
+		changes["due_at"] = map[string]interface{}{"from": before.DueAt, "to": after.DueAt}
+	}
+	return changes
+}
+
+func historyString(value interface{}) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("ожидается строка")
+	}
+	return &str, nil
+}
+
+func historyUUID(value interface{}) (*uuid.UUID, error) {
+	str, err := historyString(value)
+	if err != nil || str == nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(*str)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func historyUUIDs(value interface{}) ([]uuid.UUID, error) {
+	if value == nil {
+		return nil, nil
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("ожидается массив")
+	}
+	ids := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		id, err := historyUUID(item)
+		if err != nil || id == nil {
+			return nil, fmt.Errorf("ожидается uuid")
+		}
+		ids = append(ids, *id)
+	}
+	return ids, nil
+}
+
+func historyInt(value interface{}) (*int, error) {
+	if value == nil {
+		return nil, nil
+	}
+	number, ok := value.(float64)
+	if !ok {
+		return nil, fmt.Errorf("ожидается число")
+	}
+	result := int(number)
+	return &result, nil
+}
+
+func historyTime(value interface{}) (*time.Time, error) {
+	str, err := historyString(value)
+	if err != nil || str == nil {
+		return nil, err
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, *str)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
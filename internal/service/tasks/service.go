@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,11 +18,17 @@ import (
 
 // Service реализует бизнес-логику задач.
 type Service struct {
-	db      *sql.DB
-	tasks   TasksRepository
-	members MembersRepository
-	history HistoryRepository
-	cache   Cache
+	db          *sql.DB
+	tasks       TasksRepository
+	assignees   AssigneesRepository
+	members     MembersRepository
+	teams       TeamsRepository
+	history     HistoryRepository
+	watchers    WatchersRepository
+	views       ViewsRepository
+	links       LinksRepository
+	attachments AttachmentsRepository
+	cache       Cache
 }
 
 // TasksRepository описывает работу с задачами.
@@ -29,30 +36,66 @@ type TasksRepository interface {
 	Create(ctx context.Context, exec repomysql.DBTX, record repomysql.TaskRecord) error
 	List(ctx context.Context, filter repomysql.TaskFilter) ([]repomysql.TaskRecord, error)
 	Count(ctx context.Context, filter repomysql.TaskFilter) (int, error)
+	ListByUser(ctx context.Context, filter repomysql.UserTaskFilter) ([]repomysql.TaskRecord, error)
+	CountByUser(ctx context.Context, filter repomysql.UserTaskFilter) (int, error)
 	GetForUpdate(ctx context.Context, tx *sql.Tx, taskID uuid.UUID) (repomysql.TaskRecord, error)
 	Update(ctx context.Context, tx *sql.Tx, record repomysql.TaskRecord) error
+	Move(ctx context.Context, tx *sql.Tx, record repomysql.TaskRecord) error
 	GetTeamID(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error)
+	ListColumn(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, status string) ([]repomysql.TaskRankRecord, error)
+	LastRank(ctx context.Context, exec repomysql.DBTX, teamID uuid.UUID, status string) (string, error)
+	UpdateRank(ctx context.Context, tx *sql.Tx, taskID uuid.UUID, rank string) error
+	ListOpenByAssignee(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, userID uuid.UUID) ([]uuid.UUID, error)
+	ListInvalidAssignees(ctx context.Context, teamID uuid.UUID) ([]repomysql.InvalidAssigneeRecord, error)
+}
+
+// AssigneesRepository описывает работу с исполнителями задач.
+type AssigneesRepository interface {
+	Replace(ctx context.Context, exec repomysql.DBTX, taskID uuid.UUID, primaryID *uuid.UUID, userIDs []uuid.UUID, createdAt time.Time) error
+	ListByTask(ctx context.Context, exec repomysql.DBTX, taskID uuid.UUID) ([]uuid.UUID, error)
+	ListByTasks(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
 }
 
 // MembersRepository описывает доступ к участникам команды.
 type MembersRepository interface {
 	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
+	GetRole(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (string, bool, error)
+}
+
+// TeamsRepository описывает доступ к настройкам команды.
+type TeamsRepository interface {
+	GetTaskEditPolicy(ctx context.Context, teamID uuid.UUID) (string, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]repomysql.TeamRecord, error)
 }
 
 // HistoryRepository описывает доступ к истории задач.
 type HistoryRepository interface {
 	Add(ctx context.Context, exec repomysql.DBTX, record repomysql.TaskHistoryRecord) error
-	ListByTask(ctx context.Context, taskID uuid.UUID) ([]repomysql.TaskHistoryRecord, error)
+	List(ctx context.Context, filter repomysql.TaskHistoryFilter) ([]repomysql.TaskHistoryRecord, error)
+	Count(ctx context.Context, filter repomysql.TaskHistoryFilter) (int, error)
+	Get(ctx context.Context, exec repomysql.DBTX, taskID uuid.UUID, historyID uuid.UUID) (repomysql.TaskHistoryRecord, error)
+}
+
+// WatchersRepository описывает подписку пользователей на задачи.
+type WatchersRepository interface {
+	Add(ctx context.Context, exec repomysql.DBTX, taskID uuid.UUID, userID uuid.UUID, createdAt time.Time) error
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]repomysql.TaskWatcherRecord, error)
+}
+
+// ViewsRepository описывает доступ к сохраненным представлениям.
+type ViewsRepository interface {
+	Get(ctx context.Context, viewID uuid.UUID) (repomysql.TaskViewRecord, error)
 }
 
 // Cache описывает кэширование задач.
 type Cache interface {
 	GetTeamTasks(ctx context.Context, teamID uuid.UUID, key string) ([]api.Task, bool, error)
 	SetTeamTasks(ctx context.Context, teamID uuid.UUID, key string, tasks []api.Task) error
+	InvalidateTeam(ctx context.Context, teamID uuid.UUID) error
 }
 
 // NewService создает сервис задач.
-func NewService(db *sql.DB, tasks TasksRepository, members MembersRepository, history HistoryRepository, cache Cache) (*Service, error) {
+func NewService(db *sql.DB, tasks TasksRepository, assignees AssigneesRepository, members MembersRepository, teams TeamsRepository, history HistoryRepository, watchers WatchersRepository, views ViewsRepository, links LinksRepository, attachments AttachmentsRepository, cache Cache) (*Service, error) {
 	const methodCtx = "tasks.NewService"
 
 	slog.Debug("инициализация сервиса задач", slog.String("context", methodCtx))
@@ -63,14 +106,44 @@ func NewService(db *sql.DB, tasks TasksRepository, members MembersRepository, hi
 	if tasks == nil {
 		return nil, fmt.Errorf("%s: tasks repo не задан", methodCtx)
 	}
+	if assignees == nil {
+		return nil, fmt.Errorf("%s: assignees repo не задан", methodCtx)
+	}
 	if members == nil {
 		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
 	}
+	if teams == nil {
+		return nil, fmt.Errorf("%s: teams repo не задан", methodCtx)
+	}
 	if history == nil {
 		return nil, fmt.Errorf("%s: history repo не задан", methodCtx)
 	}
+	if watchers == nil {
+		return nil, fmt.Errorf("%s: watchers repo не задан", methodCtx)
+	}
+	if views == nil {
+		return nil, fmt.Errorf("%s: views repo не задан", methodCtx)
+	}
+	if links == nil {
+		return nil, fmt.Errorf("%s: links repo не задан", methodCtx)
+	}
+	if attachments == nil {
+		return nil, fmt.Errorf("%s: attachments repo не задан", methodCtx)
+	}
 
-	return &Service{db: db, tasks: tasks, members: members, history: history, cache: cache}, nil
+	return &Service{
+		db:          db,
+		tasks:       tasks,
+		assignees:   assignees,
+		members:     members,
+		teams:       teams,
+		history:     history,
+		watchers:    watchers,
+		views:       views,
+		links:       links,
+		attachments: attachments,
+		cache:       cache,
+	}, nil
 }
 
 // Create создает задачу.
@@ -80,7 +153,13 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, req api.CreateTa
 	slog.Debug("вызов создания задачи", slog.String("context", methodCtx))
 
 	if strings.TrimSpace(req.Title) == "" {
-		return api.Task{}, fmt.Errorf("%s: заголовок не задан", methodCtx)
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidTitle)
+	}
+	if req.EstimateMinutes != nil && *req.EstimateMinutes < 0 {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidEstimate)
+	}
+	if req.StoryPoints != nil && *req.StoryPoints < 0 {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidStoryPoints)
 	}
 
 	member, err := s.members.IsMember(ctx, req.TeamId, userID)
@@ -91,17 +170,13 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, req api.CreateTa
 		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
 	}
 
-	var assigneePtr *uuid.UUID
-	if req.AssigneeId != nil {
-		assigneeID := *req.AssigneeId
-		ok, err := s.members.IsMember(ctx, req.TeamId, assigneeID)
-		if err != nil {
-			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
-		}
-		if !ok {
-			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidAssignee)
-		}
-		assigneePtr = &assigneeID
+	var requested []uuid.UUID
+	if req.AssigneeIds != nil {
+		requested = *req.AssigneeIds
+	}
+	assigneePtr, assigneeIDs := mergeAssignees(req.AssigneeId, requested)
+	if err := s.checkAssignees(ctx, req.TeamId, assigneeIDs); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
 	status := api.TaskStatus("todo")
@@ -118,31 +193,147 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, req api.CreateTa
 	}
 
 	record := repomysql.TaskRecord{
-		ID:          taskID,
-		TeamID:      req.TeamId,
-		Title:       req.Title,
-		Description: req.Description,
-		Status:      string(status),
-		AssigneeID:  assigneePtr,
-		CreatedBy:   userID,
-		CreatedAt:   now,
-		UpdatedAt:   &now,
-		CompletedAt: completedAt,
-	}
-
-	if err := s.tasks.Create(ctx, nil, record); err != nil {
+		ID:              taskID,
+		TeamID:          req.TeamId,
+		Title:           req.Title,
+		Description:     req.Description,
+		Status:          string(status),
+		AssigneeID:      assigneePtr,
+		AssigneeIDs:     assigneeIDs,
+		EstimateMinutes: req.EstimateMinutes,
+		StoryPoints:     req.StoryPoints,
+		DueAt:           normalizeDue(req.DueAt),
+		CreatedBy:       userID,
+		CreatedAt:       now,
+		UpdatedAt:       &now,
+		CompletedAt:     completedAt,
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	record.Rank, err = s.appendRank(ctx, tx, record.TeamID, record.Status)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.tasks.Create(ctx, tx, record); err != nil {
 		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	return taskToAPI(record), nil
+	if len(assigneeIDs) > 0 {
+		if err := s.assignees.Replace(ctx, tx, taskID, assigneePtr, assigneeIDs, now); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	if err := s.history.Add(ctx, tx, repomysql.TaskHistoryRecord{
+		ID:            uuid.New(),
+		TaskID:        taskID,
+		ChangedBy:     userID,
+		Event:         string(api.Created),
+		Changes:       taskSnapshot(record),
+		SchemaVersion: HistorySchemaVersion,
+		ChangedAt:     now,
+	}); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.watchers.Add(ctx, tx, taskID, userID, now); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.watchAssignees(ctx, tx, taskID, assigneeIDs, now); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	s.invalidateTeams(ctx, methodCtx, record.TeamID)
+
+	return TaskToAPI(record), nil
+}
+
+// CreateBatch создает подготовленные задачи одной транзакцией: вместе с исполнителями,
+// событием created в истории и наблюдателями. Права пользователя и состав исполнителей
+// проверяет вызывающий код.
+func (s *Service) CreateBatch(ctx context.Context, records []repomysql.TaskRecord) error {
+	const methodCtx = "tasks.Service.CreateBatch"
+
+	slog.Debug("вызов пакетного создания задач", slog.String("context", methodCtx), slog.Int("count", len(records)))
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	teamIDs := make([]uuid.UUID, 0, 1)
+	for _, record := range records {
+		record.Rank, err = s.appendRank(ctx, tx, record.TeamID, record.Status)
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := s.tasks.Create(ctx, tx, record); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if len(record.AssigneeIDs) > 0 {
+			if err := s.assignees.Replace(ctx, tx, record.ID, record.AssigneeID, record.AssigneeIDs, record.CreatedAt); err != nil {
+				return fmt.Errorf("%s: %w", methodCtx, err)
+			}
+		}
+		if err := s.history.Add(ctx, tx, repomysql.TaskHistoryRecord{
+			ID:            uuid.New(),
+			TaskID:        record.ID,
+			ChangedBy:     record.CreatedBy,
+			Event:         string(api.Created),
+			Changes:       taskSnapshot(record),
+			SchemaVersion: HistorySchemaVersion,
+			ChangedAt:     record.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := s.watchers.Add(ctx, tx, record.ID, record.CreatedBy, record.CreatedAt); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := s.watchAssignees(ctx, tx, record.ID, record.AssigneeIDs, record.CreatedAt); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if !containsUUID(teamIDs, record.TeamID) {
+			teamIDs = append(teamIDs, record.TeamID)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	s.invalidateTeams(ctx, methodCtx, teamIDs...)
+
+	return nil
 }
 
 // List возвращает список задач с фильтрами и пагинацией.
-func (s *Service) List(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, status *api.TaskStatus, assigneeID *uuid.UUID, page int, perPage int) (api.TasksListResponse, error) {
+// Если задан view_id, фильтры и сортировка берутся из сохраненного представления.
+func (s *Service) List(ctx context.Context, userID uuid.UUID, params api.GetApiV1TasksParams) (api.TasksListResponse, error) {
 	const methodCtx = "tasks.Service.List"
 
 	slog.Debug("вызов списка задач", slog.String("context", methodCtx))
 
+	query, err := s.resolveListQuery(ctx, userID, params)
+	if err != nil {
+		return api.TasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	teamID := query.teamID
+
 	member, err := s.members.IsMember(ctx, teamID, userID)
 	if err != nil {
 		return api.TasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
@@ -151,13 +342,20 @@ func (s *Service) List(ctx context.Context, userID uuid.UUID, teamID uuid.UUID,
 		return api.TasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
 	}
 
+	var page, perPage int
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.PerPage != nil {
+		perPage = *params.PerPage
+	}
 	page, perPage = normalizePagination(page, perPage)
-	cacheKey := buildCacheKey(teamID, status, assigneeID, page, perPage)
+	cacheKey := buildCacheKey(query, page, perPage)
 
 	if s.cache != nil {
 		items, hit, err := s.cache.GetTeamTasks(ctx, teamID, cacheKey)
 		if err == nil && hit {
-			total, err := s.tasks.Count(ctx, buildFilter(teamID, status, assigneeID, page, perPage))
+			total, err := s.tasks.Count(ctx, buildFilter(query, page, perPage))
 			if err != nil {
 				return api.TasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
 			}
@@ -165,15 +363,25 @@ func (s *Service) List(ctx context.Context, userID uuid.UUID, teamID uuid.UUID,
 		}
 	}
 
-	filter := buildFilter(teamID, status, assigneeID, page, perPage)
+	filter := buildFilter(query, page, perPage)
 	records, err := s.tasks.List(ctx, filter)
 	if err != nil {
 		return api.TasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
+	taskIDs := make([]uuid.UUID, 0, len(records))
+	for _, record := range records {
+		taskIDs = append(taskIDs, record.ID)
+	}
+	assignees, err := s.assignees.ListByTasks(ctx, taskIDs)
+	if err != nil {
+		return api.TasksListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
 	items := make([]api.Task, 0, len(records))
 	for _, record := range records {
-		items = append(items, taskToAPI(record))
+		record.AssigneeIDs = assignees[record.ID]
+		items = append(items, TaskToAPI(record))
 	}
 
 	total, err := s.tasks.Count(ctx, filter)
@@ -213,8 +421,9 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, taskID uuid.UUID
 		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	if current.CreatedBy != userID {
-		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	current.AssigneeIDs, err = s.assignees.ListByTask(ctx, tx, taskID)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
 	newTitle := current.Title
@@ -232,23 +441,60 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, taskID uuid.UUID
 		newStatus = *req.Status
 	}
 
-	newAssignee := current.AssigneeID
+	newAssignee, newAssignees := current.AssigneeID, current.AssigneeIDs
+	var requested []uuid.UUID
+	switch {
+	case req.AssigneeIds != nil:
+		requested = *req.AssigneeIds
+		primary := req.AssigneeId
+		if primary == nil && current.AssigneeID != nil && containsUUID(requested, *current.AssigneeID) {
+			primary = current.AssigneeID
+		}
+		newAssignee, newAssignees = mergeAssignees(primary, requested)
+	case req.AssigneeId != nil:
+		// Старые клиенты меняют только основного исполнителя, соисполнители сохраняются.
+		var rest []uuid.UUID
+		for _, id := range current.AssigneeIDs {
+			if current.AssigneeID == nil || id != *current.AssigneeID {
+				rest = append(rest, id)
+			}
+		}
+		newAssignee, newAssignees = mergeAssignees(req.AssigneeId, rest)
+	}
 	if req.AssigneeId != nil {
-		assigneeUUID := *req.AssigneeId
-		ok, err := s.members.IsMember(ctx, current.TeamID, assigneeUUID)
-		if err != nil {
-			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		requested = append(requested, *req.AssigneeId)
+	}
+
+	newEstimate := current.EstimateMinutes
+	if req.EstimateMinutes != nil {
+		if *req.EstimateMinutes < 0 {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidEstimate)
 		}
-		if !ok {
-			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidAssignee)
+		newEstimate = req.EstimateMinutes
+	}
+
+	newPoints := current.StoryPoints
+	if req.StoryPoints != nil {
+		if *req.StoryPoints < 0 {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidStoryPoints)
 		}
-		newAssignee = &assigneeUUID
+		newPoints = req.StoryPoints
+	}
+
+	newDue := current.DueAt
+	if req.DueAt != nil {
+		newDue = normalizeDue(req.DueAt)
 	}
 
 	now := time.Now().UTC()
-	var completedAt *time.Time
-	if newStatus == "done" {
-		completedAt = &now
+	// Правка завершенной задачи без смены статуса сохраняет время завершения:
+	// по нему считаются отчеты и архивация.
+	completedAt := current.CompletedAt
+	if newStatus != api.TaskStatus(current.Status) {
+		completedAt = nil
+		if newStatus == api.Done {
+			completedAt = &now
+		}
 	}
 
 	changes := map[string]interface{}{}
@@ -261,44 +507,242 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, taskID uuid.UUID
 	if newStatus != api.TaskStatus(current.Status) {
 		changes["status"] = map[string]interface{}{"from": current.Status, "to": newStatus}
 	}
-	if !uuidPtrEqual(newAssignee, current.AssigneeID) {
+	primaryChanged := !uuidPtrEqual(newAssignee, current.AssigneeID)
+	if primaryChanged {
 		changes["assignee_id"] = map[string]interface{}{"from": current.AssigneeID, "to": newAssignee}
 	}
+	assigneesChanged := !sameUUIDSet(newAssignees, current.AssigneeIDs)
+	if assigneesChanged {
+		changes["assignee_ids"] = map[string]interface{}{"from": current.AssigneeIDs, "to": newAssignees}
+	}
+	if !intPtrEqual(newEstimate, current.EstimateMinutes) {
+		changes["estimate_minutes"] = map[string]interface{}{"from": current.EstimateMinutes, "to": newEstimate}
+	}
+	if !intPtrEqual(newPoints, current.StoryPoints) {
+		changes["story_points"] = map[string]interface{}{"from": current.StoryPoints, "to": newPoints}
+	}
+	if !timePtrEqual(newDue, current.DueAt) {
+		changes["due_at"] = map[string]interface{}{"from": current.DueAt, "to": newDue}
+	}
+
+	if err := s.checkEditPolicy(ctx, current, userID, changes); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.checkAssignees(ctx, current.TeamID, requested); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if newStatus != api.TaskStatus(current.Status) {
+		current.Rank, err = s.appendRank(ctx, tx, current.TeamID, string(newStatus))
+		if err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
 
 	current.Title = newTitle
 	current.Description = newDescription
 	current.Status = string(newStatus)
 	current.AssigneeID = newAssignee
+	current.AssigneeIDs = newAssignees
+	current.EstimateMinutes = newEstimate
+	current.StoryPoints = newPoints
+	current.DueAt = newDue
 	current.UpdatedAt = &now
 	current.CompletedAt = completedAt
+	if newStatus != api.Done {
+		// Возврат задачи в работу снимает ее с архива.
+		current.ArchivedAt = nil
+	}
 
 	if err := s.tasks.Update(ctx, tx, current); err != nil {
 		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
+	if primaryChanged || assigneesChanged {
+		if err := s.assignees.Replace(ctx, tx, current.ID, newAssignee, newAssignees, now); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	if err := s.history.Add(ctx, tx, repomysql.TaskHistoryRecord{
+		ID:            uuid.New(),
+		TaskID:        current.ID,
+		ChangedBy:     userID,
+		Event:         string(api.Updated),
+		Changes:       changes,
+		SchemaVersion: HistorySchemaVersion,
+		ChangedAt:     now,
+	}); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.watchAssignees(ctx, tx, current.ID, current.AssigneeIDs, now); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	s.invalidateTeams(ctx, methodCtx, current.TeamID)
+
+	return TaskToAPI(current), nil
+}
+
+// Move переносит задачу в другую команду. Пользователь должен состоять в обеих командах.
+// Исполнители, не состоящие в целевой команде, снимаются; исполнитель из запроса
+// проверяется по целевой команде и становится основным. Статусы общие для всех команд,
+// поэтому не переназначаются, а задача встает в конец своей колонки. Спринты принадлежат
+// команде, поэтому задача возвращается в бэклог.
+// Без team_id задача перемещается на доске своей команды, см. reorder.
+func (s *Service) Move(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, req api.MoveTaskRequest) (api.Task, error) {
+	const methodCtx = "tasks.Service.Move"
+
+	slog.Debug("вызов переноса задачи", slog.String("context", methodCtx))
+
+	if req.TeamId == nil {
+		task, err := s.reorder(ctx, userID, taskID, req)
+		if err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		return task, nil
+	}
+	if req.Status != nil || req.AfterId != nil || req.BeforeId != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidMove)
+	}
+	targetTeamID := *req.TeamId
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	current, err := s.tasks.GetForUpdate(ctx, tx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	for _, teamID := range []uuid.UUID{current.TeamID, targetTeamID} {
+		member, err := s.members.IsMember(ctx, teamID, userID)
+		if err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if !member {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+		}
+	}
+
+	if current.TeamID == targetTeamID {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, ErrSameTeam)
+	}
+
+	current.AssigneeIDs, err = s.assignees.ListByTask(ctx, tx, taskID)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if req.AssigneeId != nil {
+		if err := s.checkAssignees(ctx, targetTeamID, []uuid.UUID{*req.AssigneeId}); err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	var kept []uuid.UUID
+	for _, assigneeID := range current.AssigneeIDs {
+		ok, err := s.members.IsMember(ctx, targetTeamID, assigneeID)
+		if err != nil {
+			return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if ok {
+			kept = append(kept, assigneeID)
+		}
+	}
+
+	primary := req.AssigneeId
+	if primary == nil && current.AssigneeID != nil && containsUUID(kept, *current.AssigneeID) {
+		primary = current.AssigneeID
+	}
+	newAssignee, newAssignees := mergeAssignees(primary, kept)
+
+	now := time.Now().UTC()
+	sourceTeamID := current.TeamID
+
+	changes := map[string]interface{}{
+		"team_id": map[string]interface{}{"from": sourceTeamID, "to": targetTeamID},
+	}
+	if !uuidPtrEqual(newAssignee, current.AssigneeID) {
+		changes["assignee_id"] = map[string]interface{}{"from": current.AssigneeID, "to": newAssignee}
+	}
+	if !sameUUIDSet(newAssignees, current.AssigneeIDs) {
+		changes["assignee_ids"] = map[string]interface{}{"from": current.AssigneeIDs, "to": newAssignees}
+	}
+	if current.SprintID != nil {
+		changes["sprint_id"] = map[string]interface{}{"from": current.SprintID, "to": nil}
+	}
+	if err := s.checkEditPolicy(ctx, current, userID, changes); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	current.Rank, err = s.appendRank(ctx, tx, targetTeamID, current.Status)
+	if err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	current.TeamID = targetTeamID
+	current.SprintID = nil
+	current.AssigneeID = newAssignee
+	current.AssigneeIDs = newAssignees
+	current.UpdatedAt = &now
+
+	if err := s.tasks.Move(ctx, tx, current); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.assignees.Replace(ctx, tx, current.ID, newAssignee, newAssignees, now); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
 	if err := s.history.Add(ctx, tx, repomysql.TaskHistoryRecord{
-		ID:        uuid.New(),
-		TaskID:    current.ID,
-		ChangedBy: userID,
-		Changes:   changes,
-		ChangedAt: now,
+		ID:            uuid.New(),
+		TaskID:        current.ID,
+		ChangedBy:     userID,
+		Event:         string(api.Moved),
+		Changes:       changes,
+		SchemaVersion: HistorySchemaVersion,
+		ChangedAt:     now,
 	}); err != nil {
 		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
+	if err := s.watchAssignees(ctx, tx, current.ID, current.AssigneeIDs, now); err != nil {
+		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return api.Task{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	return taskToAPI(current), nil
+	s.invalidateTeams(ctx, methodCtx, sourceTeamID, targetTeamID)
+
+	return TaskToAPI(current), nil
 }
 
-// History возвращает историю изменений задачи.
-func (s *Service) History(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.TaskHistoryListResponse, error) {
+// History возвращает страницу истории изменений задачи по фильтрам.
+func (s *Service) History(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, params api.GetApiV1TasksIdHistoryParams) (api.TaskHistoryListResponse, error) {
 	const methodCtx = "tasks.Service.History"
 
 	slog.Debug("вызов истории задачи", slog.String("context", methodCtx))
 
+	filter, err := buildHistoryFilter(taskID, params)
+	if err != nil {
+		return api.TaskHistoryListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
 	teamID, err := s.tasks.GetTeamID(ctx, taskID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -315,59 +759,147 @@ func (s *Service) History(ctx context.Context, userID uuid.UUID, taskID uuid.UUI
 		return api.TaskHistoryListResponse{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
 	}
 
-	records, err := s.history.ListByTask(ctx, taskID)
+	records, err := s.history.List(ctx, filter)
+	if err != nil {
+		return api.TaskHistoryListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	total, err := s.history.Count(ctx, filter)
 	if err != nil {
 		return api.TaskHistoryListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
 	items := make([]api.TaskHistory, 0, len(records))
 	for _, record := range records {
-		items = append(items, api.TaskHistory{
-			Id:        api.UUID(record.ID),
-			TaskId:    api.UUID(record.TaskID),
-			ChangedBy: api.UUID(record.ChangedBy),
-			Changes:   record.Changes,
-			ChangedAt: record.ChangedAt,
-		})
+		items = append(items, HistoryToAPI(record))
+	}
+
+	return api.TaskHistoryListResponse{
+		Items:   items,
+		Page:    filter.Page,
+		PerPage: filter.PerPage,
+		Total:   total,
+	}, nil
+}
+
+// checkEditPolicy проверяет право изменить перечисленные поля задачи.
+// Изменять задачу могут только участники ее команды: создатель, покинувший команду, прав не сохраняет.
+// Создатель, owner и admin меняют все поля; права любого из исполнителей задает политика команды.
+func (s *Service) checkEditPolicy(ctx context.Context, task repomysql.TaskRecord, userID uuid.UUID, changes map[string]interface{}) error {
+	role, ok, err := s.members.GetRole(ctx, task.TeamID, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrForbidden
+	}
+	if task.CreatedBy == userID || role == "owner" || role == "admin" {
+		return nil
+	}
+
+	if !containsUUID(task.AssigneeIDs, userID) {
+		return ErrForbidden
+	}
+
+	policy, err := s.teams.GetTaskEditPolicy(ctx, task.TeamID)
+	if err != nil {
+		return err
+	}
+
+	switch api.TaskEditPolicy(policy) {
+	case api.AssigneeFull:
+		return nil
+	case api.AssigneeStatus:
+		for field := range changes {
+			if field != "status" {
+				return ErrForbidden
+			}
+		}
+		return nil
+	default:
+		return ErrForbidden
 	}
+}
 
-	return api.TaskHistoryListResponse{Items: items}, nil
+// checkAssignees проверяет, что все исполнители состоят в команде.
+func (s *Service) checkAssignees(ctx context.Context, teamID uuid.UUID, assigneeIDs []uuid.UUID) error {
+	for _, assigneeID := range assigneeIDs {
+		ok, err := s.members.IsMember(ctx, teamID, assigneeID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrInvalidAssignee
+		}
+	}
+	return nil
 }
 
-func buildFilter(teamID uuid.UUID, status *api.TaskStatus, assigneeID *uuid.UUID, page int, perPage int) repomysql.TaskFilter {
-	var statusPtr *string
-	if status != nil {
-		value := string(*status)
-		statusPtr = &value
+func (s *Service) watchAssignees(ctx context.Context, exec repomysql.DBTX, taskID uuid.UUID, assigneeIDs []uuid.UUID, now time.Time) error {
+	for _, assigneeID := range assigneeIDs {
+		if err := s.watchers.Add(ctx, exec, taskID, assigneeID, now); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// invalidateTeams сбрасывает кеш списков задач. Ошибка кеша не отменяет изменение:
+// устаревшие записи истекут по TTL.
+func (s *Service) invalidateTeams(ctx context.Context, methodCtx string, teamIDs ...uuid.UUID) {
+	if s.cache == nil {
+		return
+	}
+	for _, teamID := range teamIDs {
+		if err := s.cache.InvalidateTeam(ctx, teamID); err != nil {
+			slog.Warn("ошибка сброса кеша задач",
+				slog.String("context", methodCtx),
+				slog.String("team_id", teamID.String()),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
 
-	var assigneePtr *uuid.UUID
-	if assigneeID != nil {
-		value := *assigneeID
-		assigneePtr = &value
+func buildFilter(query listQuery, page int, perPage int) repomysql.TaskFilter {
+	var statusPtr *string
+	if query.status != nil {
+		value := string(*query.status)
+		statusPtr = &value
 	}
 
 	return repomysql.TaskFilter{
-		TeamID:     teamID,
-		Status:     statusPtr,
-		AssigneeID: assigneePtr,
-		Page:       page,
-		PerPage:    perPage,
+		TeamID:      query.teamID,
+		Status:      statusPtr,
+		AssigneeIDs: query.assigneeIDs,
+		SprintID:    query.sprintID,
+		Archived:    string(query.archived),
+		Sort:        string(query.sort),
+		Page:        page,
+		PerPage:     perPage,
 	}
 }
 
-func taskToAPI(record repomysql.TaskRecord) api.Task {
+// TaskToAPI преобразует запись задачи в модель API. Используется также выгрузкой задач.
+func TaskToAPI(record repomysql.TaskRecord) api.Task {
 	return api.Task{
-		Id:          record.ID,
-		TeamId:      record.TeamID,
-		Title:       record.Title,
-		Description: record.Description,
-		Status:      api.TaskStatus(record.Status),
-		AssigneeId:  toAPUUIDPtr(record.AssigneeID),
-		CreatedBy:   record.CreatedBy,
-		CreatedAt:   record.CreatedAt,
-		UpdatedAt:   record.UpdatedAt,
-		CompletedAt: record.CompletedAt,
+		Id:              record.ID,
+		TeamId:          record.TeamID,
+		Title:           record.Title,
+		Description:     record.Description,
+		Status:          api.TaskStatus(record.Status),
+		Rank:            record.Rank,
+		SprintId:        toAPUUIDPtr(record.SprintID),
+		AssigneeId:      toAPUUIDPtr(record.AssigneeID),
+		AssigneeIds:     toAPIUUIDs(record.AssigneeIDs),
+		EstimateMinutes: record.EstimateMinutes,
+		StoryPoints:     record.StoryPoints,
+		DueAt:           record.DueAt,
+		CreatedBy:       record.CreatedBy,
+		CreatedAt:       record.CreatedAt,
+		UpdatedAt:       record.UpdatedAt,
+		CompletedAt:     record.CompletedAt,
+		ArchivedAt:      record.ArchivedAt,
 	}
 }
 
@@ -379,6 +911,14 @@ func toAPUUIDPtr(id *uuid.UUID) *api.UUID {
 	return &tmp
 }
 
+func toAPIUUIDs(ids []uuid.UUID) []api.UUID {
+	items := make([]api.UUID, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, api.UUID(id))
+	}
+	return items
+}
+
 func normalizePagination(page int, perPage int) (int, int) {
 	if page <= 0 {
 		page = 1
@@ -392,24 +932,73 @@ func normalizePagination(page int, perPage int) (int, int) {
 	return page, perPage
 }
 
-func buildCacheKey(teamID uuid.UUID, status *api.TaskStatus, assigneeID *uuid.UUID, page int, perPage int) string {
+func buildCacheKey(query listQuery, page int, perPage int) string {
 	statusValue := ""
-	if status != nil {
-		statusValue = string(*status)
+	if query.status != nil {
+		statusValue = string(*query.status)
+	}
+	assigneeValues := make([]string, 0, len(query.assigneeIDs))
+	for _, assigneeID := range query.assigneeIDs {
+		assigneeValues = append(assigneeValues, assigneeID.String())
 	}
-	assigneeValue := ""
-	if assigneeID != nil {
-		assigneeValue = assigneeID.String()
+	sort.Strings(assigneeValues)
+	assigneeValue := strings.Join(assigneeValues, ",")
+	sprintValue := ""
+	if query.sprintID != nil {
+		sprintValue = query.sprintID.String()
 	}
-	return fmt.Sprintf("tasks:%s:status=%s:assignee=%s:page=%d:per=%d",
-		teamID.String(),
+	return fmt.Sprintf("tasks:%s:status=%s:assignee=%s:sprint=%s:archived=%s:sort=%s:page=%d:per=%d",
+		query.teamID.String(),
 		statusValue,
 		assigneeValue,
+		sprintValue,
+		query.archived,
+		query.sort,
 		page,
 		perPage,
 	)
 }
 
+// mergeAssignees объединяет основного исполнителя и список без повторов.
+// Без явного основного исполнителя им становится первый из списка.
+func mergeAssignees(primary *uuid.UUID, ids []uuid.UUID) (*uuid.UUID, []uuid.UUID) {
+	var merged []uuid.UUID
+	if primary != nil {
+		merged = append(merged, *primary)
+	}
+	for _, id := range ids {
+		if !containsUUID(merged, id) {
+			merged = append(merged, id)
+		}
+	}
+	if len(merged) == 0 {
+		return nil, nil
+	}
+	first := merged[0]
+	return &first, merged
+}
+
+func containsUUID(ids []uuid.UUID, target uuid.UUID) bool {
+	for _, id := range ids {
+		if id == target {
+			return true
+		}
+	}
+	return false
+}
+
+func sameUUIDSet(a []uuid.UUID, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !containsUUID(b, id) {
+			return false
+		}
+	}
+	return true
+}
+
 func stringPtrEqual(a *string, b *string) bool {
 	if a == nil && b == nil {
 		return true
@@ -429,3 +1018,32 @@ func uuidPtrEqual(a *uuid.UUID, b *uuid.UUID) bool {
 	}
 	return *a == *b
 }
+
+func intPtrEqual(a *int, b *int) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return *a == *b
+}
+
+func timePtrEqual(a *time.Time, b *time.Time) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Equal(*b)
+}
+
+// normalizeDue приводит срок к UTC с точностью до секунды, как он хранится в DATETIME.
+func normalizeDue(due *time.Time) *time.Time {
+	if due == nil {
+		return nil
+	}
+	value := due.UTC().Truncate(time.Second)
+	return &value
+}
@@ -35,6 +35,12 @@ func (s *TasksSuite) SetupTest() {
 	const methodCtx = "tasks.TasksSuite.SetupTest"
 
 	s.TruncateTables(
+		"task_links",
+		"task_views",
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
 		"task_comments",
 		"task_history",
 		"tasks",
@@ -54,9 +60,15 @@ func (s *TasksSuite) SetupTest() {
 
 	s.cache = &cacheSpy{}
 	tasksRepo := repomysql.NewTasksRepo(s.DB)
+	assigneesRepo := repomysql.NewTaskAssigneesRepo(s.DB)
 	membersRepo := repomysql.NewTeamMembersRepo(s.DB)
+	teamsRepo := repomysql.NewTeamsRepo(s.DB)
 	historyRepo := repomysql.NewTaskHistoryRepo(s.DB)
-	service, err := NewService(s.DB, tasksRepo, membersRepo, historyRepo, s.cache)
+	watchersRepo := repomysql.NewTaskWatchersRepo(s.DB)
+	viewsRepo := repomysql.NewTaskViewsRepo(s.DB)
+	linksRepo := repomysql.NewTaskLinksRepo(s.DB)
+	attachmentsRepo := repomysql.NewTaskAttachmentsRepo(s.DB)
+	service, err := NewService(s.DB, tasksRepo, assigneesRepo, membersRepo, teamsRepo, historyRepo, watchersRepo, viewsRepo, linksRepo, attachmentsRepo, s.cache)
 	s.Require().NoError(err, methodCtx)
 	s.service = service
 }
@@ -131,6 +143,32 @@ func (s *TasksSuite) TestCreateTaskWithAssignee() {
 	s.Equal(s.memberID, *resp.AssigneeId)
 }
 
+func (s *TasksSuite) TestCreateTaskSubscribesCreatorAndAssignee() {
+	const methodCtx = "tasks.TasksSuite.TestCreateTaskSubscribesCreatorAndAssignee"
+
+	ctx := context.Background()
+	assignee := api.UUID(s.memberID)
+	req := api.CreateTaskRequest{
+		TeamId:     s.teamID,
+		Title:      "Watched",
+		AssigneeId: &assignee,
+	}
+
+	resp, err := s.service.Create(ctx, s.ownerID, req)
+	s.Require().NoError(err, methodCtx)
+
+	var count int
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM task_watchers WHERE task_id = ? AND user_id IN (?, ?)",
+		resp.Id.String(),
+		s.ownerID.String(),
+		s.memberID.String(),
+	).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(2, count)
+}
+
 func (s *TasksSuite) TestListTasksFilters() {
 	const methodCtx = "tasks.TasksSuite.TestListTasksFilters"
 
@@ -142,12 +180,60 @@ func (s *TasksSuite) TestListTasksFilters() {
 	s.CreateTask(s.teamID, s.ownerID, nil, "todo", "todo-2", "")
 
 	status := api.TaskStatus("todo")
-	resp, err := s.service.List(ctx, s.memberID, s.teamID, &status, &assignee, 1, 10)
+	resp, err := s.service.List(ctx, s.memberID, listParams(s.teamID, &status, []uuid.UUID{assignee}))
 	s.Require().NoError(err, methodCtx)
 	s.Len(resp.Items, 1)
 	s.Equal("todo-1", resp.Items[0].Title)
 }
 
+func (s *TasksSuite) TestMultipleAssignees() {
+	const methodCtx = "tasks.TasksSuite.TestMultipleAssignees"
+
+	ctx := context.Background()
+	reviewerID := s.CreateUser("reviewer-task@example.com")
+	s.AddTeamMember(s.teamID, reviewerID, "member")
+
+	assigneeIDs := []api.UUID{s.memberID, reviewerID}
+	created, err := s.service.Create(ctx, s.ownerID, api.CreateTaskRequest{
+		TeamId:      s.teamID,
+		Title:       "pair",
+		AssigneeIds: &assigneeIDs,
+	})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(created.AssigneeId)
+	s.Equal(s.memberID, *created.AssigneeId)
+	s.ElementsMatch([]uuid.UUID{s.memberID, reviewerID}, created.AssigneeIds)
+
+	s.CreateTask(s.teamID, s.ownerID, &s.ownerID, "todo", "solo", "")
+
+	resp, err := s.service.List(ctx, s.memberID, listParams(s.teamID, nil, []uuid.UUID{reviewerID, s.ownerID}))
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 2)
+
+	resp, err = s.service.List(ctx, s.memberID, listParams(s.teamID, nil, []uuid.UUID{reviewerID}))
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 1)
+	s.ElementsMatch([]uuid.UUID{s.memberID, reviewerID}, resp.Items[0].AssigneeIds)
+
+	updated, err := s.service.Update(ctx, s.ownerID, created.Id, api.UpdateTaskRequest{AssigneeId: &s.ownerID})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(s.ownerID, *updated.AssigneeId)
+	s.ElementsMatch([]uuid.UUID{s.ownerID, reviewerID}, updated.AssigneeIds)
+
+	outsiders := []api.UUID{reviewerID, s.outsiderID}
+	_, err = s.service.Update(ctx, s.ownerID, created.Id, api.UpdateTaskRequest{AssigneeIds: &outsiders})
+	s.ErrorIs(err, ErrInvalidAssignee, methodCtx)
+
+	status := api.TaskStatus("in_progress")
+	_, err = s.service.Update(ctx, reviewerID, created.Id, api.UpdateTaskRequest{Status: &status})
+	s.Require().NoError(err, methodCtx)
+
+	cleared, err := s.service.Update(ctx, s.ownerID, created.Id, api.UpdateTaskRequest{AssigneeIds: &[]api.UUID{}})
+	s.Require().NoError(err, methodCtx)
+	s.Nil(cleared.AssigneeId)
+	s.Empty(cleared.AssigneeIds)
+}
+
 func (s *TasksSuite) TestListTasksUsesCache() {
 	const methodCtx = "tasks.TasksSuite.TestListTasksUsesCache"
 
@@ -156,7 +242,7 @@ func (s *TasksSuite) TestListTasksUsesCache() {
 	s.CreateTask(s.teamID, s.ownerID, nil, "todo", "cached-1", "")
 	s.CreateTask(s.teamID, s.ownerID, nil, "todo", "cached-2", "")
 
-	resp, err := s.service.List(ctx, s.memberID, s.teamID, nil, nil, 1, 10)
+	resp, err := s.service.List(ctx, s.memberID, listParams(s.teamID, nil, nil))
 	s.Require().NoError(err, methodCtx)
 	s.Require().Greater(len(resp.Items), 0, methodCtx)
 
@@ -167,7 +253,7 @@ func (s *TasksSuite) TestListTasksUsesCache() {
 	s.cache.hit = true
 	s.cache.data = []api.Task{resp.Items[0]}
 
-	respCached, err := s.service.List(ctx, s.memberID, s.teamID, nil, nil, 1, 10)
+	respCached, err := s.service.List(ctx, s.memberID, listParams(s.teamID, nil, nil))
 	s.Require().NoError(err, methodCtx)
 	s.Len(respCached.Items, 1)
 	s.Equal(resp.Items[0].Title, respCached.Items[0].Title)
@@ -184,12 +270,12 @@ func (s *TasksSuite) TestListTasksCacheKeyIncludesFilters() {
 	s.CreateTask(s.teamID, s.ownerID, nil, "done", "done-1", "")
 
 	statusTodo := api.TaskStatus("todo")
-	_, err := s.service.List(ctx, s.memberID, s.teamID, &statusTodo, nil, 1, 10)
+	_, err := s.service.List(ctx, s.memberID, listParams(s.teamID, &statusTodo, nil))
 	s.Require().NoError(err, methodCtx)
 	keyTodo := s.cache.lastKey
 
 	statusDone := api.TaskStatus("done")
-	_, err = s.service.List(ctx, s.memberID, s.teamID, &statusDone, nil, 1, 10)
+	_, err = s.service.List(ctx, s.memberID, listParams(s.teamID, &statusDone, nil))
 	s.Require().NoError(err, methodCtx)
 	keyDone := s.cache.lastKey
 
@@ -198,13 +284,128 @@ func (s *TasksSuite) TestListTasksCacheKeyIncludesFilters() {
 	s.NotEqual(keyTodo, keyDone)
 }
 
+func (s *TasksSuite) TestListTasksByView() {
+	const methodCtx = "tasks.TasksSuite.TestListTasksByView"
+
+	ctx := context.Background()
+	assignee := s.memberID
+
+	s.CreateTask(s.teamID, s.ownerID, &assignee, "todo", "b-todo", "")
+	s.CreateTask(s.teamID, s.ownerID, &assignee, "todo", "a-todo", "")
+	s.CreateTask(s.teamID, s.ownerID, &assignee, "done", "done", "")
+	s.CreateTask(s.teamID, s.ownerID, nil, "todo", "unassigned", "")
+
+	views := repomysql.NewTaskViewsRepo(s.DB)
+	status := "todo"
+	privateView := repomysql.TaskViewRecord{
+		ID:          uuid.New(),
+		OwnerID:     s.ownerID,
+		TeamID:      s.teamID,
+		Name:        "My todo",
+		Status:      &status,
+		AssigneeIDs: []uuid.UUID{assignee},
+		Sort:        string(api.TitleAsc),
+		CreatedAt:   time.Now().UTC(),
+	}
+	s.Require().NoError(views.Create(ctx, privateView), methodCtx)
+
+	resp, err := s.service.List(ctx, s.ownerID, api.GetApiV1TasksParams{ViewId: &privateView.ID})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 2)
+	s.Equal("a-todo", resp.Items[0].Title)
+	s.Equal("b-todo", resp.Items[1].Title)
+	viewKey := s.cache.lastKey
+
+	statusTodo := api.Todo
+	sortTitle := api.TitleAsc
+	_, err = s.service.List(ctx, s.ownerID, api.GetApiV1TasksParams{
+		TeamId:     &s.teamID,
+		Status:     &statusTodo,
+		AssigneeId: &[]uuid.UUID{assignee},
+		Sort:       &sortTitle,
+	})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(viewKey, s.cache.lastKey, "view and equivalent query share the cache key")
+
+	statusDone := api.Done
+	resp, err = s.service.List(ctx, s.ownerID, api.GetApiV1TasksParams{ViewId: &privateView.ID, Status: &statusDone})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 1)
+	s.Equal("done", resp.Items[0].Title)
+
+	_, err = s.service.List(ctx, s.memberID, api.GetApiV1TasksParams{ViewId: &privateView.ID})
+	s.ErrorIs(err, ErrNotFound)
+
+	otherTeamID := s.CreateTeam("Other", s.ownerID)
+	_, err = s.service.List(ctx, s.ownerID, api.GetApiV1TasksParams{ViewId: &privateView.ID, TeamId: &otherTeamID})
+	s.ErrorIs(err, ErrViewTeamMismatch)
+
+	_, err = s.service.List(ctx, s.ownerID, api.GetApiV1TasksParams{})
+	s.ErrorIs(err, ErrTeamRequired)
+}
+
+func (s *TasksSuite) TestListMine() {
+	const methodCtx = "tasks.TasksSuite.TestListMine"
+
+	ctx := context.Background()
+	otherTeam := s.CreateTeam("Second Team", s.ownerID)
+	s.AddTeamMember(otherTeam, s.ownerID, "owner")
+	s.AddTeamMember(otherTeam, s.memberID, "member")
+	leftTeam := s.CreateTeam("Left Team", s.ownerID)
+	s.AddTeamMember(leftTeam, s.ownerID, "owner")
+
+	assigned := s.CreateTask(s.teamID, s.ownerID, &s.memberID, "todo", "assigned", "")
+	created := s.CreateTask(otherTeam, s.memberID, nil, "in_progress", "created", "")
+	coAssigned := s.CreateTask(otherTeam, s.ownerID, &s.ownerID, "done", "co-assigned", "")
+	_, err := s.DB.ExecContext(ctx,
+		"INSERT INTO task_assignees (task_id, user_id, is_primary, created_at) VALUES (?, ?, 0, ?)",
+		coAssigned.String(), s.memberID.String(), time.Now().UTC(),
+	)
+	s.Require().NoError(err, methodCtx)
+	s.CreateTask(s.teamID, s.ownerID, &s.ownerID, "todo", "foreign", "")
+	s.CreateTask(leftTeam, s.ownerID, &s.memberID, "todo", "left team", "")
+
+	list, err := s.service.ListMine(ctx, s.memberID, api.GetApiV1MeTasksParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(3, list.Total)
+	ids := make([]uuid.UUID, 0, len(list.Items))
+	names := map[uuid.UUID]string{}
+	for _, item := range list.Items {
+		ids = append(ids, item.Id)
+		names[item.Id] = item.TeamName
+	}
+	s.ElementsMatch([]uuid.UUID{assigned, created, coAssigned}, ids, "задачи других пользователей и чужих команд не попадают в список")
+	s.Equal("Task Team", names[assigned])
+	s.Equal("Second Team", names[coAssigned])
+
+	status := api.TaskStatus("done")
+	list, err = s.service.ListMine(ctx, s.memberID, api.GetApiV1MeTasksParams{Status: &status})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(list.Items, 1, methodCtx)
+	s.Equal(coAssigned, list.Items[0].Id)
+	s.ElementsMatch([]uuid.UUID{s.ownerID, s.memberID}, list.Items[0].AssigneeIds)
+
+	sortTitle := api.TaskSort("title_asc")
+	page, perPage := 2, 2
+	list, err = s.service.ListMine(ctx, s.memberID, api.GetApiV1MeTasksParams{Sort: &sortTitle, Page: &page, PerPage: &perPage})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(3, list.Total)
+	s.Require().Len(list.Items, 1, methodCtx)
+	s.Equal("created", list.Items[0].Title)
+
+	list, err = s.service.ListMine(ctx, s.outsiderID, api.GetApiV1MeTasksParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Empty(list.Items)
+	s.Equal(0, list.Total)
+}
+
 func (s *TasksSuite) TestListTasksForbidden() {
 	const methodCtx = "tasks.TasksSuite.TestListTasksForbidden"
 
 	ctx := context.Background()
 
 	status := api.TaskStatus("todo")
-	_, err := s.service.List(ctx, s.outsiderID, s.teamID, &status, nil, 1, 10)
+	_, err := s.service.List(ctx, s.outsiderID, listParams(s.teamID, &status, nil))
 	s.Require().Error(err, methodCtx)
 	s.ErrorIs(err, ErrForbidden)
 }
@@ -239,6 +440,431 @@ func (s *TasksSuite) TestUpdateTaskAndHistory() {
 	s.WithinDuration(time.Now().UTC(), completedAt.Time, time.Minute)
 }
 
+func (s *TasksSuite) TestUpdateTaskSubscribesAssignee() {
+	const methodCtx = "tasks.TasksSuite.TestUpdateTaskSubscribesAssignee"
+
+	ctx := context.Background()
+	taskID := s.CreateTask(s.teamID, s.memberID, nil, "todo", "task", "")
+
+	assignee := api.UUID(s.ownerID)
+	_, err := s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{AssigneeId: &assignee})
+	s.Require().NoError(err, methodCtx)
+
+	var count int
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM task_watchers WHERE task_id = ? AND user_id = ?",
+		taskID.String(),
+		s.ownerID.String(),
+	).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(1, count)
+}
+
+func (s *TasksSuite) TestTaskEstimate() {
+	const methodCtx = "tasks.TasksSuite.TestTaskEstimate"
+
+	ctx := context.Background()
+	estimate := 120
+	created, err := s.service.Create(ctx, s.memberID, api.CreateTaskRequest{
+		TeamId:          api.UUID(s.teamID),
+		Title:           "estimated",
+		EstimateMinutes: &estimate,
+	})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(created.EstimateMinutes)
+	s.Equal(120, *created.EstimateMinutes)
+
+	negative := -1
+	_, err = s.service.Update(ctx, s.memberID, created.Id, api.UpdateTaskRequest{EstimateMinutes: &negative})
+	s.ErrorIs(err, ErrInvalidEstimate, methodCtx)
+
+	updatedEstimate := 90
+	updated, err := s.service.Update(ctx, s.memberID, created.Id, api.UpdateTaskRequest{EstimateMinutes: &updatedEstimate})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(updated.EstimateMinutes)
+	s.Equal(90, *updated.EstimateMinutes)
+
+	history, err := s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(history.Items, 2)
+	for _, item := range history.Items {
+		s.Require().Contains(item.Changes, "estimate_minutes")
+		if item.Event == api.Created {
+			s.Nil(item.Changes["estimate_minutes"].From)
+			s.EqualValues(120, item.Changes["estimate_minutes"].To)
+		} else {
+			s.Equal(api.Updated, item.Event)
+			s.EqualValues(90, item.Changes["estimate_minutes"].To)
+		}
+	}
+}
+
+func (s *TasksSuite) TestTaskStoryPoints() {
+	const methodCtx = "tasks.TasksSuite.TestTaskStoryPoints"
+
+	ctx := context.Background()
+	points := 3
+	created, err := s.service.Create(ctx, s.memberID, api.CreateTaskRequest{
+		TeamId:      api.UUID(s.teamID),
+		Title:       "pointed",
+		StoryPoints: &points,
+	})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(created.StoryPoints)
+	s.Equal(3, *created.StoryPoints)
+
+	negative := -1
+	_, err = s.service.Update(ctx, s.memberID, created.Id, api.UpdateTaskRequest{StoryPoints: &negative})
+	s.ErrorIs(err, ErrInvalidStoryPoints, methodCtx)
+
+	updatedPoints := 5
+	updated, err := s.service.Update(ctx, s.memberID, created.Id, api.UpdateTaskRequest{StoryPoints: &updatedPoints})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(updated.StoryPoints)
+	s.Equal(5, *updated.StoryPoints)
+
+	field := api.TaskHistoryFieldStoryPoints
+	history, err := s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{Field: &field})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(history.Items, 2)
+
+	var entryID api.UUID
+	for _, item := range history.Items {
+		if item.Event == api.Updated {
+			s.EqualValues(3, item.Changes["story_points"].From)
+			s.EqualValues(5, item.Changes["story_points"].To)
+			entryID = item.Id
+		}
+	}
+
+	reverted, err := s.service.Revert(ctx, s.memberID, created.Id, entryID)
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(reverted.StoryPoints)
+	s.Equal(3, *reverted.StoryPoints)
+}
+
+func (s *TasksSuite) TestTaskDueAt() {
+	const methodCtx = "tasks.TasksSuite.TestTaskDueAt"
+
+	ctx := context.Background()
+	due := time.Date(2025, time.March, 10, 18, 30, 15, 500, time.FixedZone("MSK", 3*60*60))
+	created, err := s.service.Create(ctx, s.memberID, api.CreateTaskRequest{
+		TeamId: api.UUID(s.teamID),
+		Title:  "deadline",
+		DueAt:  &due,
+	})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(created.DueAt)
+	s.True(time.Date(2025, time.March, 10, 15, 30, 15, 0, time.UTC).Equal(*created.DueAt), "срок хранится с точностью до секунды")
+	s.Equal(time.UTC, created.DueAt.Location())
+
+	moved := due.Add(48 * time.Hour)
+	updated, err := s.service.Update(ctx, s.memberID, created.Id, api.UpdateTaskRequest{DueAt: &moved})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(updated.DueAt)
+	s.True(moved.Truncate(time.Second).Equal(*updated.DueAt))
+
+	field := api.TaskHistoryFieldDueAt
+	history, err := s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{Field: &field})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(history.Items, 2)
+
+	var entryID api.UUID
+	for _, item := range history.Items {
+		if item.Event == api.Updated {
+			entryID = item.Id
+		}
+	}
+
+	reverted, err := s.service.Revert(ctx, s.memberID, created.Id, entryID)
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(reverted.DueAt)
+	s.True(created.DueAt.Equal(*reverted.DueAt))
+}
+
+func (s *TasksSuite) TestArchiveDoneTasks() {
+	const methodCtx = "tasks.TasksSuite.TestArchiveDoneTasks"
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	old := make([]uuid.UUID, 0, 3)
+	for i := 0; i < 3; i++ {
+		taskID := s.CreateTask(s.teamID, s.memberID, nil, "done", "old", "")
+		s.setCompletedAt(taskID, now.AddDate(0, 0, -40))
+		old = append(old, taskID)
+	}
+	recent := s.CreateTask(s.teamID, s.memberID, nil, "done", "recent", "")
+	s.setCompletedAt(recent, now.AddDate(0, 0, -5))
+	open := s.CreateTask(s.teamID, s.memberID, nil, "todo", "open", "")
+
+	otherTeam := s.CreateTeam("No Policy Team", s.ownerID)
+	foreign := s.CreateTask(otherTeam, s.ownerID, nil, "done", "foreign", "")
+	s.setCompletedAt(foreign, now.AddDate(0, 0, -400))
+
+	_, err := s.DB.ExecContext(ctx, "UPDATE teams SET archive_after_days = 30 WHERE id = ?", s.teamID.String())
+	s.Require().NoError(err, methodCtx)
+
+	teamsRepo := repomysql.NewTeamsRepo(s.DB)
+	archiver, err := NewArchiver(teamsRepo, repomysql.NewTasksRepo(s.DB), s.cache, 2)
+	s.Require().NoError(err, methodCtx)
+	s.Require().NoError(archiver.Run(ctx), methodCtx)
+	s.Equal([]uuid.UUID{s.teamID}, s.cache.invalidated)
+
+	list, err := s.service.List(ctx, s.memberID, listParams(s.teamID, nil, nil))
+	s.Require().NoError(err, methodCtx)
+	s.ElementsMatch([]uuid.UUID{recent, open}, taskIDs(list.Items), "архивные задачи скрыты по умолчанию")
+
+	archived := api.Only
+	params := listParams(s.teamID, nil, nil)
+	params.Archived = &archived
+	list, err = s.service.List(ctx, s.memberID, params)
+	s.Require().NoError(err, methodCtx)
+	s.ElementsMatch(old, taskIDs(list.Items), "три задачи заархивированы за две пачки")
+	s.NotNil(list.Items[0].ArchivedAt)
+
+	included := api.Include
+	params.Archived = &included
+	list, err = s.service.List(ctx, s.memberID, params)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(5, list.Total)
+
+	reopen := api.TaskStatus("todo")
+	updated, err := s.service.Update(ctx, s.memberID, old[0], api.UpdateTaskRequest{Status: &reopen})
+	s.Require().NoError(err, methodCtx)
+	s.Nil(updated.ArchivedAt, "возврат в работу снимает задачу с архива")
+
+	var foreignArchived sql.NullTime
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT archived_at FROM tasks WHERE id = ?", foreign.String()).Scan(&foreignArchived), methodCtx)
+	s.False(foreignArchived.Valid, "команды без политики не архивируются")
+}
+
+func (s *TasksSuite) TestCloneTask() {
+	const methodCtx = "tasks.TasksSuite.TestCloneTask"
+
+	ctx := context.Background()
+	points := 5
+	done := api.Done
+	source, err := s.service.Create(ctx, s.memberID, api.CreateTaskRequest{
+		TeamId:      api.UUID(s.teamID),
+		Title:       "Source",
+		Description: ptrString("details"),
+		AssigneeId:  &s.memberID,
+		StoryPoints: &points,
+		Status:      &done,
+	})
+	s.Require().NoError(err, methodCtx)
+
+	watcherID := s.CreateUser("watcher-clone@example.com")
+	s.AddTeamMember(s.teamID, watcherID, "member")
+	_, err = s.DB.ExecContext(ctx, "INSERT INTO task_watchers (task_id, user_id, created_at) VALUES (?, ?, NOW())", source.Id.String(), watcherID.String())
+	s.Require().NoError(err, methodCtx)
+	_, err = s.DB.ExecContext(ctx,
+		`INSERT INTO task_attachments (id, task_id, target, uploaded_by, file_name, content_type, size_bytes, storage_key, created_at)
+		VALUES (?, ?, 'task', ?, 'a.txt', 'text/plain', 4, 'attachments/shared', NOW())`,
+		uuid.New().String(), source.Id.String(), s.memberID.String(),
+	)
+	s.Require().NoError(err, methodCtx)
+
+	clone, err := s.service.Clone(ctx, s.ownerID, source.Id, api.CloneTaskRequest{
+		CopyWatchers:    ptrBool(true),
+		CopyAttachments: ptrBool(true),
+	})
+	s.Require().NoError(err, methodCtx)
+	s.NotEqual(source.Id, clone.Id)
+	s.Equal("Source", clone.Title)
+	s.Equal(source.Description, clone.Description)
+	s.Equal(api.Todo, clone.Status, "копия начинает с todo")
+	s.Nil(clone.CompletedAt)
+	s.Equal(&s.memberID, clone.AssigneeId)
+	s.Equal(&points, clone.StoryPoints)
+	s.Equal(s.ownerID, clone.CreatedBy)
+
+	var watchers, attachments int
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM task_watchers WHERE task_id = ? AND user_id = ?", clone.Id.String(), watcherID.String()).Scan(&watchers), methodCtx)
+	s.Equal(1, watchers)
+	s.Require().NoError(s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM task_attachments WHERE task_id = ? AND storage_key = 'attachments/shared'", clone.Id.String()).Scan(&attachments), methodCtx)
+	s.Equal(1, attachments)
+
+	links, err := s.service.Links(ctx, s.memberID, source.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(links.Items, 1)
+	s.Equal(clone.Id, links.Items[0].TaskId)
+	s.Equal(source.Id, links.Items[0].LinkedTaskId)
+	s.Equal(api.RelatesTo, links.Items[0].Type)
+
+	otherTeam := s.CreateTeam("Clone Target", s.ownerID)
+	s.AddTeamMember(otherTeam, s.ownerID, "owner")
+	moved, err := s.service.Clone(ctx, s.ownerID, source.Id, api.CloneTaskRequest{
+		TeamId:          &otherTeam,
+		Title:           ptrString("Follow-up"),
+		CopyDescription: ptrBool(false),
+	})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(otherTeam, moved.TeamId)
+	s.Equal("Follow-up", moved.Title)
+	s.Nil(moved.Description)
+	s.Nil(moved.AssigneeId, "исполнитель не из целевой команды не копируется")
+	s.Contains(s.cache.invalidated, otherTeam)
+
+	_, err = s.service.Clone(ctx, s.memberID, source.Id, api.CloneTaskRequest{TeamId: &otherTeam})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Clone(ctx, s.memberID, source.Id, api.CloneTaskRequest{Title: ptrString("  ")})
+	s.ErrorIs(err, ErrInvalidTitle, methodCtx)
+
+	_, err = s.service.Links(ctx, s.outsiderID, source.Id)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Clone(ctx, s.memberID, uuid.New(), api.CloneTaskRequest{})
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *TasksSuite) TestMoveTask() {
+	const methodCtx = "tasks.TasksSuite.TestMoveTask"
+
+	ctx := context.Background()
+	targetTeamID := s.CreateTeam("Target Team", s.memberID)
+	s.AddTeamMember(targetTeamID, s.memberID, "owner")
+
+	taskID := s.CreateTask(s.teamID, s.memberID, &s.ownerID, "in_progress", "move me", "")
+
+	resp, err := s.service.Move(ctx, s.memberID, taskID, api.MoveTaskRequest{TeamId: &targetTeamID})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(targetTeamID, resp.TeamId)
+	s.Nil(resp.AssigneeId, "исполнитель не из целевой команды должен быть сброшен")
+	s.Equal(api.TaskStatus("in_progress"), resp.Status)
+	s.ElementsMatch([]uuid.UUID{s.teamID, targetTeamID}, s.cache.invalidated)
+
+	history, err := s.service.History(ctx, s.memberID, taskID, api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(history.Items, 1)
+	s.Contains(history.Items[0].Changes, "team_id")
+	s.Contains(history.Items[0].Changes, "assignee_id")
+
+	_, err = s.service.Move(ctx, s.memberID, taskID, api.MoveTaskRequest{TeamId: &targetTeamID})
+	s.ErrorIs(err, ErrSameTeam, methodCtx)
+}
+
+func (s *TasksSuite) TestMoveTaskValidation() {
+	const methodCtx = "tasks.TasksSuite.TestMoveTaskValidation"
+
+	ctx := context.Background()
+	targetTeamID := s.CreateTeam("Target Team", s.outsiderID)
+	s.AddTeamMember(targetTeamID, s.outsiderID, "owner")
+
+	taskID := s.CreateTask(s.teamID, s.memberID, nil, "todo", "stay", "")
+
+	_, err := s.service.Move(ctx, s.memberID, taskID, api.MoveTaskRequest{TeamId: &targetTeamID})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	s.AddTeamMember(targetTeamID, s.memberID, "member")
+	assignee := api.UUID(s.ownerID)
+	_, err = s.service.Move(ctx, s.memberID, taskID, api.MoveTaskRequest{TeamId: &targetTeamID, AssigneeId: &assignee})
+	s.ErrorIs(err, ErrInvalidAssignee, methodCtx)
+
+	_, err = s.service.Move(ctx, s.memberID, uuid.New(), api.MoveTaskRequest{TeamId: &targetTeamID})
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *TasksSuite) TestReorderTask() {
+	const methodCtx = "tasks.TasksSuite.TestReorderTask"
+
+	ctx := context.Background()
+	var ids []uuid.UUID
+	for _, title := range []string{"first", "second", "third"} {
+		task, err := s.service.Create(ctx, s.ownerID, api.CreateTaskRequest{TeamId: s.teamID, Title: title})
+		s.Require().NoError(err, methodCtx)
+		ids = append(ids, task.Id)
+	}
+
+	board := func(status api.TaskStatus) []string {
+		params := listParams(s.teamID, &status, nil)
+		sortOrder := api.Rank
+		params.Sort = &sortOrder
+		resp, err := s.service.List(ctx, s.ownerID, params)
+		s.Require().NoError(err, methodCtx)
+		titles := make([]string, 0, len(resp.Items))
+		for _, item := range resp.Items {
+			titles = append(titles, item.Title)
+		}
+		return titles
+	}
+	s.Equal([]string{"first", "second", "third"}, board(api.Todo))
+
+	_, err := s.service.Move(ctx, s.ownerID, ids[2], api.MoveTaskRequest{BeforeId: &ids[0]})
+	s.Require().NoError(err, methodCtx)
+	s.Equal([]string{"third", "first", "second"}, board(api.Todo))
+
+	_, err = s.service.Move(ctx, s.ownerID, ids[1], api.MoveTaskRequest{AfterId: &ids[2], BeforeId: &ids[0]})
+	s.Require().NoError(err, methodCtx)
+	s.Equal([]string{"third", "second", "first"}, board(api.Todo))
+
+	status := api.Done
+	resp, err := s.service.Move(ctx, s.ownerID, ids[0], api.MoveTaskRequest{Status: &status})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Done, resp.Status)
+	s.NotNil(resp.CompletedAt)
+	s.Equal([]string{"first"}, board(api.Done))
+	s.Equal([]string{"third", "second"}, board(api.Todo))
+
+	history, err := s.service.History(ctx, s.ownerID, ids[0], api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Len(history.Items, 2, "в историю попадает только смена колонки")
+
+	_, err = s.service.Move(ctx, s.ownerID, ids[1], api.MoveTaskRequest{AfterId: &ids[0]})
+	s.ErrorIs(err, ErrInvalidMove, "сосед должен быть в целевой колонке")
+
+	_, err = s.service.Move(ctx, s.ownerID, ids[1], api.MoveTaskRequest{AfterId: &ids[1]})
+	s.ErrorIs(err, ErrInvalidMove, methodCtx)
+
+	_, err = s.service.Move(ctx, s.outsiderID, ids[1], api.MoveTaskRequest{BeforeId: &ids[2]})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Move(ctx, s.ownerID, ids[1], api.MoveTaskRequest{TeamId: &s.teamID, Status: &status})
+	s.ErrorIs(err, ErrInvalidMove, methodCtx)
+}
+
+func (s *TasksSuite) TestReorderTaskRebalance() {
+	const methodCtx = "tasks.TasksSuite.TestReorderTaskRebalance"
+
+	ctx := context.Background()
+	legacy := s.CreateTask(s.teamID, s.ownerID, nil, "todo", "legacy", "")
+	first, err := s.service.Create(ctx, s.ownerID, api.CreateTaskRequest{TeamId: s.teamID, Title: "first"})
+	s.Require().NoError(err, methodCtx)
+	second, err := s.service.Create(ctx, s.ownerID, api.CreateTaskRequest{TeamId: s.teamID, Title: "second"})
+	s.Require().NoError(err, methodCtx)
+
+	// Многократная вставка в одно место удлиняет ранг, пока колонка не будет перебалансирована.
+	for i := 0; i < 8*maxRankLength; i++ {
+		moved := second.Id
+		anchor := first.Id
+		if i%2 == 1 {
+			moved, anchor = first.Id, second.Id
+		}
+		resp, err := s.service.Move(ctx, s.ownerID, moved, api.MoveTaskRequest{AfterId: &legacy, BeforeId: &anchor})
+		s.Require().NoError(err, methodCtx)
+		s.LessOrEqual(len(resp.Rank), maxRankLength, methodCtx)
+	}
+
+	status := api.Todo
+	params := listParams(s.teamID, &status, nil)
+	sortOrder := api.Rank
+	params.Sort = &sortOrder
+	resp, err := s.service.List(ctx, s.ownerID, params)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 3, methodCtx)
+	s.Equal(legacy, resp.Items[0].Id)
+	s.Equal(first.Id, resp.Items[1].Id, "последней перемещалась первая задача")
+	for i, item := range resp.Items {
+		s.NotEmpty(item.Rank, "после перебалансировки ранг есть у всех задач колонки")
+		if i > 0 {
+			s.Less(resp.Items[i-1].Rank, item.Rank, methodCtx)
+		}
+	}
+}
+
 func (s *TasksSuite) TestUpdateTaskForbidden() {
 	const methodCtx = "tasks.TasksSuite.TestUpdateTaskForbidden"
 
@@ -251,6 +877,244 @@ func (s *TasksSuite) TestUpdateTaskForbidden() {
 	s.ErrorIs(err, ErrForbidden)
 }
 
+func (s *TasksSuite) TestUpdateTaskEditPolicy() {
+	const methodCtx = "tasks.TasksSuite.TestUpdateTaskEditPolicy"
+
+	ctx := context.Background()
+	creatorID := s.CreateUser("creator-task@example.com")
+	s.AddTeamMember(s.teamID, creatorID, "member")
+	taskID := s.CreateTask(s.teamID, creatorID, &s.memberID, "todo", "policy", "")
+
+	status := api.TaskStatus("in_progress")
+	resp, err := s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Status: &status})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(status, resp.Status)
+
+	_, err = s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Title: ptrString("assignee")})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	resp, err = s.service.Update(ctx, s.ownerID, taskID, api.UpdateTaskRequest{Title: ptrString("owner")})
+	s.Require().NoError(err, methodCtx)
+	s.Equal("owner", resp.Title)
+
+	_, err = s.DB.ExecContext(ctx, "UPDATE teams SET task_edit_policy = 'strict' WHERE id = ?", s.teamID.String())
+	s.Require().NoError(err, methodCtx)
+
+	done := api.TaskStatus("done")
+	_, err = s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Status: &done})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.DB.ExecContext(ctx, "UPDATE teams SET task_edit_policy = 'assignee_full' WHERE id = ?", s.teamID.String())
+	s.Require().NoError(err, methodCtx)
+
+	resp, err = s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Title: ptrString("assignee"), Status: &done})
+	s.Require().NoError(err, methodCtx)
+	s.Equal("assignee", resp.Title)
+
+	resp, err = s.service.Update(ctx, creatorID, taskID, api.UpdateTaskRequest{Description: ptrString("creator")})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(resp.Description)
+}
+
+func (s *TasksSuite) TestUpdateDoneTaskKeepsCompletedAt() {
+	const methodCtx = "tasks.TasksSuite.TestUpdateDoneTaskKeepsCompletedAt"
+
+	ctx := context.Background()
+	taskID := s.CreateTask(s.teamID, s.memberID, nil, "done", "done", "")
+	completedAt := time.Now().UTC().Add(-10 * 24 * time.Hour).Truncate(time.Second)
+	s.setCompletedAt(taskID, completedAt)
+
+	points := 5
+	resp, err := s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Title: ptrString("renamed"), StoryPoints: &points})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(resp.CompletedAt, methodCtx)
+	s.True(completedAt.Equal(*resp.CompletedAt), "время завершения не меняется")
+
+	var stored time.Time
+	err = s.DB.QueryRowContext(ctx, "SELECT completed_at FROM tasks WHERE id = ?", taskID.String()).Scan(&stored)
+	s.Require().NoError(err, methodCtx)
+	s.True(completedAt.Equal(stored.UTC()), methodCtx)
+
+	todo := api.TaskStatus("todo")
+	resp, err = s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Status: &todo})
+	s.Require().NoError(err, methodCtx)
+	s.Nil(resp.CompletedAt, "возврат в работу сбрасывает время завершения")
+}
+
+func (s *TasksSuite) TestRevertHistory() {
+	const methodCtx = "tasks.TasksSuite.TestRevertHistory"
+
+	ctx := context.Background()
+	taskID := s.CreateTask(s.teamID, s.memberID, nil, "todo", "original", "")
+
+	done := api.TaskStatus("done")
+	_, err := s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Title: ptrString("changed"), Status: &done})
+	s.Require().NoError(err, methodCtx)
+
+	history, err := s.service.History(ctx, s.memberID, taskID, api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(history.Items, 1)
+	entryID := history.Items[0].Id
+
+	resp, err := s.service.Revert(ctx, s.memberID, taskID, entryID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal("original", resp.Title)
+	s.Equal(api.TaskStatus("todo"), resp.Status)
+	s.Nil(resp.CompletedAt)
+
+	history, err = s.service.History(ctx, s.memberID, taskID, api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(history.Items, 2)
+	reverts := 0
+	for _, item := range history.Items {
+		if item.RevertsId != nil {
+			s.Equal(entryID, *item.RevertsId)
+			reverts++
+		}
+	}
+	s.Equal(1, reverts)
+
+	_, err = s.service.Revert(ctx, s.memberID, taskID, entryID)
+	s.ErrorIs(err, ErrRevertConflict, methodCtx)
+
+	_, err = s.service.Revert(ctx, s.outsiderID, taskID, entryID)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.Revert(ctx, s.memberID, taskID, uuid.New())
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *TasksSuite) TestWritesInvalidateCache() {
+	const methodCtx = "tasks.TasksSuite.TestWritesInvalidateCache"
+
+	ctx := context.Background()
+	created, err := s.service.Create(ctx, s.memberID, api.CreateTaskRequest{TeamId: s.teamID, Title: "cached"})
+	s.Require().NoError(err, methodCtx)
+	s.Equal([]uuid.UUID{s.teamID}, s.cache.invalidated, "создание сбрасывает кеш")
+
+	s.cache.invalidated = nil
+	_, err = s.service.Update(ctx, s.memberID, created.Id, api.UpdateTaskRequest{Title: ptrString("edited")})
+	s.Require().NoError(err, methodCtx)
+	s.Equal([]uuid.UUID{s.teamID}, s.cache.invalidated, "изменение сбрасывает кеш")
+
+	history, err := s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	var entryID uuid.UUID
+	for _, item := range history.Items {
+		if item.Event == api.Updated {
+			entryID = item.Id
+		}
+	}
+	s.Require().NotEqual(uuid.Nil, entryID, methodCtx)
+
+	s.cache.invalidated = nil
+	_, err = s.service.Revert(ctx, s.memberID, created.Id, entryID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal([]uuid.UUID{s.teamID}, s.cache.invalidated, "откат сбрасывает кеш")
+}
+
+func (s *TasksSuite) TestRemovedCreatorCannotEdit() {
+	const methodCtx = "tasks.TasksSuite.TestRemovedCreatorCannotEdit"
+
+	ctx := context.Background()
+	targetTeamID := s.CreateTeam("Creator Team", s.memberID)
+	s.AddTeamMember(targetTeamID, s.memberID, "owner")
+
+	taskID := s.CreateTask(s.teamID, s.memberID, nil, "todo", "original", "")
+	_, err := s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Title: ptrString("changed")})
+	s.Require().NoError(err, methodCtx)
+
+	history, err := s.service.History(ctx, s.memberID, taskID, api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(history.Items, 1)
+	entryID := history.Items[0].Id
+
+	_, err = s.DB.ExecContext(ctx, "DELETE FROM team_members WHERE team_id = ? AND user_id = ?", s.teamID.String(), s.memberID.String())
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Title: ptrString("again")})
+	s.ErrorIs(err, ErrForbidden, "исключенный создатель не редактирует задачу")
+
+	_, err = s.service.Revert(ctx, s.memberID, taskID, entryID)
+	s.ErrorIs(err, ErrForbidden, "исключенный создатель не откатывает изменения")
+
+	done := api.TaskStatus("done")
+	_, err = s.service.Move(ctx, s.memberID, taskID, api.MoveTaskRequest{Status: &done})
+	s.ErrorIs(err, ErrForbidden, "исключенный создатель не перемещает задачу по доске")
+
+	_, err = s.service.Move(ctx, s.memberID, taskID, api.MoveTaskRequest{TeamId: &targetTeamID})
+	s.ErrorIs(err, ErrForbidden, "исключенный создатель не переносит задачу в свою команду")
+}
+
+func (s *TasksSuite) TestRevertStatusAppendsRank() {
+	const methodCtx = "tasks.TasksSuite.TestRevertStatusAppendsRank"
+
+	ctx := context.Background()
+	todo := api.TaskStatus("todo")
+	task, err := s.service.Create(ctx, s.memberID, api.CreateTaskRequest{TeamId: s.teamID, Title: "moved", Status: &todo})
+	s.Require().NoError(err, methodCtx)
+
+	inProgress := api.TaskStatus("in_progress")
+	_, err = s.service.Update(ctx, s.memberID, task.Id, api.UpdateTaskRequest{Status: &inProgress})
+	s.Require().NoError(err, methodCtx)
+
+	last, err := s.service.Create(ctx, s.memberID, api.CreateTaskRequest{TeamId: s.teamID, Title: "last", Status: &todo})
+	s.Require().NoError(err, methodCtx)
+
+	history, err := s.service.History(ctx, s.memberID, task.Id, api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	var entryID uuid.UUID
+	for _, item := range history.Items {
+		if item.Event == api.Updated {
+			entryID = item.Id
+		}
+	}
+	s.Require().NotEqual(uuid.Nil, entryID, methodCtx)
+
+	resp, err := s.service.Revert(ctx, s.memberID, task.Id, entryID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(todo, resp.Status)
+	s.Greater(resp.Rank, last.Rank, "после отката задача встает в конец колонки")
+}
+
+func (s *TasksSuite) TestRevertConflictWithLaterEdit() {
+	const methodCtx = "tasks.TasksSuite.TestRevertConflictWithLaterEdit"
+
+	ctx := context.Background()
+	taskID := s.CreateTask(s.teamID, s.memberID, nil, "todo", "v1", "")
+
+	_, err := s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Title: ptrString("v2")})
+	s.Require().NoError(err, methodCtx)
+	estimate := 30
+	_, err = s.service.Update(ctx, s.memberID, taskID, api.UpdateTaskRequest{Title: ptrString("v3"), EstimateMinutes: &estimate})
+	s.Require().NoError(err, methodCtx)
+
+	history, err := s.service.History(ctx, s.memberID, taskID, api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(history.Items, 2)
+
+	first, second := history.Items[0], history.Items[1]
+	if _, ok := first.Changes["estimate_minutes"]; ok {
+		first, second = second, first
+	}
+
+	_, err = s.service.Revert(ctx, s.memberID, taskID, first.Id)
+	s.ErrorIs(err, ErrRevertConflict, methodCtx)
+
+	resp, err := s.service.Revert(ctx, s.memberID, taskID, second.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Equal("v2", resp.Title)
+	s.Nil(resp.EstimateMinutes)
+
+	movedID := s.CreateTaskHistory(taskID, s.memberID, `{"team_id": {"from": "a", "to": "b"}}`)
+	_, err = s.service.Revert(ctx, s.memberID, taskID, movedID)
+	s.ErrorIs(err, ErrRevertInvalid, methodCtx)
+
+	sprintID := s.CreateTaskHistory(taskID, s.memberID, `{"sprint_id": {"from": null, "to": "`+uuid.NewString()+`"}}`)
+	_, err = s.service.Revert(ctx, s.memberID, taskID, sprintID)
+	s.ErrorIs(err, ErrRevertInvalid, methodCtx)
+}
+
 func (s *TasksSuite) TestUpdateTaskNotFound() {
 	const methodCtx = "tasks.TasksSuite.TestUpdateTaskNotFound"
 
@@ -270,32 +1134,177 @@ func (s *TasksSuite) TestHistory() {
 	s.CreateTaskHistory(taskID, s.memberID, "{}")
 	s.CreateTaskHistory(taskID, s.ownerID, "{\"title\":{\"from\":\"old\",\"to\":\"new\"}}")
 
-	resp, err := s.service.History(ctx, s.memberID, taskID)
+	resp, err := s.service.History(ctx, s.memberID, taskID, api.GetApiV1TasksIdHistoryParams{})
 	s.Require().NoError(err, methodCtx)
 	s.Len(resp.Items, 2)
 }
 
+func (s *TasksSuite) TestHistoryFilters() {
+	const methodCtx = "tasks.TasksSuite.TestHistoryFilters"
+
+	ctx := context.Background()
+	created, err := s.service.Create(ctx, s.memberID, api.CreateTaskRequest{
+		TeamId: api.UUID(s.teamID),
+		Title:  "filtered",
+	})
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.service.Update(ctx, s.ownerID, created.Id, api.UpdateTaskRequest{Title: ptrString("renamed")})
+	s.Require().NoError(err, methodCtx)
+	inProgress := api.TaskStatus("in_progress")
+	_, err = s.service.Update(ctx, s.memberID, created.Id, api.UpdateTaskRequest{Status: &inProgress})
+	s.Require().NoError(err, methodCtx)
+
+	all, err := s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(3, all.Total)
+	s.Equal(1, all.Page)
+	s.Equal(20, all.PerPage)
+	for _, item := range all.Items {
+		s.Equal(HistorySchemaVersion, item.SchemaVersion)
+	}
+
+	field := api.TaskHistoryFieldStatus
+	byField, err := s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{Field: &field})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(2, byField.Total)
+
+	owner := api.UUID(s.ownerID)
+	byActor, err := s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{ChangedBy: &owner})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(byActor.Items, 1)
+	s.Equal(api.Updated, byActor.Items[0].Event)
+	s.Equal("renamed", byActor.Items[0].Changes["title"].To)
+
+	page, perPage := 2, 2
+	paged, err := s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{Page: &page, PerPage: &perPage})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(3, paged.Total)
+	s.Len(paged.Items, 1)
+
+	future := time.Now().UTC().Add(time.Hour)
+	later, err := s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{From: &future})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(0, later.Total)
+	s.Empty(later.Items)
+
+	past := future.Add(-2 * time.Hour)
+	_, err = s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{From: &future, To: &past})
+	s.ErrorIs(err, ErrInvalidHistoryFilter, methodCtx)
+
+	unknown := api.TaskHistoryField("created_by")
+	_, err = s.service.History(ctx, s.memberID, created.Id, api.GetApiV1TasksIdHistoryParams{Field: &unknown})
+	s.ErrorIs(err, ErrInvalidHistoryFilter, methodCtx)
+
+	var createdID api.UUID
+	for _, item := range all.Items {
+		if item.Event == api.Created {
+			createdID = item.Id
+		}
+	}
+	_, err = s.service.Revert(ctx, s.memberID, created.Id, createdID)
+	s.ErrorIs(err, ErrRevertInvalid, methodCtx)
+}
+
 func (s *TasksSuite) TestHistoryForbidden() {
 	const methodCtx = "tasks.TasksSuite.TestHistoryForbidden"
 
 	ctx := context.Background()
 	taskID := s.CreateTask(s.teamID, s.memberID, nil, "todo", "old", "")
 
-	_, err := s.service.History(ctx, s.outsiderID, taskID)
+	_, err := s.service.History(ctx, s.outsiderID, taskID, api.GetApiV1TasksIdHistoryParams{})
 	s.Require().Error(err, methodCtx)
 	s.ErrorIs(err, ErrForbidden)
 }
 
+func (s *TasksSuite) TestRepairAssignees() {
+	const methodCtx = "tasks.TasksSuite.TestRepairAssignees"
+
+	ctx := context.Background()
+	openTaskID := s.CreateTask(s.teamID, s.ownerID, &s.outsiderID, "todo", "open", "")
+	doneTaskID := s.CreateTask(s.teamID, s.ownerID, &s.outsiderID, "done", "done", "")
+	validTaskID := s.CreateTask(s.teamID, s.ownerID, &s.memberID, "todo", "valid", "")
+
+	_, err := s.service.RepairAssignees(ctx, s.memberID, s.teamID, api.RepairAssigneesRequest{
+		Strategy: api.RepairAssigneesRequestStrategyUnassign,
+	})
+	s.ErrorIs(err, ErrForbidden, "member не исправляет исполнителей")
+
+	_, err = s.service.RepairAssignees(ctx, s.ownerID, s.teamID, api.RepairAssigneesRequest{
+		Strategy: api.RepairAssigneesRequestStrategyReassign,
+	})
+	s.ErrorIs(err, ErrAssigneeRequired, methodCtx)
+
+	_, err = s.service.RepairAssignees(ctx, s.ownerID, s.teamID, api.RepairAssigneesRequest{
+		Strategy:   api.RepairAssigneesRequestStrategyReassign,
+		AssigneeId: &s.outsiderID,
+	})
+	s.ErrorIs(err, ErrInvalidAssignee, methodCtx)
+
+	result, err := s.service.RepairAssignees(ctx, s.ownerID, s.teamID, api.RepairAssigneesRequest{
+		Strategy:   api.RepairAssigneesRequestStrategyReassign,
+		AssigneeId: &s.memberID,
+	})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(2, result.TasksUpdated)
+	s.Contains(s.cache.invalidated, s.teamID)
+
+	for _, taskID := range []uuid.UUID{openTaskID, doneTaskID, validTaskID} {
+		var assigneeID string
+		err = s.DB.QueryRowContext(ctx, "SELECT assignee_id FROM tasks WHERE id = ?", taskID.String()).Scan(&assigneeID)
+		s.Require().NoError(err, methodCtx)
+		s.Equal(s.memberID.String(), assigneeID)
+	}
+
+	records, err := repomysql.NewTasksRepo(s.DB).ListInvalidAssignees(ctx, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Empty(records)
+
+	result, err = s.service.RepairAssignees(ctx, s.ownerID, s.teamID, api.RepairAssigneesRequest{
+		Strategy: api.RepairAssigneesRequestStrategyUnassign,
+	})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(0, result.TasksUpdated)
+}
+
+func listParams(teamID uuid.UUID, status *api.TaskStatus, assigneeIDs []uuid.UUID) api.GetApiV1TasksParams {
+	params := api.GetApiV1TasksParams{TeamId: &teamID, Status: status}
+	if assigneeIDs != nil {
+		params.AssigneeId = &assigneeIDs
+	}
+	return params
+}
+
+func taskIDs(items []api.Task) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	return ids
+}
+
+func (s *TasksSuite) setCompletedAt(taskID uuid.UUID, completedAt time.Time) {
+	const methodCtx = "tasks.TasksSuite.setCompletedAt"
+
+	_, err := s.DB.ExecContext(context.Background(), "UPDATE tasks SET completed_at = ? WHERE id = ?", completedAt, taskID.String())
+	s.Require().NoError(err, methodCtx)
+}
+
 func ptrString(value string) *string {
 	return &value
 }
 
+func ptrBool(value bool) *bool {
+	return &value
+}
+
 type cacheSpy struct {
-	getCalls int
-	setCalls int
-	hit      bool
-	data     []api.Task
-	lastKey  string
+	getCalls    int
+	setCalls    int
+	hit         bool
+	data        []api.Task
+	lastKey     string
+	invalidated []uuid.UUID
 }
 
 func (c *cacheSpy) GetTeamTasks(ctx context.Context, teamID uuid.UUID, key string) ([]api.Task, bool, error) {
@@ -313,3 +1322,8 @@ func (c *cacheSpy) SetTeamTasks(ctx context.Context, teamID uuid.UUID, key strin
 	c.data = tasks
 	return nil
 }
+
+func (c *cacheSpy) InvalidateTeam(ctx context.Context, teamID uuid.UUID) error {
+	c.invalidated = append(c.invalidated, teamID)
+	return nil
+}
@@ -0,0 +1,78 @@
+package tasks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+)
+
+// listQuery описывает итоговые параметры списка задач после применения представления.
+type listQuery struct {
+	teamID      uuid.UUID
+	status      *api.TaskStatus
+	assigneeIDs []uuid.UUID
+	sprintID    *uuid.UUID
+	archived    api.TaskArchivedFilter
+	sort        api.TaskSort
+}
+
+// resolveListQuery объединяет параметры запроса с сохраненным представлением.
+// Явно переданные status, assignee_id и sort имеют приоритет над значениями представления.
+// Чужое личное представление считается ненайденным.
+func (s *Service) resolveListQuery(ctx context.Context, userID uuid.UUID, params api.GetApiV1TasksParams) (listQuery, error) {
+	var query listQuery
+
+	if params.ViewId != nil {
+		view, err := s.views.Get(ctx, *params.ViewId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return listQuery{}, ErrNotFound
+			}
+			return listQuery{}, err
+		}
+		if view.OwnerID != userID && !view.Shared {
+			return listQuery{}, ErrNotFound
+		}
+		if params.TeamId != nil && *params.TeamId != view.TeamID {
+			return listQuery{}, ErrViewTeamMismatch
+		}
+
+		query.teamID = view.TeamID
+		if view.Status != nil {
+			status := api.TaskStatus(*view.Status)
+			query.status = &status
+		}
+		query.assigneeIDs = view.AssigneeIDs
+		query.sort = api.TaskSort(view.Sort)
+	} else {
+		if params.TeamId == nil {
+			return listQuery{}, fmt.Errorf("%w: team_id", ErrTeamRequired)
+		}
+		query.teamID = *params.TeamId
+	}
+
+	if params.Status != nil {
+		query.status = params.Status
+	}
+	if params.AssigneeId != nil {
+		query.assigneeIDs = *params.AssigneeId
+	}
+	query.sprintID = params.SprintId
+	query.archived = api.Exclude
+	if params.Archived != nil {
+		query.archived = *params.Archived
+	}
+	if params.Sort != nil {
+		query.sort = *params.Sort
+	}
+	if query.sort == "" {
+		query.sort = api.CreatedAtDesc
+	}
+
+	return query, nil
+}
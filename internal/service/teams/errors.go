@@ -9,4 +9,14 @@ var (
 	ErrInviteNotFound      = errors.New("приглашение не найдено")
 	ErrInviteEmailMismatch = errors.New("email не соответствует приглашению")
 	ErrNotImplemented      = errors.New("не реализовано")
+	ErrInvalidPolicy       = errors.New("неизвестная политика редактирования задач")
+	ErrInvalidArchiveDays  = errors.New("срок архивации должен быть от 1 до 3650 дней")
+	ErrInvalidName         = errors.New("имя команды не задано")
+	ErrMemberNotFound      = errors.New("участник команды не найден")
+	ErrInvalidTransfer     = errors.New("нельзя передать команду самому себе")
+	ErrInvalidRole         = errors.New("неизвестная роль участника")
+	ErrLastOwner           = errors.New("в команде должен остаться хотя бы один owner")
+	ErrInvalidStrategy     = errors.New("неизвестная стратегия для открытых задач")
+	ErrInvalidReassign     = errors.New("задачи можно переназначить только другому участнику команды")
+	ErrOpenTasks           = errors.New("у участника есть открытые задачи")
 )
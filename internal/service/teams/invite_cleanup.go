@@ -0,0 +1,70 @@
+package teams
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+const defaultInviteCleanupBatchSize = 500
+
+// InviteCleanupRepository описывает удаление истекших приглашений.
+type InviteCleanupRepository interface {
+	DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int, error)
+}
+
+// InviteCleaner удаляет приглашения, срок действия которых истек.
+type InviteCleaner struct {
+	invites   InviteCleanupRepository
+	batchSize int
+	now       func() time.Time
+}
+
+// NewInviteCleaner создает обработчик очистки истекших приглашений.
+func NewInviteCleaner(invites InviteCleanupRepository) (*InviteCleaner, error) {
+	const methodCtx = "teams.NewInviteCleaner"
+
+	slog.Debug("инициализация очистки приглашений", slog.String("context", methodCtx))
+
+	if invites == nil {
+		return nil, fmt.Errorf("%s: invites repo не задан", methodCtx)
+	}
+
+	return &InviteCleaner{
+		invites:   invites,
+		batchSize: defaultInviteCleanupBatchSize,
+		now:       time.Now,
+	}, nil
+}
+
+// Run удаляет истекшие приглашения пачками. Вызывается фоновым обработчиком.
+func (c *InviteCleaner) Run(ctx context.Context) error {
+	const methodCtx = "teams.InviteCleaner.Run"
+
+	expiredBefore := c.now().UTC()
+	total := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+
+		deleted, err := c.invites.DeleteExpired(ctx, expiredBefore, c.batchSize)
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		total += deleted
+
+		if deleted < c.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.Info("истекшие приглашения удалены",
+			slog.String("context", methodCtx),
+			slog.Int("count", total),
+		)
+	}
+	return nil
+}
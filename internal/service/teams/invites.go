@@ -0,0 +1,231 @@
+package teams
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/pkg/mailer"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+const (
+	defaultInviteTTL = 72 * time.Hour
+	inviteCodeBytes  = 32
+)
+
+// ListInvites возвращает действующие приглашения команды, новые первыми. Доступно owner и admin.
+func (s *Service) ListInvites(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.GetApiV1TeamsIdInvitesParams) (api.InvitesListResponse, error) {
+	const methodCtx = "teams.Service.ListInvites"
+
+	slog.Debug("вызов списка приглашений команды", slog.String("context", methodCtx))
+
+	if err := s.ensureInviteManager(ctx, teamID, userID); err != nil {
+		return api.InvitesListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	var page, perPage int
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.PerPage != nil {
+		perPage = *params.PerPage
+	}
+	page, perPage = normalizePagination(page, perPage)
+
+	filter := repomysql.TeamInviteFilter{
+		TeamID:   teamID,
+		ActiveAt: s.now().UTC(),
+		Page:     page,
+		PerPage:  perPage,
+	}
+
+	records, err := s.invites.ListByTeam(ctx, filter)
+	if err != nil {
+		return api.InvitesListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	total, err := s.invites.CountByTeam(ctx, filter)
+	if err != nil {
+		return api.InvitesListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.Invite, 0, len(records))
+	for _, record := range records {
+		items = append(items, mapInvite(record))
+	}
+
+	return api.InvitesListResponse{
+		Items:   items,
+		Page:    page,
+		PerPage: perPage,
+		Total:   total,
+	}, nil
+}
+
+// RevokeInvite отзывает приглашение: его код перестает действовать. Доступно owner и admin.
+func (s *Service) RevokeInvite(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, inviteID uuid.UUID) error {
+	const methodCtx = "teams.Service.RevokeInvite"
+
+	slog.Debug("вызов отзыва приглашения", slog.String("context", methodCtx))
+
+	if err := s.ensureInviteManager(ctx, teamID, userID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if _, err := s.invites.Get(ctx, nil, teamID, inviteID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", methodCtx, ErrInviteNotFound)
+		}
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.invites.Delete(ctx, nil, inviteID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return nil
+}
+
+// ResendInvite выпускает новый код приглашения, продлевает срок действия и повторно отправляет письмо.
+// Прежний код перестает действовать после успешной отправки письма. Доступно owner и admin.
+func (s *Service) ResendInvite(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, inviteID uuid.UUID) (api.Invite, error) {
+	const methodCtx = "teams.Service.ResendInvite"
+
+	slog.Debug("вызов повторной отправки приглашения", slog.String("context", methodCtx))
+
+	if err := s.ensureInviteManager(ctx, teamID, userID); err != nil {
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	record, err := s.invites.Get(ctx, nil, teamID, inviteID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, ErrInviteNotFound)
+		}
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.ensureNotMember(ctx, teamID, record.Email); err != nil {
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	record.CodeHash = hashInviteCode(code)
+	record.ExpiresAt = s.now().UTC().Truncate(time.Second).Add(s.inviteTTL)
+
+	// Код меняется только после отправки письма: при ошибке почты прежний код продолжает действовать.
+	if err := s.sendInvite(ctx, record.Email, code, record.ExpiresAt); err != nil {
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.invites.Rotate(ctx, nil, record.ID, record.CodeHash, record.ExpiresAt); err != nil {
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return mapInvite(record), nil
+}
+
+// ensureInviteManager проверяет, что команда существует, а пользователь в ней owner или admin.
+func (s *Service) ensureInviteManager(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
+	teamExists, err := s.teams.Exists(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if !teamExists {
+		return ErrNotFound
+	}
+
+	role, ok, err := s.members.GetRole(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !ok || (role != "owner" && role != "admin") {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// ensureNotMember проверяет, что пользователь с указанным email еще не состоит в команде.
+func (s *Service) ensureNotMember(ctx context.Context, teamID uuid.UUID, email string) error {
+	foundID, exists, err := s.users.FindIDByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	isMember, err := s.members.IsMember(ctx, teamID, foundID)
+	if err != nil {
+		return err
+	}
+	if isMember {
+		return ErrAlreadyMember
+	}
+	return nil
+}
+
+func (s *Service) sendInvite(ctx context.Context, email string, code string, expiresAt time.Time) error {
+	if s.mailer == nil {
+		return nil
+	}
+
+	send := func() error {
+		return s.mailer.Send(ctx, mailer.Message{
+			To:      email,
+			Subject: "Приглашение в команду",
+			Body: fmt.Sprintf(
+				"Ваш код приглашения: %s\nКод действует до %s UTC.",
+				code,
+				expiresAt.UTC().Format("02.01.2006 15:04"),
+			),
+		})
+	}
+
+	var err error
+	if s.breaker != nil {
+		err = s.breaker.Execute(send)
+	} else {
+		err = send()
+	}
+	if err != nil {
+		return fmt.Errorf("ошибка отправки письма: %w", err)
+	}
+	return nil
+}
+
+func mapInvite(record repomysql.TeamInviteRecord) api.Invite {
+	return api.Invite{
+		Id:        api.UUID(record.ID),
+		TeamId:    api.UUID(record.TeamID),
+		Email:     openapi_types.Email(record.Email),
+		InviterId: api.UUID(record.InviterID),
+		CreatedAt: record.CreatedAt,
+		ExpiresAt: record.ExpiresAt,
+	}
+}
+
+func newInviteCode() (string, error) {
+	raw := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func hashInviteCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
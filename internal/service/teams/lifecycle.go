@@ -0,0 +1,232 @@
+package teams
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// defaultDeleteGrace — срок, в течение которого удаленную команду можно восстановить.
+const defaultDeleteGrace = 7 * 24 * time.Hour
+
+// GetTeam возвращает команду. Доступно участникам команды.
+func (s *Service) GetTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.Team, error) {
+	const methodCtx = "teams.Service.GetTeam"
+
+	slog.Debug("вызов получения команды", slog.String("context", methodCtx))
+
+	team, err := s.teams.Get(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Team{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	isMember, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !isMember {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	return api.Team{
+		Id:        api.UUID(team.ID),
+		Name:      team.Name,
+		CreatedBy: api.UUID(team.CreatedBy),
+		CreatedAt: team.CreatedAt,
+	}, nil
+}
+
+// UpdateTeam переименовывает команду. Доступно owner и admin.
+func (s *Service) UpdateTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.UpdateTeamRequest) (api.Team, error) {
+	const methodCtx = "teams.Service.UpdateTeam"
+
+	slog.Debug("вызов изменения команды", slog.String("context", methodCtx))
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidName)
+	}
+
+	team, err := s.teams.Get(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Team{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	role, ok, err := s.members.GetRole(ctx, teamID, userID)
+	if err != nil {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !ok || (role != "owner" && role != "admin") {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	if err := s.teams.Rename(ctx, teamID, name, s.now().UTC()); err != nil {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.Team{
+		Id:        api.UUID(team.ID),
+		Name:      name,
+		CreatedBy: api.UUID(team.CreatedBy),
+		CreatedAt: team.CreatedAt,
+	}, nil
+}
+
+// DeleteTeam помечает команду удаленной. Доступно только owner.
+// Команда сразу скрывается, а окончательно удаляется фоновой задачей после срока восстановления.
+func (s *Service) DeleteTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.TeamDeletion, error) {
+	const methodCtx = "teams.Service.DeleteTeam"
+
+	slog.Debug("вызов удаления команды", slog.String("context", methodCtx))
+
+	role, ok, err := s.members.GetRole(ctx, teamID, userID)
+	if err != nil {
+		return api.TeamDeletion{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !ok {
+		exists, err := s.teams.Exists(ctx, teamID)
+		if err != nil {
+			return api.TeamDeletion{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if !exists {
+			return api.TeamDeletion{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.TeamDeletion{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+	if role != "owner" {
+		return api.TeamDeletion{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	now := s.now().UTC().Truncate(time.Second)
+	deleted, err := s.teams.SoftDelete(ctx, teamID, userID, now)
+	if err != nil {
+		return api.TeamDeletion{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !deleted {
+		return api.TeamDeletion{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	}
+
+	return api.TeamDeletion{
+		TeamId:     api.UUID(teamID),
+		DeletedAt:  now,
+		PurgeAfter: now.Add(s.grace),
+	}, nil
+}
+
+// RestoreTeam снимает пометку удаления до окончательного удаления команды. Доступно только owner;
+// для остальных удаленная команда считается ненайденной.
+func (s *Service) RestoreTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.Team, error) {
+	const methodCtx = "teams.Service.RestoreTeam"
+
+	slog.Debug("вызов восстановления команды", slog.String("context", methodCtx))
+
+	now := s.now().UTC()
+	restored, err := s.teams.Restore(ctx, teamID, userID, now.Add(-s.grace), now)
+	if err != nil {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !restored {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	}
+
+	team, err := s.teams.Get(ctx, teamID)
+	if err != nil {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.Team{
+		Id:        api.UUID(team.ID),
+		Name:      team.Name,
+		CreatedBy: api.UUID(team.CreatedBy),
+		CreatedAt: team.CreatedAt,
+	}, nil
+}
+
+// TransferOwnership передает владение командой другому участнику. Доступно только owner:
+// участник становится owner, текущий owner — admin.
+func (s *Service) TransferOwnership(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.TransferTeamRequest) (api.TeamMember, error) {
+	const methodCtx = "teams.Service.TransferOwnership"
+
+	slog.Debug("вызов передачи владения командой", slog.String("context", methodCtx))
+
+	targetID := uuid.UUID(req.UserId)
+	if targetID == userID {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidTransfer)
+	}
+
+	exists, err := s.teams.Exists(ctx, teamID)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !exists {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	role, ok, err := s.members.GetRoleForUpdate(ctx, tx, teamID, userID)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !ok || role != "owner" {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	targetRole, ok, err := s.members.GetRoleForUpdate(ctx, tx, teamID, targetID)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !ok {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrMemberNotFound)
+	}
+
+	if targetRole != "owner" {
+		if err := s.members.SetRole(ctx, tx, teamID, targetID, "owner"); err != nil {
+			return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := s.addMemberEvent(ctx, tx, teamID, targetID, userID, repomysql.TeamMemberEventRoleChanged, targetRole, "owner"); err != nil {
+			return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+	if err := s.members.SetRole(ctx, tx, teamID, userID, "admin"); err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.addMemberEvent(ctx, tx, teamID, userID, userID, repomysql.TeamMemberEventRoleChanged, "owner", "admin"); err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	member, err := s.members.Get(ctx, tx, teamID, targetID)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.TeamMember{
+		TeamId:    api.UUID(member.TeamID),
+		UserId:    api.UUID(member.UserID),
+		Role:      api.TeamMemberRole(member.Role),
+		CreatedAt: member.CreatedAt,
+	}, nil
+}
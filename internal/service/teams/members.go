@@ -0,0 +1,410 @@
+package teams
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// ListMembers возвращает участников команды с email и ролью. Доступно участникам команды.
+func (s *Service) ListMembers(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.GetApiV1TeamsIdMembersParams) (api.TeamMembersListResponse, error) {
+	const methodCtx = "teams.Service.ListMembers"
+
+	slog.Debug("вызов списка участников команды", slog.String("context", methodCtx))
+
+	if err := s.ensureMember(ctx, teamID, userID); err != nil {
+		return api.TeamMembersListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	var page, perPage int
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.PerPage != nil {
+		perPage = *params.PerPage
+	}
+	page, perPage = normalizePagination(page, perPage)
+
+	filter := repomysql.TeamMemberFilter{
+		TeamID:  teamID,
+		Page:    page,
+		PerPage: perPage,
+	}
+	if params.Email != nil {
+		filter.EmailPrefix = strings.TrimSpace(*params.Email)
+	}
+
+	records, err := s.members.ListByTeam(ctx, filter)
+	if err != nil {
+		return api.TeamMembersListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	total, err := s.members.CountByTeam(ctx, filter)
+	if err != nil {
+		return api.TeamMembersListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.TeamMemberProfile, 0, len(records))
+	for _, record := range records {
+		items = append(items, api.TeamMemberProfile{
+			TeamId:    api.UUID(record.TeamID),
+			UserId:    api.UUID(record.UserID),
+			Email:     openapi_types.Email(record.Email),
+			Role:      api.TeamMemberProfileRole(record.Role),
+			CreatedAt: record.CreatedAt,
+		})
+	}
+
+	return api.TeamMembersListResponse{
+		Items:   items,
+		Page:    page,
+		PerPage: perPage,
+		Total:   total,
+	}, nil
+}
+
+// ensureMember проверяет, что команда существует и пользователь в ней состоит.
+func (s *Service) ensureMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
+	isMember, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if isMember {
+		return nil
+	}
+
+	exists, err := s.teams.Exists(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return ErrForbidden
+}
+
+func normalizePagination(page int, perPage int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = 20
+	}
+	if perPage > 100 {
+		perPage = 100
+	}
+	return page, perPage
+}
+
+// UpdateMemberRole изменяет роль участника. Доступно owner и admin; назначать owner и менять роль owner
+// может только owner. Последний owner не может быть понижен.
+func (s *Service) UpdateMemberRole(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, memberID uuid.UUID, req api.UpdateMemberRoleRequest) (api.TeamMember, error) {
+	const methodCtx = "teams.Service.UpdateMemberRole"
+
+	slog.Debug("вызов изменения роли участника", slog.String("context", methodCtx))
+
+	role := string(req.Role)
+	if !isValidRole(role) {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidRole)
+	}
+
+	exists, err := s.teams.Exists(ctx, teamID)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !exists {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	owners, err := s.members.CountOwnersForUpdate(ctx, tx, teamID)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	actorRole, targetRole, err := s.lockMembers(ctx, tx, teamID, userID, memberID)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if (role == "owner" || targetRole == "owner") && actorRole != "owner" {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	if role != targetRole {
+		if targetRole == "owner" && owners <= 1 {
+			return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrLastOwner)
+		}
+		if err := s.members.SetRole(ctx, tx, teamID, memberID, role); err != nil {
+			return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := s.addMemberEvent(ctx, tx, teamID, memberID, userID, repomysql.TeamMemberEventRoleChanged, targetRole, role); err != nil {
+			return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	member, err := s.members.Get(ctx, tx, teamID, memberID)
+	if err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.TeamMember{
+		TeamId:    api.UUID(member.TeamID),
+		UserId:    api.UUID(member.UserID),
+		Role:      api.TeamMemberRole(member.Role),
+		CreatedAt: member.CreatedAt,
+	}, nil
+}
+
+// RemoveMember исключает участника из команды. Доступно owner и admin; исключить owner может только owner.
+// Открытые задачи участника обрабатываются в той же транзакции по стратегии из params.
+// Исключение самого себя равносильно выходу из команды.
+func (s *Service) RemoveMember(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, memberID uuid.UUID, params api.DeleteApiV1TeamsIdMembersUserIdParams) error {
+	const methodCtx = "teams.Service.RemoveMember"
+
+	slog.Debug("вызов исключения участника", slog.String("context", methodCtx))
+
+	if memberID == userID {
+		leave := api.PostApiV1TeamsIdLeaveParams{OpenTasks: params.OpenTasks, ReassignTo: params.ReassignTo}
+		if err := s.LeaveTeam(ctx, userID, teamID, leave); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		return nil
+	}
+
+	handoff, err := newTasksHandoff(params.OpenTasks, params.ReassignTo)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	exists, err := s.teams.Exists(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !exists {
+		return fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := s.members.CountOwnersForUpdate(ctx, tx, teamID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	actorRole, targetRole, err := s.lockMembers(ctx, tx, teamID, userID, memberID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if targetRole == "owner" && actorRole != "owner" {
+		return fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	released, err := s.handoffTasks(ctx, tx, teamID, memberID, userID, handoff)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.members.Remove(ctx, tx, teamID, memberID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.addMemberEvent(ctx, tx, teamID, memberID, userID, repomysql.TeamMemberEventRemoved, targetRole, ""); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if released > 0 {
+		s.tasks.InvalidateTeam(ctx, teamID)
+	}
+	return nil
+}
+
+// LeaveTeam исключает пользователя из команды по его собственному запросу.
+// Последний owner покинуть команду не может. Открытые задачи обрабатываются так же, как в RemoveMember.
+func (s *Service) LeaveTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, params api.PostApiV1TeamsIdLeaveParams) error {
+	const methodCtx = "teams.Service.LeaveTeam"
+
+	slog.Debug("вызов выхода из команды", slog.String("context", methodCtx))
+
+	handoff, err := newTasksHandoff(params.OpenTasks, params.ReassignTo)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.ensureMember(ctx, teamID, userID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	owners, err := s.members.CountOwnersForUpdate(ctx, tx, teamID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	role, ok, err := s.members.GetRoleForUpdate(ctx, tx, teamID, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !ok {
+		return fmt.Errorf("%s: %w", methodCtx, ErrMemberNotFound)
+	}
+	if role == "owner" && owners <= 1 {
+		return fmt.Errorf("%s: %w", methodCtx, ErrLastOwner)
+	}
+
+	released, err := s.handoffTasks(ctx, tx, teamID, userID, userID, handoff)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.members.Remove(ctx, tx, teamID, userID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.addMemberEvent(ctx, tx, teamID, userID, userID, repomysql.TeamMemberEventLeft, role, ""); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if released > 0 {
+		s.tasks.InvalidateTeam(ctx, teamID)
+	}
+	return nil
+}
+
+// tasksHandoff описывает, что делать с открытыми задачами участника, покидающего команду.
+type tasksHandoff struct {
+	strategy   api.OpenTasksStrategy
+	reassignTo *uuid.UUID
+}
+
+// newTasksHandoff проверяет параметры передачи задач. По умолчанию задачи снимаются с участника.
+func newTasksHandoff(strategy *api.OpenTasksStrategy, reassignTo *api.ReassignTo) (tasksHandoff, error) {
+	handoff := tasksHandoff{strategy: api.OpenTasksStrategyUnassign, reassignTo: reassignTo}
+	if strategy != nil {
+		handoff.strategy = *strategy
+	}
+
+	switch handoff.strategy {
+	case api.OpenTasksStrategyReassign:
+		if reassignTo == nil {
+			return tasksHandoff{}, ErrInvalidReassign
+		}
+	case api.OpenTasksStrategyUnassign, api.OpenTasksStrategyReject:
+		if reassignTo != nil {
+			return tasksHandoff{}, ErrInvalidReassign
+		}
+	default:
+		return tasksHandoff{}, ErrInvalidStrategy
+	}
+	return handoff, nil
+}
+
+// handoffTasks обрабатывает открытые задачи участника в транзакции исключения и возвращает
+// количество измененных задач. Участник, которому переходят задачи, блокируется до конца транзакции.
+func (s *Service) handoffTasks(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, memberID uuid.UUID, actorID uuid.UUID, handoff tasksHandoff) (int, error) {
+	if handoff.reassignTo != nil {
+		if *handoff.reassignTo == memberID {
+			return 0, ErrInvalidReassign
+		}
+		_, ok, err := s.members.GetRoleForUpdate(ctx, tx, teamID, *handoff.reassignTo)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return 0, ErrInvalidReassign
+		}
+	}
+
+	taskIDs, err := s.tasks.OpenTasksByAssignee(ctx, tx, teamID, memberID)
+	if err != nil {
+		return 0, err
+	}
+	if len(taskIDs) > 0 && handoff.strategy == api.OpenTasksStrategyReject {
+		return 0, ErrOpenTasks
+	}
+
+	released := 0
+	for _, taskID := range taskIDs {
+		ok, err := s.tasks.ReleaseAssignee(ctx, tx, actorID, taskID, memberID, handoff.reassignTo)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			released++
+		}
+	}
+	return released, nil
+}
+
+// lockMembers блокирует записи инициатора и участника и возвращает их роли.
+// Инициатор должен быть owner или admin.
+func (s *Service) lockMembers(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, actorID uuid.UUID, memberID uuid.UUID) (string, string, error) {
+	actorRole, ok, err := s.members.GetRoleForUpdate(ctx, tx, teamID, actorID)
+	if err != nil {
+		return "", "", err
+	}
+	if !ok || (actorRole != "owner" && actorRole != "admin") {
+		return "", "", ErrForbidden
+	}
+
+	targetRole, ok, err := s.members.GetRoleForUpdate(ctx, tx, teamID, memberID)
+	if err != nil {
+		return "", "", err
+	}
+	if !ok {
+		return "", "", ErrMemberNotFound
+	}
+	return actorRole, targetRole, nil
+}
+
+// addMemberEvent записывает изменение участника в журнал. Пустая роль сохраняется как NULL.
+func (s *Service) addMemberEvent(ctx context.Context, exec repomysql.DBTX, teamID uuid.UUID, memberID uuid.UUID, actorID uuid.UUID, event string, oldRole string, newRole string) error {
+	record := repomysql.TeamMemberEventRecord{
+		ID:        uuid.New(),
+		TeamID:    teamID,
+		UserID:    &memberID,
+		ActorID:   actorID,
+		Event:     event,
+		CreatedAt: s.now().UTC(),
+	}
+	if oldRole != "" {
+		record.OldRole = &oldRole
+	}
+	if newRole != "" {
+		record.NewRole = &newRole
+	}
+	return s.events.Add(ctx, exec, record)
+}
+
+func isValidRole(role string) bool {
+	switch role {
+	case "owner", "admin", "member":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,99 @@
+package teams
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/config"
+)
+
+const defaultPurgeBatchSize = 100
+
+// PurgeRepository описывает окончательное удаление команд.
+type PurgeRepository interface {
+	ListDeletedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]uuid.UUID, error)
+	Purge(ctx context.Context, teamID uuid.UUID) error
+}
+
+// Cache описывает сброс кеша задач удаленной команды.
+type Cache interface {
+	InvalidateTeam(ctx context.Context, teamID uuid.UUID) error
+}
+
+// Purger окончательно удаляет команды, срок восстановления которых истек.
+type Purger struct {
+	teams     PurgeRepository
+	cache     Cache
+	grace     time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+// NewPurger создает обработчик окончательного удаления команд.
+func NewPurger(teams PurgeRepository, cache Cache, cfg config.TeamsConfig) (*Purger, error) {
+	const methodCtx = "teams.NewPurger"
+
+	slog.Debug("инициализация удаления команд", slog.String("context", methodCtx))
+
+	if teams == nil {
+		return nil, fmt.Errorf("%s: teams repo не задан", methodCtx)
+	}
+
+	grace := time.Duration(cfg.DeleteGraceHours) * time.Hour
+	if grace <= 0 {
+		grace = defaultDeleteGrace
+	}
+
+	return &Purger{
+		teams:     teams,
+		cache:     cache,
+		grace:     grace,
+		batchSize: defaultPurgeBatchSize,
+		now:       time.Now,
+	}, nil
+}
+
+// Run удаляет команды, помеченные удаленными раньше срока восстановления. Вызывается фоновым обработчиком.
+// Каждая команда удаляется отдельным запросом, зависимые записи удаляются каскадом.
+func (p *Purger) Run(ctx context.Context) error {
+	const methodCtx = "teams.Purger.Run"
+
+	deletedBefore := p.now().UTC().Add(-p.grace)
+	for {
+		teamIDs, err := p.teams.ListDeletedBefore(ctx, deletedBefore, p.batchSize)
+		if err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+
+		for _, teamID := range teamIDs {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("%s: %w", methodCtx, err)
+			}
+			if err := p.teams.Purge(ctx, teamID); err != nil {
+				return fmt.Errorf("%s: %w", methodCtx, err)
+			}
+
+			slog.Info("команда удалена окончательно",
+				slog.String("context", methodCtx),
+				slog.String("team_id", teamID.String()),
+			)
+			if p.cache != nil {
+				if err := p.cache.InvalidateTeam(ctx, teamID); err != nil {
+					slog.Warn("ошибка сброса кеша задач",
+						slog.String("context", methodCtx),
+						slog.String("team_id", teamID.String()),
+						slog.String("error", err.Error()),
+					)
+				}
+			}
+		}
+
+		if len(teamIDs) < p.batchSize {
+			return nil
+		}
+	}
+}
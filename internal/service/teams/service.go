@@ -3,6 +3,7 @@ package teams
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/breaker"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/mailer"
 	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
@@ -18,13 +20,18 @@ import (
 
 // Service реализует бизнес-логику команд и приглашений.
 type Service struct {
-	db      *sql.DB
-	teams   TeamsRepository
-	members MembersRepository
-	invites InvitesRepository
-	users   UsersRepository
-	mailer  mailer.Mailer
-	breaker breaker.Breaker
+	db        *sql.DB
+	teams     TeamsRepository
+	members   MembersRepository
+	invites   InvitesRepository
+	users     UsersRepository
+	events    MemberEventsRepository
+	tasks     TasksService
+	mailer    mailer.Mailer
+	breaker   breaker.Breaker
+	grace     time.Duration
+	inviteTTL time.Duration
+	now       func() time.Time
 }
 
 // TeamsRepository описывает работу с командами.
@@ -32,6 +39,14 @@ type TeamsRepository interface {
 	Create(ctx context.Context, exec repomysql.DBTX, id uuid.UUID, name string, createdBy uuid.UUID, createdAt time.Time) error
 	ListByUser(ctx context.Context, userID uuid.UUID) ([]repomysql.TeamRecord, error)
 	Exists(ctx context.Context, teamID uuid.UUID) (bool, error)
+	Get(ctx context.Context, teamID uuid.UUID) (repomysql.TeamRecord, error)
+	Rename(ctx context.Context, teamID uuid.UUID, name string, updatedAt time.Time) error
+	SoftDelete(ctx context.Context, teamID uuid.UUID, deletedBy uuid.UUID, deletedAt time.Time) (bool, error)
+	Restore(ctx context.Context, teamID uuid.UUID, userID uuid.UUID, deletedAfter time.Time, updatedAt time.Time) (bool, error)
+	GetTaskEditPolicy(ctx context.Context, teamID uuid.UUID) (string, error)
+	SetTaskEditPolicy(ctx context.Context, teamID uuid.UUID, policy string, updatedAt time.Time) error
+	GetArchiveAfterDays(ctx context.Context, teamID uuid.UUID) (*int, error)
+	SetArchiveAfterDays(ctx context.Context, teamID uuid.UUID, days *int, updatedAt time.Time) error
 }
 
 // MembersRepository описывает работу с участниками команды.
@@ -39,13 +54,37 @@ type MembersRepository interface {
 	Add(ctx context.Context, exec repomysql.DBTX, teamID uuid.UUID, userID uuid.UUID, role string, createdAt time.Time) error
 	GetRole(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (string, bool, error)
 	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
+	GetRoleForUpdate(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, userID uuid.UUID) (string, bool, error)
+	SetRole(ctx context.Context, exec repomysql.DBTX, teamID uuid.UUID, userID uuid.UUID, role string) error
+	Get(ctx context.Context, exec repomysql.DBTX, teamID uuid.UUID, userID uuid.UUID) (repomysql.TeamMemberRecord, error)
+	ListByTeam(ctx context.Context, filter repomysql.TeamMemberFilter) ([]repomysql.TeamMemberRecord, error)
+	CountByTeam(ctx context.Context, filter repomysql.TeamMemberFilter) (int, error)
+	CountOwnersForUpdate(ctx context.Context, tx *sql.Tx, teamID uuid.UUID) (int, error)
+	Remove(ctx context.Context, exec repomysql.DBTX, teamID uuid.UUID, userID uuid.UUID) error
+}
+
+// MemberEventsRepository описывает журнал изменений участников команды.
+type MemberEventsRepository interface {
+	Add(ctx context.Context, exec repomysql.DBTX, record repomysql.TeamMemberEventRecord) error
+}
+
+// TasksService описывает передачу задач участника, покидающего команду.
+// Методы с транзакцией выполняются в транзакции сервиса команд.
+type TasksService interface {
+	OpenTasksByAssignee(ctx context.Context, tx *sql.Tx, teamID uuid.UUID, userID uuid.UUID) ([]uuid.UUID, error)
+	ReleaseAssignee(ctx context.Context, tx *sql.Tx, actorID uuid.UUID, taskID uuid.UUID, userID uuid.UUID, replacement *uuid.UUID) (bool, error)
+	InvalidateTeam(ctx context.Context, teamID uuid.UUID)
 }
 
 // InvitesRepository описывает работу с приглашениями.
 type InvitesRepository interface {
 	Create(ctx context.Context, exec repomysql.DBTX, record repomysql.TeamInviteRecord) error
-	GetByCode(ctx context.Context, code string) (*repomysql.TeamInviteRecord, error)
-	DeleteByCode(ctx context.Context, exec repomysql.DBTX, code string) error
+	GetByCodeHash(ctx context.Context, codeHash string) (*repomysql.TeamInviteRecord, error)
+	Get(ctx context.Context, exec repomysql.DBTX, teamID uuid.UUID, inviteID uuid.UUID) (repomysql.TeamInviteRecord, error)
+	ListByTeam(ctx context.Context, filter repomysql.TeamInviteFilter) ([]repomysql.TeamInviteRecord, error)
+	CountByTeam(ctx context.Context, filter repomysql.TeamInviteFilter) (int, error)
+	Rotate(ctx context.Context, exec repomysql.DBTX, inviteID uuid.UUID, codeHash string, expiresAt time.Time) error
+	Delete(ctx context.Context, exec repomysql.DBTX, inviteID uuid.UUID) error
 }
 
 // UsersRepository описывает доступ к пользователям.
@@ -54,8 +93,9 @@ type UsersRepository interface {
 	GetEmailByID(ctx context.Context, id uuid.UUID) (string, bool, error)
 }
 
-// NewService создает сервис команд.
-func NewService(db *sql.DB, teams TeamsRepository, members MembersRepository, invites InvitesRepository, users UsersRepository, mailer mailer.Mailer, breaker breaker.Breaker) (*Service, error) {
+// NewService создает сервис команд. Нулевые сроки восстановления удаленной команды и действия приглашений
+// заменяются значениями по умолчанию.
+func NewService(db *sql.DB, teams TeamsRepository, members MembersRepository, invites InvitesRepository, users UsersRepository, events MemberEventsRepository, tasks TasksService, mailer mailer.Mailer, breaker breaker.Breaker, cfg config.TeamsConfig) (*Service, error) {
 	const methodCtx = "teams.NewService"
 
 	slog.Debug("инициализация сервиса команд", slog.String("context", methodCtx))
@@ -75,15 +115,35 @@ func NewService(db *sql.DB, teams TeamsRepository, members MembersRepository, in
 	if users == nil {
 		return nil, fmt.Errorf("%s: users repo не задан", methodCtx)
 	}
+	if events == nil {
+		return nil, fmt.Errorf("%s: events repo не задан", methodCtx)
+	}
+	if tasks == nil {
+		return nil, fmt.Errorf("%s: tasks service не задан", methodCtx)
+	}
+
+	grace := time.Duration(cfg.DeleteGraceHours) * time.Hour
+	if grace <= 0 {
+		grace = defaultDeleteGrace
+	}
+	inviteTTL := time.Duration(cfg.InviteTTLHours) * time.Hour
+	if inviteTTL <= 0 {
+		inviteTTL = defaultInviteTTL
+	}
 
 	return &Service{
-		db:      db,
-		teams:   teams,
-		members: members,
-		invites: invites,
-		users:   users,
-		mailer:  mailer,
-		breaker: breaker,
+		db:        db,
+		teams:     teams,
+		members:   members,
+		invites:   invites,
+		users:     users,
+		events:    events,
+		tasks:     tasks,
+		mailer:    mailer,
+		breaker:   breaker,
+		grace:     grace,
+		inviteTTL: inviteTTL,
+		now:       time.Now,
 	}, nil
 }
 
@@ -94,7 +154,7 @@ func (s *Service) CreateTeam(ctx context.Context, userID uuid.UUID, req api.Crea
 	slog.Debug("вызов создания команды", slog.String("context", methodCtx))
 
 	if strings.TrimSpace(req.Name) == "" {
-		return api.Team{}, fmt.Errorf("%s: имя команды не задано", methodCtx)
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidName)
 	}
 
 	now := time.Now().UTC()
@@ -112,6 +172,9 @@ func (s *Service) CreateTeam(ctx context.Context, userID uuid.UUID, req api.Crea
 	if err := s.members.Add(ctx, tx, teamID, userID, "owner", now); err != nil {
 		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
+	if err := s.addMemberEvent(ctx, tx, teamID, userID, userID, repomysql.TeamMemberEventJoined, "", "owner"); err != nil {
+		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
 
 	if err := tx.Commit(); err != nil {
 		return api.Team{}, fmt.Errorf("%s: %w", methodCtx, err)
@@ -149,7 +212,8 @@ func (s *Service) ListTeams(ctx context.Context, userID uuid.UUID) (api.TeamsLis
 	return api.TeamsListResponse{Items: items}, nil
 }
 
-// Invite создает приглашение в команду.
+// Invite создает приглашение в команду и отправляет код на email приглашенного.
+// В базе хранится только хеш кода, поэтому в ответе код не возвращается.
 func (s *Service) Invite(ctx context.Context, inviterID uuid.UUID, teamID uuid.UUID, req api.InviteRequest) (api.Invite, error) {
 	const methodCtx = "teams.Service.Invite"
 
@@ -159,81 +223,61 @@ func (s *Service) Invite(ctx context.Context, inviterID uuid.UUID, teamID uuid.U
 		return api.Invite{}, fmt.Errorf("%s: email не задан", methodCtx)
 	}
 
-	teamExists, err := s.teams.Exists(ctx, teamID)
-	if err != nil {
+	if err := s.ensureInviteManager(ctx, teamID, inviterID); err != nil {
 		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
-	if !teamExists {
-		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	if err := s.ensureNotMember(ctx, teamID, string(req.Email)); err != nil {
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	role, ok, err := s.members.GetRole(ctx, teamID, inviterID)
+	code, err := newInviteCode()
 	if err != nil {
 		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
-	if !ok || (role != "owner" && role != "admin") {
-		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+
+	now := s.now().UTC().Truncate(time.Second)
+	record := repomysql.TeamInviteRecord{
+		ID:        uuid.New(),
+		TeamID:    teamID,
+		Email:     string(req.Email),
+		InviterID: inviterID,
+		CodeHash:  hashInviteCode(code),
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.inviteTTL),
 	}
 
-	foundID, exists, err := s.users.FindIDByEmail(ctx, string(req.Email))
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
-	if exists {
-		isMember, err := s.members.IsMember(ctx, teamID, foundID)
-		if err != nil {
-			return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
-		}
-		if isMember {
-			return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, ErrAlreadyMember)
-		}
-	}
-
-	now := time.Now().UTC()
-	inviteID := uuid.New()
-	code := uuid.NewString()
+	defer func() { _ = tx.Rollback() }()
 
-	err = s.invites.Create(ctx, nil, repomysql.TeamInviteRecord{
-		ID:        inviteID,
+	if err := s.invites.Create(ctx, tx, record); err != nil {
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.events.Add(ctx, tx, repomysql.TeamMemberEventRecord{
+		ID:        uuid.New(),
 		TeamID:    teamID,
-		Email:     string(req.Email),
-		InviterID: inviterID,
-		Code:      code,
+		Email:     &record.Email,
+		ActorID:   inviterID,
+		Event:     repomysql.TeamMemberEventInvited,
 		CreatedAt: now,
-	})
-	if err != nil {
+	}); err != nil {
 		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	if s.mailer != nil {
-		send := func() error {
-			return s.mailer.Send(ctx, mailer.Message{
-				To:      string(req.Email),
-				Subject: "Приглашение в команду",
-				Body:    fmt.Sprintf("Ваш код приглашения: %s", code),
-			})
-		}
-		if s.breaker != nil {
-			err = s.breaker.Execute(send)
-		} else {
-			err = send()
-		}
-		if err != nil {
-			return api.Invite{}, fmt.Errorf("%s: ошибка отправки письма: %w", methodCtx, err)
-		}
+	if err := tx.Commit(); err != nil {
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
-	return api.Invite{
-		Id:        api.UUID(inviteID),
-		TeamId:    api.UUID(teamID),
-		Email:     req.Email,
-		InviterId: api.UUID(inviterID),
-		Code:      code,
-		CreatedAt: now,
-	}, nil
+	if err := s.sendInvite(ctx, record.Email, code, record.ExpiresAt); err != nil {
+		return api.Invite{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return mapInvite(record), nil
 }
 
-// AcceptInvite принимает приглашение по коду.
+// AcceptInvite принимает приглашение по коду. Истекшее приглашение считается ненайденным.
 func (s *Service) AcceptInvite(ctx context.Context, userID uuid.UUID, req api.AcceptInviteRequest) (api.TeamMember, error) {
 	const methodCtx = "teams.Service.AcceptInvite"
 
@@ -243,11 +287,13 @@ func (s *Service) AcceptInvite(ctx context.Context, userID uuid.UUID, req api.Ac
 		return api.TeamMember{}, fmt.Errorf("%s: код не задан", methodCtx)
 	}
 
-	invite, err := s.invites.GetByCode(ctx, req.Code)
+	now := s.now().UTC()
+
+	invite, err := s.invites.GetByCodeHash(ctx, hashInviteCode(req.Code))
 	if err != nil {
 		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
-	if invite == nil {
+	if invite == nil || !invite.ExpiresAt.After(now) {
 		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrInviteNotFound)
 	}
 
@@ -270,8 +316,6 @@ func (s *Service) AcceptInvite(ctx context.Context, userID uuid.UUID, req api.Ac
 		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, ErrAlreadyMember)
 	}
 
-	now := time.Now().UTC()
-
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
@@ -281,7 +325,10 @@ func (s *Service) AcceptInvite(ctx context.Context, userID uuid.UUID, req api.Ac
 	if err := s.members.Add(ctx, tx, invite.TeamID, userID, "member", now); err != nil {
 		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
-	if err := s.invites.DeleteByCode(ctx, tx, invite.Code); err != nil {
+	if err := s.addMemberEvent(ctx, tx, invite.TeamID, userID, userID, repomysql.TeamMemberEventJoined, "", "member"); err != nil {
+		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if err := s.invites.Delete(ctx, tx, invite.ID); err != nil {
 		return api.TeamMember{}, fmt.Errorf("%s: %w", methodCtx, err)
 	}
 
@@ -296,3 +343,137 @@ func (s *Service) AcceptInvite(ctx context.Context, userID uuid.UUID, req api.Ac
 		CreatedAt: now,
 	}, nil
 }
+
+// GetTaskPolicy возвращает политику редактирования задач. Доступно участникам команды.
+func (s *Service) GetTaskPolicy(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.TeamTaskPolicy, error) {
+	const methodCtx = "teams.Service.GetTaskPolicy"
+
+	slog.Debug("вызов получения политики задач", slog.String("context", methodCtx))
+
+	policy, err := s.teams.GetTaskEditPolicy(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	isMember, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !isMember {
+		return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	return api.TeamTaskPolicy{
+		TeamId:         api.UUID(teamID),
+		TaskEditPolicy: api.TaskEditPolicy(policy),
+	}, nil
+}
+
+// UpdateTaskPolicy изменяет политику редактирования задач. Доступно owner и admin.
+func (s *Service) UpdateTaskPolicy(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.UpdateTeamTaskPolicyRequest) (api.TeamTaskPolicy, error) {
+	const methodCtx = "teams.Service.UpdateTaskPolicy"
+
+	slog.Debug("вызов изменения политики задач", slog.String("context", methodCtx))
+
+	switch req.TaskEditPolicy {
+	case api.Strict, api.AssigneeStatus, api.AssigneeFull:
+	default:
+		return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidPolicy)
+	}
+
+	teamExists, err := s.teams.Exists(ctx, teamID)
+	if err != nil {
+		return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !teamExists {
+		return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	}
+
+	role, ok, err := s.members.GetRole(ctx, teamID, userID)
+	if err != nil {
+		return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !ok || (role != "owner" && role != "admin") {
+		return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	if err := s.teams.SetTaskEditPolicy(ctx, teamID, string(req.TaskEditPolicy), time.Now().UTC()); err != nil {
+		return api.TeamTaskPolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.TeamTaskPolicy{
+		TeamId:         api.UUID(teamID),
+		TaskEditPolicy: req.TaskEditPolicy,
+	}, nil
+}
+
+// maxArchiveAfterDays ограничивает срок архивации десятью годами.
+const maxArchiveAfterDays = 3650
+
+// GetArchivePolicy возвращает политику архивации завершенных задач. Доступно участникам команды.
+func (s *Service) GetArchivePolicy(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.TeamArchivePolicy, error) {
+	const methodCtx = "teams.Service.GetArchivePolicy"
+
+	slog.Debug("вызов получения политики архивации", slog.String("context", methodCtx))
+
+	days, err := s.teams.GetArchiveAfterDays(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+		}
+		return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	isMember, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !isMember {
+		return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	return api.TeamArchivePolicy{
+		TeamId:           api.UUID(teamID),
+		ArchiveAfterDays: days,
+	}, nil
+}
+
+// UpdateArchivePolicy изменяет политику архивации завершенных задач. Доступно owner и admin.
+// Пустой archive_after_days выключает архивацию; уже архивные задачи остаются в архиве.
+func (s *Service) UpdateArchivePolicy(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.UpdateTeamArchivePolicyRequest) (api.TeamArchivePolicy, error) {
+	const methodCtx = "teams.Service.UpdateArchivePolicy"
+
+	slog.Debug("вызов изменения политики архивации", slog.String("context", methodCtx))
+
+	if req.ArchiveAfterDays != nil && (*req.ArchiveAfterDays < 1 || *req.ArchiveAfterDays > maxArchiveAfterDays) {
+		return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidArchiveDays)
+	}
+
+	teamExists, err := s.teams.Exists(ctx, teamID)
+	if err != nil {
+		return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !teamExists {
+		return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrNotFound)
+	}
+
+	role, ok, err := s.members.GetRole(ctx, teamID, userID)
+	if err != nil {
+		return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !ok || (role != "owner" && role != "admin") {
+		return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, ErrForbidden)
+	}
+
+	if err := s.teams.SetArchiveAfterDays(ctx, teamID, req.ArchiveAfterDays, time.Now().UTC()); err != nil {
+		return api.TeamArchivePolicy{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.TeamArchivePolicy{
+		TeamId:           api.UUID(teamID),
+		ArchiveAfterDays: req.ArchiveAfterDays,
+	}, nil
+}
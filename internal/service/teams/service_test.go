@@ -2,22 +2,29 @@ package teams
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/Seraf-seraf/mkk_test/internal/api"
+	"github.com/Seraf-seraf/mkk_test/internal/config"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/breaker"
 	"github.com/Seraf-seraf/mkk_test/internal/pkg/mailer"
 	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/service/tasks"
 	"github.com/Seraf-seraf/mkk_test/internal/tests"
 )
 
 type TeamsSuite struct {
 	tests.IntegrationSuite
 	service    *Service
+	tasks      *tasks.Service
 	mailer     *mailer.MockMailer
 	ownerID    uuid.UUID
 	adminID    uuid.UUID
@@ -39,8 +46,11 @@ func (s *TeamsSuite) SetupTest() {
 	s.TruncateTables(
 		"task_comments",
 		"task_history",
+		"task_assignees",
+		"task_watchers",
 		"tasks",
 		"team_invites",
+		"team_member_events",
 		"team_members",
 		"teams",
 		"users",
@@ -64,8 +74,24 @@ func (s *TeamsSuite) SetupTest() {
 	membersRepo := repomysql.NewTeamMembersRepo(s.DB)
 	invitesRepo := repomysql.NewTeamInvitesRepo(s.DB)
 	usersRepo := repomysql.NewUsersRepo(s.DB)
+	eventsRepo := repomysql.NewTeamMemberEventsRepo(s.DB)
+
+	s.tasks, err = tasks.NewService(
+		s.DB,
+		repomysql.NewTasksRepo(s.DB),
+		repomysql.NewTaskAssigneesRepo(s.DB),
+		membersRepo,
+		teamsRepo,
+		repomysql.NewTaskHistoryRepo(s.DB),
+		repomysql.NewTaskWatchersRepo(s.DB),
+		repomysql.NewTaskViewsRepo(s.DB),
+		repomysql.NewTaskLinksRepo(s.DB),
+		repomysql.NewTaskAttachmentsRepo(s.DB),
+		nil,
+	)
+	s.Require().NoError(err, methodCtx)
 
-	s.service, err = NewService(s.DB, teamsRepo, membersRepo, invitesRepo, usersRepo, s.mailer, cb)
+	s.service, err = NewService(s.DB, teamsRepo, membersRepo, invitesRepo, usersRepo, eventsRepo, s.tasks, s.mailer, cb, config.TeamsConfig{})
 	s.Require().NoError(err, methodCtx)
 }
 
@@ -87,6 +113,16 @@ func (s *TeamsSuite) TestCreateTeam() {
 	).Scan(&role)
 	s.Require().NoError(err, methodCtx)
 	s.Equal("owner", role)
+
+	var event string
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT event FROM team_member_events WHERE team_id = ? AND user_id = ?",
+		resp.Id.String(),
+		s.ownerID.String(),
+	).Scan(&event)
+	s.Require().NoError(err, methodCtx)
+	s.Equal("joined", event)
 }
 
 func (s *TeamsSuite) TestListTeams() {
@@ -117,21 +153,30 @@ func (s *TeamsSuite) TestInviteByOwner() {
 	ctx := context.Background()
 	resp, err := s.service.Invite(ctx, s.ownerID, s.teamID, api.InviteRequest{Email: "invitee@example.com"})
 	s.Require().NoError(err, methodCtx)
-	s.NotEmpty(resp.Code)
 	s.Equal(openapi_types.Email("invitee@example.com"), resp.Email)
-
-	var count int
-	err = s.DB.QueryRowContext(
-		ctx,
-		"SELECT COUNT(*) FROM team_invites WHERE code = ?",
-		resp.Code,
-	).Scan(&count)
-	s.Require().NoError(err, methodCtx)
-	s.Equal(1, count)
+	s.WithinDuration(resp.CreatedAt.Add(defaultInviteTTL), resp.ExpiresAt, time.Second)
 
 	msgs := s.mailer.Messages()
 	s.Require().Len(msgs, 1, methodCtx)
 	s.Equal("invitee@example.com", msgs[0].To)
+	code := inviteCode(msgs[0].Body)
+	s.Require().NotEmpty(code, methodCtx)
+
+	var codeHash string
+	err = s.DB.QueryRowContext(ctx, "SELECT code_hash FROM team_invites WHERE id = ?", resp.Id.String()).Scan(&codeHash)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(hashInviteCode(code), codeHash)
+	s.NotContains(codeHash, code, "код не хранится в открытом виде")
+
+	var email, actorID string
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT email, actor_id FROM team_member_events WHERE team_id = ? AND event = 'invited' AND user_id IS NULL",
+		s.teamID.String(),
+	).Scan(&email, &actorID)
+	s.Require().NoError(err, "приглашение записывается в журнал участников")
+	s.Equal("invitee@example.com", email)
+	s.Equal(s.ownerID.String(), actorID)
 }
 
 func (s *TeamsSuite) TestInviteForbidden() {
@@ -195,11 +240,34 @@ func (s *TeamsSuite) TestAcceptInvite() {
 
 	err = s.DB.QueryRowContext(
 		ctx,
-		"SELECT COUNT(*) FROM team_invites WHERE code = ?",
-		code,
+		"SELECT COUNT(*) FROM team_invites WHERE code_hash = ?",
+		hashInviteCode(code),
 	).Scan(&count)
 	s.Require().NoError(err, methodCtx)
 	s.Equal(0, count)
+
+	var newRole string
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT new_role FROM team_member_events WHERE team_id = ? AND user_id = ? AND event = 'joined'",
+		s.teamID.String(),
+		inviteUserID.String(),
+	).Scan(&newRole)
+	s.Require().NoError(err, "вступление записывается в журнал участников")
+	s.Equal("member", newRole)
+}
+
+func (s *TeamsSuite) TestAcceptInviteExpired() {
+	const methodCtx = "teams.TeamsSuite.TestAcceptInviteExpired"
+
+	ctx := context.Background()
+	inviteUserID := s.CreateUser("invitee@example.com")
+	inviteID, code := s.CreateInvite(s.teamID, s.ownerID, "invitee@example.com", "")
+	s.expireInvite(inviteID)
+
+	_, err := s.service.AcceptInvite(ctx, inviteUserID, api.AcceptInviteRequest{Code: code})
+	s.Require().Error(err, methodCtx)
+	s.ErrorIs(err, ErrInviteNotFound)
 }
 
 func (s *TeamsSuite) TestAcceptInviteWrongEmail() {
@@ -253,3 +321,623 @@ func (s *TeamsSuite) TearDownTest() {
 		s.T().Log(methodCtx)
 	}
 }
+
+func (s *TeamsSuite) TestTaskPolicy() {
+	const methodCtx = "teams.TeamsSuite.TestTaskPolicy"
+
+	ctx := context.Background()
+
+	resp, err := s.service.GetTaskPolicy(ctx, s.memberID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.AssigneeStatus, resp.TaskEditPolicy)
+
+	_, err = s.service.UpdateTaskPolicy(ctx, s.memberID, s.teamID, api.UpdateTeamTaskPolicyRequest{TaskEditPolicy: api.Strict})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.UpdateTaskPolicy(ctx, s.adminID, s.teamID, api.UpdateTeamTaskPolicyRequest{TaskEditPolicy: "anyone"})
+	s.ErrorIs(err, ErrInvalidPolicy, methodCtx)
+
+	_, err = s.service.UpdateTaskPolicy(ctx, s.adminID, s.teamID, api.UpdateTeamTaskPolicyRequest{TaskEditPolicy: api.Strict})
+	s.Require().NoError(err, methodCtx)
+
+	resp, err = s.service.GetTaskPolicy(ctx, s.ownerID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.Strict, resp.TaskEditPolicy)
+
+	_, err = s.service.GetTaskPolicy(ctx, s.outsiderID, s.teamID)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.GetTaskPolicy(ctx, s.ownerID, uuid.New())
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *TeamsSuite) TestArchivePolicy() {
+	const methodCtx = "teams.TeamsSuite.TestArchivePolicy"
+
+	ctx := context.Background()
+
+	resp, err := s.service.GetArchivePolicy(ctx, s.memberID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Nil(resp.ArchiveAfterDays, "по умолчанию архивация выключена")
+
+	days := 30
+	_, err = s.service.UpdateArchivePolicy(ctx, s.memberID, s.teamID, api.UpdateTeamArchivePolicyRequest{ArchiveAfterDays: &days})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	invalid := 0
+	_, err = s.service.UpdateArchivePolicy(ctx, s.adminID, s.teamID, api.UpdateTeamArchivePolicyRequest{ArchiveAfterDays: &invalid})
+	s.ErrorIs(err, ErrInvalidArchiveDays, methodCtx)
+
+	_, err = s.service.UpdateArchivePolicy(ctx, s.adminID, s.teamID, api.UpdateTeamArchivePolicyRequest{ArchiveAfterDays: &days})
+	s.Require().NoError(err, methodCtx)
+
+	resp, err = s.service.GetArchivePolicy(ctx, s.ownerID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(resp.ArchiveAfterDays)
+	s.Equal(days, *resp.ArchiveAfterDays)
+
+	_, err = s.service.UpdateArchivePolicy(ctx, s.ownerID, s.teamID, api.UpdateTeamArchivePolicyRequest{})
+	s.Require().NoError(err, methodCtx)
+	resp, err = s.service.GetArchivePolicy(ctx, s.ownerID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Nil(resp.ArchiveAfterDays, "null выключает архивацию")
+
+	_, err = s.service.GetArchivePolicy(ctx, s.outsiderID, s.teamID)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.UpdateArchivePolicy(ctx, s.ownerID, uuid.New(), api.UpdateTeamArchivePolicyRequest{ArchiveAfterDays: &days})
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *TeamsSuite) TestUpdateTeam() {
+	const methodCtx = "teams.TeamsSuite.TestUpdateTeam"
+
+	ctx := context.Background()
+
+	_, err := s.service.UpdateTeam(ctx, s.memberID, s.teamID, api.UpdateTeamRequest{Name: "Renamed"})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.UpdateTeam(ctx, s.adminID, s.teamID, api.UpdateTeamRequest{Name: "   "})
+	s.ErrorIs(err, ErrInvalidName, methodCtx)
+
+	resp, err := s.service.UpdateTeam(ctx, s.adminID, s.teamID, api.UpdateTeamRequest{Name: " Renamed "})
+	s.Require().NoError(err, methodCtx)
+	s.Equal("Renamed", resp.Name)
+
+	team, err := s.service.GetTeam(ctx, s.memberID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal("Renamed", team.Name)
+
+	_, err = s.service.GetTeam(ctx, s.outsiderID, s.teamID)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.GetTeam(ctx, s.ownerID, uuid.New())
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *TeamsSuite) TestAcceptInviteDeletedTeam() {
+	const methodCtx = "teams.TeamsSuite.TestAcceptInviteDeletedTeam"
+
+	ctx := context.Background()
+	inviteUserID := s.CreateUser("invitee@example.com")
+	_, code := s.CreateInvite(s.teamID, s.ownerID, "invitee@example.com", "")
+
+	_, err := s.service.DeleteTeam(ctx, s.ownerID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.service.AcceptInvite(ctx, inviteUserID, api.AcceptInviteRequest{Code: code})
+	s.ErrorIs(err, ErrInviteNotFound, "приглашение удаленной команды не действует")
+
+	var count int
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM team_members WHERE team_id = ? AND user_id = ?",
+		s.teamID.String(),
+		inviteUserID.String(),
+	).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(0, count)
+}
+
+func (s *TeamsSuite) TestDeleteAndRestoreTeam() {
+	const methodCtx = "teams.TeamsSuite.TestDeleteAndRestoreTeam"
+
+	ctx := context.Background()
+
+	_, err := s.service.DeleteTeam(ctx, s.adminID, s.teamID)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	resp, err := s.service.DeleteTeam(ctx, s.ownerID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(s.teamID, resp.TeamId)
+	s.Equal(resp.DeletedAt.Add(defaultDeleteGrace), resp.PurgeAfter)
+
+	_, err = s.service.GetTeam(ctx, s.ownerID, s.teamID)
+	s.ErrorIs(err, ErrNotFound, "удаленная команда скрыта")
+
+	list, err := s.service.ListTeams(ctx, s.memberID)
+	s.Require().NoError(err, methodCtx)
+	s.Empty(list.Items, "удаленная команда не попадает в список")
+
+	_, err = s.service.GetTaskPolicy(ctx, s.memberID, s.teamID)
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+
+	_, err = s.service.DeleteTeam(ctx, s.ownerID, s.teamID)
+	s.ErrorIs(err, ErrNotFound, "повторное удаление")
+
+	_, err = s.service.RestoreTeam(ctx, s.adminID, s.teamID)
+	s.ErrorIs(err, ErrNotFound, "восстанавливать может только owner")
+
+	team, err := s.service.RestoreTeam(ctx, s.ownerID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(s.teamID, team.Id)
+
+	_, err = s.service.GetTeam(ctx, s.memberID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.DB.ExecContext(
+		ctx,
+		"UPDATE teams SET deleted_at = ?, deleted_by = ? WHERE id = ?",
+		time.Now().UTC().Add(-defaultDeleteGrace-time.Hour),
+		s.ownerID.String(),
+		s.teamID.String(),
+	)
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.service.RestoreTeam(ctx, s.ownerID, s.teamID)
+	s.ErrorIs(err, ErrNotFound, "срок восстановления истек")
+}
+
+func (s *TeamsSuite) TestTransferOwnership() {
+	const methodCtx = "teams.TeamsSuite.TestTransferOwnership"
+
+	ctx := context.Background()
+
+	_, err := s.service.TransferOwnership(ctx, s.adminID, s.teamID, api.TransferTeamRequest{UserId: s.memberID})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.TransferOwnership(ctx, s.ownerID, s.teamID, api.TransferTeamRequest{UserId: s.ownerID})
+	s.ErrorIs(err, ErrInvalidTransfer, methodCtx)
+
+	_, err = s.service.TransferOwnership(ctx, s.ownerID, s.teamID, api.TransferTeamRequest{UserId: s.outsiderID})
+	s.ErrorIs(err, ErrMemberNotFound, methodCtx)
+
+	resp, err := s.service.TransferOwnership(ctx, s.ownerID, s.teamID, api.TransferTeamRequest{UserId: s.memberID})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(s.memberID, resp.UserId)
+	s.Equal(api.TeamMemberRole("owner"), resp.Role)
+	s.False(resp.CreatedAt.IsZero(), "дата вступления участника")
+
+	roles := map[uuid.UUID]string{}
+	for _, userID := range []uuid.UUID{s.ownerID, s.memberID} {
+		var role string
+		err = s.DB.QueryRowContext(
+			ctx,
+			"SELECT role FROM team_members WHERE team_id = ? AND user_id = ?",
+			s.teamID.String(),
+			userID.String(),
+		).Scan(&role)
+		s.Require().NoError(err, methodCtx)
+		roles[userID] = role
+	}
+	s.Equal("admin", roles[s.ownerID])
+	s.Equal("owner", roles[s.memberID])
+
+	_, err = s.service.DeleteTeam(ctx, s.ownerID, s.teamID)
+	s.ErrorIs(err, ErrForbidden, "бывший owner не может удалить команду")
+}
+
+func (s *TeamsSuite) TestPurgeDeletedTeams() {
+	const methodCtx = "teams.TeamsSuite.TestPurgeDeletedTeams"
+
+	ctx := context.Background()
+	taskID := s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Task", "")
+	recentTeam := s.CreateTeam("Recent", s.ownerID)
+	s.AddTeamMember(recentTeam, s.ownerID, "owner")
+
+	_, err := s.service.DeleteTeam(ctx, s.ownerID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	_, err = s.service.DeleteTeam(ctx, s.ownerID, recentTeam)
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.DB.ExecContext(
+		ctx,
+		"UPDATE teams SET deleted_at = ? WHERE id = ?",
+		time.Now().UTC().Add(-defaultDeleteGrace-time.Hour),
+		s.teamID.String(),
+	)
+	s.Require().NoError(err, methodCtx)
+
+	purger, err := NewPurger(repomysql.NewTeamsRepo(s.DB), nil, config.TeamsConfig{})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NoError(purger.Run(ctx), methodCtx)
+
+	var count int
+	err = s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM teams WHERE id = ?", s.teamID.String()).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(0, count, "команда удалена окончательно")
+
+	err = s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE id = ?", taskID.String()).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(0, count, "задачи удалены каскадом")
+
+	err = s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM team_members WHERE team_id = ?", s.teamID.String()).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(0, count, "участники удалены каскадом")
+
+	err = s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM teams WHERE id = ?", recentTeam.String()).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(1, count, "команда в сроке восстановления не удаляется")
+}
+
+func (s *TeamsSuite) TestListMembers() {
+	const methodCtx = "teams.TeamsSuite.TestListMembers"
+
+	ctx := context.Background()
+	underscoreID := s.CreateUser("m_x@example.com")
+	s.AddTeamMember(s.teamID, underscoreID, "member")
+
+	resp, err := s.service.ListMembers(ctx, s.memberID, s.teamID, api.GetApiV1TeamsIdMembersParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(4, resp.Total)
+	s.Require().Len(resp.Items, 4)
+	s.Equal(openapi_types.Email("admin@example.com"), resp.Items[0].Email, "сортировка по email")
+	s.Equal(api.TeamMemberProfileRole("admin"), resp.Items[0].Role)
+	s.False(resp.Items[0].CreatedAt.IsZero())
+
+	prefix := "M"
+	resp, err = s.service.ListMembers(ctx, s.memberID, s.teamID, api.GetApiV1TeamsIdMembersParams{Email: &prefix})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(2, resp.Total, "префикс без учета регистра")
+
+	prefix = "m_"
+	resp, err = s.service.ListMembers(ctx, s.memberID, s.teamID, api.GetApiV1TeamsIdMembersParams{Email: &prefix})
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(resp.Items, 1, "подчеркивание не считается шаблоном")
+	s.Equal(underscoreID, resp.Items[0].UserId)
+
+	page, perPage := 2, 3
+	resp, err = s.service.ListMembers(ctx, s.memberID, s.teamID, api.GetApiV1TeamsIdMembersParams{Page: &page, PerPage: &perPage})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(4, resp.Total)
+	s.Len(resp.Items, 1)
+
+	_, err = s.service.ListMembers(ctx, s.outsiderID, s.teamID, api.GetApiV1TeamsIdMembersParams{})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.ListMembers(ctx, s.ownerID, uuid.New(), api.GetApiV1TeamsIdMembersParams{})
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *TeamsSuite) TestUpdateMemberRole() {
+	const methodCtx = "teams.TeamsSuite.TestUpdateMemberRole"
+
+	ctx := context.Background()
+
+	_, err := s.service.UpdateMemberRole(ctx, s.memberID, s.teamID, s.adminID, api.UpdateMemberRoleRequest{Role: "member"})
+	s.ErrorIs(err, ErrForbidden, "member не меняет роли")
+
+	_, err = s.service.UpdateMemberRole(ctx, s.adminID, s.teamID, s.memberID, api.UpdateMemberRoleRequest{Role: "owner"})
+	s.ErrorIs(err, ErrForbidden, "admin не назначает owner")
+
+	_, err = s.service.UpdateMemberRole(ctx, s.adminID, s.teamID, s.ownerID, api.UpdateMemberRoleRequest{Role: "member"})
+	s.ErrorIs(err, ErrForbidden, "admin не понижает owner")
+
+	_, err = s.service.UpdateMemberRole(ctx, s.adminID, s.teamID, s.outsiderID, api.UpdateMemberRoleRequest{Role: "admin"})
+	s.ErrorIs(err, ErrMemberNotFound, methodCtx)
+
+	_, err = s.service.UpdateMemberRole(ctx, s.adminID, s.teamID, s.memberID, api.UpdateMemberRoleRequest{Role: "guest"})
+	s.ErrorIs(err, ErrInvalidRole, methodCtx)
+
+	resp, err := s.service.UpdateMemberRole(ctx, s.adminID, s.teamID, s.memberID, api.UpdateMemberRoleRequest{Role: "admin"})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(api.TeamMemberRole("admin"), resp.Role)
+
+	_, err = s.service.UpdateMemberRole(ctx, s.ownerID, s.teamID, s.ownerID, api.UpdateMemberRoleRequest{Role: "admin"})
+	s.ErrorIs(err, ErrLastOwner, methodCtx)
+
+	_, err = s.service.UpdateMemberRole(ctx, s.ownerID, s.teamID, s.adminID, api.UpdateMemberRoleRequest{Role: "owner"})
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.service.UpdateMemberRole(ctx, s.ownerID, s.teamID, s.ownerID, api.UpdateMemberRoleRequest{Role: "member"})
+	s.Require().NoError(err, "второй owner позволяет понизить первого")
+
+	var event, oldRole, newRole, actorID string
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT event, old_role, new_role, actor_id FROM team_member_events WHERE team_id = ? AND user_id = ?",
+		s.teamID.String(),
+		s.memberID.String(),
+	).Scan(&event, &oldRole, &newRole, &actorID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal("role_changed", event)
+	s.Equal("member", oldRole)
+	s.Equal("admin", newRole)
+	s.Equal(s.adminID.String(), actorID)
+
+	var count int
+	err = s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM team_member_events WHERE team_id = ?", s.teamID.String()).Scan(&count)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(3, count, "отклоненные изменения не записываются")
+}
+
+func (s *TeamsSuite) TestRemoveMemberAndLeave() {
+	const methodCtx = "teams.TeamsSuite.TestRemoveMemberAndLeave"
+
+	ctx := context.Background()
+
+	err := s.service.RemoveMember(ctx, s.memberID, s.teamID, s.adminID, api.DeleteApiV1TeamsIdMembersUserIdParams{})
+	s.ErrorIs(err, ErrForbidden, "member не исключает")
+
+	err = s.service.RemoveMember(ctx, s.adminID, s.teamID, s.ownerID, api.DeleteApiV1TeamsIdMembersUserIdParams{})
+	s.ErrorIs(err, ErrForbidden, "admin не исключает owner")
+
+	err = s.service.RemoveMember(ctx, s.adminID, s.teamID, s.memberID, api.DeleteApiV1TeamsIdMembersUserIdParams{})
+	s.Require().NoError(err, methodCtx)
+
+	isMember, err := repomysql.NewTeamMembersRepo(s.DB).IsMember(ctx, s.teamID, s.memberID)
+	s.Require().NoError(err, methodCtx)
+	s.False(isMember)
+
+	err = s.service.RemoveMember(ctx, s.adminID, s.teamID, s.memberID, api.DeleteApiV1TeamsIdMembersUserIdParams{})
+	s.ErrorIs(err, ErrMemberNotFound, methodCtx)
+
+	err = s.service.LeaveTeam(ctx, s.ownerID, s.teamID, api.PostApiV1TeamsIdLeaveParams{})
+	s.ErrorIs(err, ErrLastOwner, methodCtx)
+
+	err = s.service.RemoveMember(ctx, s.ownerID, s.teamID, s.ownerID, api.DeleteApiV1TeamsIdMembersUserIdParams{})
+	s.ErrorIs(err, ErrLastOwner, "исключение себя равносильно выходу")
+
+	err = s.service.LeaveTeam(ctx, s.adminID, s.teamID, api.PostApiV1TeamsIdLeaveParams{})
+	s.Require().NoError(err, methodCtx)
+
+	err = s.service.LeaveTeam(ctx, s.outsiderID, s.teamID, api.PostApiV1TeamsIdLeaveParams{})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	rows, err := s.DB.QueryContext(
+		ctx,
+		"SELECT user_id, actor_id, event, old_role FROM team_member_events WHERE team_id = ? ORDER BY event",
+		s.teamID.String(),
+	)
+	s.Require().NoError(err, methodCtx)
+	defer rows.Close()
+
+	var events []string
+	for rows.Next() {
+		var userID, actorID, event, oldRole string
+		s.Require().NoError(rows.Scan(&userID, &actorID, &event, &oldRole), methodCtx)
+		events = append(events, event+":"+userID+":"+actorID+":"+oldRole)
+	}
+	s.Require().NoError(rows.Err(), methodCtx)
+	s.Equal([]string{
+		"left:" + s.adminID.String() + ":" + s.adminID.String() + ":admin",
+		"removed:" + s.memberID.String() + ":" + s.adminID.String() + ":member",
+	}, events)
+}
+
+func (s *TeamsSuite) TestRemoveMemberOpenTasks() {
+	const methodCtx = "teams.TeamsSuite.TestRemoveMemberOpenTasks"
+
+	ctx := context.Background()
+	openTaskID := s.CreateTask(s.teamID, s.ownerID, &s.memberID, "todo", "Open", "open task")
+	doneTaskID := s.CreateTask(s.teamID, s.ownerID, &s.memberID, "done", "Done", "done task")
+
+	reject := api.OpenTasksStrategyReject
+	err := s.service.RemoveMember(ctx, s.adminID, s.teamID, s.memberID, api.DeleteApiV1TeamsIdMembersUserIdParams{OpenTasks: &reject})
+	s.ErrorIs(err, ErrOpenTasks, methodCtx)
+
+	reassign := api.OpenTasksStrategyReassign
+	err = s.service.RemoveMember(ctx, s.adminID, s.teamID, s.memberID, api.DeleteApiV1TeamsIdMembersUserIdParams{OpenTasks: &reassign})
+	s.ErrorIs(err, ErrInvalidReassign, "reassign без reassign_to")
+
+	err = s.service.RemoveMember(ctx, s.adminID, s.teamID, s.memberID, api.DeleteApiV1TeamsIdMembersUserIdParams{OpenTasks: &reassign, ReassignTo: &s.outsiderID})
+	s.ErrorIs(err, ErrInvalidReassign, "reassign_to не участник команды")
+
+	err = s.service.RemoveMember(ctx, s.adminID, s.teamID, s.memberID, api.DeleteApiV1TeamsIdMembersUserIdParams{OpenTasks: &reassign, ReassignTo: &s.memberID})
+	s.ErrorIs(err, ErrInvalidReassign, "reassign_to совпадает с исключаемым")
+
+	isMember, err := repomysql.NewTeamMembersRepo(s.DB).IsMember(ctx, s.teamID, s.memberID)
+	s.Require().NoError(err, methodCtx)
+	s.True(isMember, "после ошибки участник остается в команде")
+
+	err = s.service.RemoveMember(ctx, s.adminID, s.teamID, s.memberID, api.DeleteApiV1TeamsIdMembersUserIdParams{OpenTasks: &reassign, ReassignTo: &s.adminID})
+	s.Require().NoError(err, methodCtx)
+
+	var assigneeID string
+	err = s.DB.QueryRowContext(ctx, "SELECT assignee_id FROM tasks WHERE id = ?", openTaskID.String()).Scan(&assigneeID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(s.adminID.String(), assigneeID)
+
+	err = s.DB.QueryRowContext(ctx, "SELECT assignee_id FROM tasks WHERE id = ?", doneTaskID.String()).Scan(&assigneeID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(s.memberID.String(), assigneeID, "закрытые задачи не переназначаются")
+
+	var assignees int
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM task_assignees WHERE task_id = ? AND user_id = ?",
+		openTaskID.String(),
+		s.memberID.String(),
+	).Scan(&assignees)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(0, assignees)
+
+	var watchers int
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM task_watchers WHERE task_id = ? AND user_id = ?",
+		openTaskID.String(),
+		s.adminID.String(),
+	).Scan(&watchers)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(1, watchers)
+
+	var history int
+	err = s.DB.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM task_history WHERE task_id = ? AND changed_by = ?",
+		openTaskID.String(),
+		s.adminID.String(),
+	).Scan(&history)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(1, history)
+}
+
+func (s *TeamsSuite) TestLeaveUnassignsTasks() {
+	const methodCtx = "teams.TeamsSuite.TestLeaveUnassignsTasks"
+
+	ctx := context.Background()
+	taskID := s.CreateTask(s.teamID, s.ownerID, &s.memberID, "in_progress", "Open", "open task")
+
+	err := s.service.LeaveTeam(ctx, s.memberID, s.teamID, api.PostApiV1TeamsIdLeaveParams{})
+	s.Require().NoError(err, methodCtx)
+
+	var assigneeID sql.NullString
+	err = s.DB.QueryRowContext(ctx, "SELECT assignee_id FROM tasks WHERE id = ?", taskID.String()).Scan(&assigneeID)
+	s.Require().NoError(err, methodCtx)
+	s.False(assigneeID.Valid)
+
+	var assignees int
+	err = s.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM task_assignees WHERE task_id = ?", taskID.String()).Scan(&assignees)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(0, assignees)
+}
+
+func (s *TeamsSuite) TestListAndRevokeInvites() {
+	const methodCtx = "teams.TeamsSuite.TestListAndRevokeInvites"
+
+	ctx := context.Background()
+	firstID, _ := s.CreateInvite(s.teamID, s.ownerID, "first@example.com", "")
+	secondID, code := s.CreateInvite(s.teamID, s.adminID, "second@example.com", "")
+	expiredID, _ := s.CreateInvite(s.teamID, s.ownerID, "expired@example.com", "")
+	s.expireInvite(expiredID)
+
+	_, err := s.service.ListInvites(ctx, s.memberID, s.teamID, api.GetApiV1TeamsIdInvitesParams{})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.ListInvites(ctx, s.ownerID, uuid.New(), api.GetApiV1TeamsIdInvitesParams{})
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+
+	resp, err := s.service.ListInvites(ctx, s.adminID, s.teamID, api.GetApiV1TeamsIdInvitesParams{})
+	s.Require().NoError(err, methodCtx)
+	s.Equal(2, resp.Total)
+	ids := make([]uuid.UUID, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		ids = append(ids, item.Id)
+	}
+	s.ElementsMatch([]uuid.UUID{firstID, secondID}, ids, "истекшие приглашения не выводятся")
+
+	err = s.service.RevokeInvite(ctx, s.memberID, s.teamID, secondID)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	otherTeamID := s.CreateTeam("Other Team", s.ownerID)
+	s.AddTeamMember(otherTeamID, s.ownerID, "owner")
+	err = s.service.RevokeInvite(ctx, s.ownerID, otherTeamID, secondID)
+	s.ErrorIs(err, ErrInviteNotFound, "приглашение другой команды")
+
+	err = s.service.RevokeInvite(ctx, s.adminID, s.teamID, secondID)
+	s.Require().NoError(err, methodCtx)
+
+	inviteUserID := s.CreateUser("second@example.com")
+	_, err = s.service.AcceptInvite(ctx, inviteUserID, api.AcceptInviteRequest{Code: code})
+	s.ErrorIs(err, ErrInviteNotFound, "отозванное приглашение не принимается")
+
+	err = s.service.RevokeInvite(ctx, s.adminID, s.teamID, secondID)
+	s.ErrorIs(err, ErrInviteNotFound, methodCtx)
+}
+
+func (s *TeamsSuite) TestResendInvite() {
+	const methodCtx = "teams.TeamsSuite.TestResendInvite"
+
+	ctx := context.Background()
+	inviteID, oldCode := s.CreateInvite(s.teamID, s.ownerID, "invitee@example.com", "")
+	s.expireInvite(inviteID)
+
+	_, err := s.service.ResendInvite(ctx, s.memberID, s.teamID, inviteID)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.ResendInvite(ctx, s.ownerID, s.teamID, uuid.New())
+	s.ErrorIs(err, ErrInviteNotFound, methodCtx)
+
+	resp, err := s.service.ResendInvite(ctx, s.adminID, s.teamID, inviteID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(inviteID, resp.Id)
+	s.True(resp.ExpiresAt.After(time.Now()), "срок действия продлен")
+
+	msgs := s.mailer.Messages()
+	s.Require().Len(msgs, 1, methodCtx)
+	s.Equal("invitee@example.com", msgs[0].To)
+	newCode := inviteCode(msgs[0].Body)
+	s.Require().NotEmpty(newCode, methodCtx)
+	s.NotEqual(oldCode, newCode)
+
+	inviteUserID := s.CreateUser("invitee@example.com")
+	_, err = s.service.AcceptInvite(ctx, inviteUserID, api.AcceptInviteRequest{Code: oldCode})
+	s.ErrorIs(err, ErrInviteNotFound, "прежний код не действует")
+
+	_, err = s.service.AcceptInvite(ctx, inviteUserID, api.AcceptInviteRequest{Code: newCode})
+	s.Require().NoError(err, methodCtx)
+
+	alreadyID, _ := s.CreateInvite(s.teamID, s.ownerID, "member@example.com", "")
+	_, err = s.service.ResendInvite(ctx, s.ownerID, s.teamID, alreadyID)
+	s.ErrorIs(err, ErrAlreadyMember, methodCtx)
+}
+
+func (s *TeamsSuite) TestResendInviteMailFailureKeepsCode() {
+	const methodCtx = "teams.TeamsSuite.TestResendInviteMailFailureKeepsCode"
+
+	ctx := context.Background()
+	inviteID, oldCode := s.CreateInvite(s.teamID, s.ownerID, "invitee@example.com", "")
+
+	s.mailer.SetError(errors.New("smtp недоступен"))
+	_, err := s.service.ResendInvite(ctx, s.ownerID, s.teamID, inviteID)
+	s.Require().Error(err, methodCtx)
+
+	inviteUserID := s.CreateUser("invitee@example.com")
+	_, err = s.service.AcceptInvite(ctx, inviteUserID, api.AcceptInviteRequest{Code: oldCode})
+	s.Require().NoError(err, "прежний код действует после неудачной отправки")
+}
+
+func (s *TeamsSuite) TestInviteCleanup() {
+	const methodCtx = "teams.TeamsSuite.TestInviteCleanup"
+
+	ctx := context.Background()
+	activeID, _ := s.CreateInvite(s.teamID, s.ownerID, "active@example.com", "")
+	expiredID, _ := s.CreateInvite(s.teamID, s.ownerID, "expired@example.com", "")
+	s.expireInvite(expiredID)
+
+	cleaner, err := NewInviteCleaner(repomysql.NewTeamInvitesRepo(s.DB))
+	s.Require().NoError(err, methodCtx)
+	cleaner.batchSize = 1
+	s.Require().NoError(cleaner.Run(ctx), methodCtx)
+
+	rows, err := s.DB.QueryContext(ctx, "SELECT id FROM team_invites")
+	s.Require().NoError(err, methodCtx)
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		s.Require().NoError(rows.Scan(&id), methodCtx)
+		ids = append(ids, id)
+	}
+	s.Require().NoError(rows.Err(), methodCtx)
+	s.Equal([]string{activeID.String()}, ids)
+}
+
+func (s *TeamsSuite) expireInvite(inviteID uuid.UUID) {
+	const methodCtx = "teams.TeamsSuite.expireInvite"
+
+	_, err := s.DB.ExecContext(
+		context.Background(),
+		"UPDATE team_invites SET expires_at = ? WHERE id = ?",
+		time.Now().UTC().Add(-time.Hour),
+		inviteID.String(),
+	)
+	s.Require().NoError(err, methodCtx)
+}
+
+func inviteCode(body string) string {
+	line, _, _ := strings.Cut(body, "\n")
+	return strings.TrimPrefix(line, "Ваш код приглашения: ")
+}
@@ -0,0 +1,11 @@
+package views
+
+import "errors"
+
+var (
+	ErrForbidden   = errors.New("доступ запрещен")
+	ErrNotFound    = errors.New("не найдено")
+	ErrInvalidName = errors.New("название представления не может быть пустым")
+	ErrInvalidSort = errors.New("неизвестный порядок сортировки")
+	ErrViewExists  = errors.New("представление с таким именем уже существует")
+)
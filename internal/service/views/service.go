@@ -0,0 +1,281 @@
+package views
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// sorts перечисляет допустимые порядки сортировки представления.
+var sorts = map[api.TaskSort]struct{}{
+	api.CreatedAtDesc: {},
+	api.CreatedAtAsc:  {},
+	api.UpdatedAtDesc: {},
+	api.TitleAsc:      {},
+	api.Rank:          {},
+}
+
+// Service управляет сохраненными представлениями списка задач.
+type Service struct {
+	views   ViewsRepository
+	members MembersRepository
+}
+
+// ViewsRepository описывает хранение представлений.
+type ViewsRepository interface {
+	Create(ctx context.Context, record repomysql.TaskViewRecord) error
+	Get(ctx context.Context, viewID uuid.UUID) (repomysql.TaskViewRecord, error)
+	ListVisible(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) ([]repomysql.TaskViewRecord, error)
+	Update(ctx context.Context, record repomysql.TaskViewRecord) error
+	Delete(ctx context.Context, viewID uuid.UUID) error
+}
+
+// MembersRepository описывает доступ к участникам команды.
+type MembersRepository interface {
+	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// NewService создает сервис представлений.
+func NewService(views ViewsRepository, members MembersRepository) (*Service, error) {
+	const methodCtx = "views.NewService"
+
+	slog.Debug("инициализация сервиса представлений", slog.String("context", methodCtx))
+
+	if views == nil {
+		return nil, fmt.Errorf("%s: views repo не задан", methodCtx)
+	}
+	if members == nil {
+		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
+	}
+
+	return &Service{views: views, members: members}, nil
+}
+
+// List возвращает собственные и общие представления команды.
+func (s *Service) List(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) (api.TaskViewsListResponse, error) {
+	const methodCtx = "views.Service.List"
+
+	slog.Debug("вызов списка представлений", slog.String("context", methodCtx))
+
+	if err := s.checkMember(ctx, teamID, userID); err != nil {
+		return api.TaskViewsListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	records, err := s.views.ListVisible(ctx, teamID, userID)
+	if err != nil {
+		return api.TaskViewsListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.TaskView, 0, len(records))
+	for _, record := range records {
+		items = append(items, viewToAPI(record))
+	}
+	return api.TaskViewsListResponse{Items: items}, nil
+}
+
+// Create сохраняет представление пользователя в команде.
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, teamID uuid.UUID, req api.CreateTaskViewRequest) (api.TaskView, error) {
+	const methodCtx = "views.Service.Create"
+
+	slog.Debug("вызов создания представления", slog.String("context", methodCtx))
+
+	if err := s.checkMember(ctx, teamID, userID); err != nil {
+		return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidName)
+	}
+
+	sortOrder := api.CreatedAtDesc
+	if req.Sort != nil {
+		sortOrder = *req.Sort
+	}
+	if _, ok := sorts[sortOrder]; !ok {
+		return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidSort)
+	}
+
+	record := repomysql.TaskViewRecord{
+		ID:        uuid.New(),
+		OwnerID:   userID,
+		TeamID:    teamID,
+		Name:      name,
+		Sort:      string(sortOrder),
+		Shared:    req.Shared != nil && *req.Shared,
+		CreatedAt: time.Now().UTC(),
+	}
+	if req.Status != nil {
+		status := string(*req.Status)
+		record.Status = &status
+	}
+	if req.AssigneeIds != nil {
+		record.AssigneeIDs = *req.AssigneeIds
+	}
+
+	if err := s.views.Create(ctx, record); err != nil {
+		if errors.Is(err, repomysql.ErrTaskViewExists) {
+			return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, ErrViewExists)
+		}
+		return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return viewToAPI(record), nil
+}
+
+// Get возвращает представление, если оно принадлежит пользователю или открыто для команды.
+func (s *Service) Get(ctx context.Context, userID uuid.UUID, viewID uuid.UUID) (api.TaskView, error) {
+	const methodCtx = "views.Service.Get"
+
+	slog.Debug("вызов получения представления", slog.String("context", methodCtx))
+
+	record, err := s.visible(ctx, userID, viewID)
+	if err != nil {
+		return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return viewToAPI(record), nil
+}
+
+// Update изменяет представление. Доступно только владельцу.
+func (s *Service) Update(ctx context.Context, userID uuid.UUID, viewID uuid.UUID, req api.UpdateTaskViewRequest) (api.TaskView, error) {
+	const methodCtx = "views.Service.Update"
+
+	slog.Debug("вызов обновления представления", slog.String("context", methodCtx))
+
+	record, err := s.owned(ctx, userID, viewID)
+	if err != nil {
+		return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidName)
+		}
+		record.Name = name
+	}
+	if req.ClearStatus != nil && *req.ClearStatus {
+		record.Status = nil
+	}
+	if req.Status != nil {
+		status := string(*req.Status)
+		record.Status = &status
+	}
+	if req.AssigneeIds != nil {
+		record.AssigneeIDs = *req.AssigneeIds
+	}
+	if req.Sort != nil {
+		if _, ok := sorts[*req.Sort]; !ok {
+			return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidSort)
+		}
+		record.Sort = string(*req.Sort)
+	}
+	if req.Shared != nil {
+		record.Shared = *req.Shared
+	}
+
+	now := time.Now().UTC()
+	record.UpdatedAt = &now
+
+	if err := s.views.Update(ctx, record); err != nil {
+		if errors.Is(err, repomysql.ErrTaskViewExists) {
+			return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, ErrViewExists)
+		}
+		return api.TaskView{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return viewToAPI(record), nil
+}
+
+// Delete удаляет представление. Доступно только владельцу.
+func (s *Service) Delete(ctx context.Context, userID uuid.UUID, viewID uuid.UUID) error {
+	const methodCtx = "views.Service.Delete"
+
+	slog.Debug("вызов удаления представления", slog.String("context", methodCtx))
+
+	if _, err := s.owned(ctx, userID, viewID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.views.Delete(ctx, viewID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	return nil
+}
+
+// visible возвращает представление, доступное пользователю для чтения.
+// Чужое личное представление считается ненайденным, чтобы не раскрывать его существование.
+func (s *Service) visible(ctx context.Context, userID uuid.UUID, viewID uuid.UUID) (repomysql.TaskViewRecord, error) {
+	record, err := s.views.Get(ctx, viewID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repomysql.TaskViewRecord{}, ErrNotFound
+		}
+		return repomysql.TaskViewRecord{}, err
+	}
+	if record.OwnerID != userID && !record.Shared {
+		return repomysql.TaskViewRecord{}, ErrNotFound
+	}
+	if err := s.checkMember(ctx, record.TeamID, userID); err != nil {
+		return repomysql.TaskViewRecord{}, err
+	}
+	return record, nil
+}
+
+// owned возвращает представление, которое пользователь может изменять.
+func (s *Service) owned(ctx context.Context, userID uuid.UUID, viewID uuid.UUID) (repomysql.TaskViewRecord, error) {
+	record, err := s.visible(ctx, userID, viewID)
+	if err != nil {
+		return repomysql.TaskViewRecord{}, err
+	}
+	if record.OwnerID != userID {
+		return repomysql.TaskViewRecord{}, ErrForbidden
+	}
+	return record, nil
+}
+
+func (s *Service) checkMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
+	member, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func viewToAPI(record repomysql.TaskViewRecord) api.TaskView {
+	assigneeIDs := make([]api.UUID, 0, len(record.AssigneeIDs))
+	for _, id := range record.AssigneeIDs {
+		assigneeIDs = append(assigneeIDs, api.UUID(id))
+	}
+
+	var status *api.TaskStatus
+	if record.Status != nil {
+		value := api.TaskStatus(*record.Status)
+		status = &value
+	}
+
+	return api.TaskView{
+		Id:          record.ID,
+		OwnerId:     record.OwnerID,
+		TeamId:      record.TeamID,
+		Name:        record.Name,
+		Status:      status,
+		AssigneeIds: assigneeIDs,
+		Sort:        api.TaskSort(record.Sort),
+		Shared:      record.Shared,
+		CreatedAt:   record.CreatedAt,
+		UpdatedAt:   record.UpdatedAt,
+	}
+}
@@ -0,0 +1,131 @@
+package views
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+type ViewsSuite struct {
+	tests.IntegrationSuite
+	service    *Service
+	ownerID    uuid.UUID
+	memberID   uuid.UUID
+	outsiderID uuid.UUID
+	teamID     uuid.UUID
+}
+
+func TestViewsSuite(t *testing.T) {
+	const methodCtx = "views.TestViewsSuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(ViewsSuite))
+}
+
+func (s *ViewsSuite) SetupTest() {
+	const methodCtx = "views.ViewsSuite.SetupTest"
+
+	s.TruncateTables(
+		"task_views",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-views@example.com")
+	s.memberID = s.CreateUser("member-views@example.com")
+	s.outsiderID = s.CreateUser("outsider-views@example.com")
+
+	s.teamID = s.CreateTeam("Views Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.memberID, "member")
+
+	service, err := NewService(repomysql.NewTaskViewsRepo(s.DB), repomysql.NewTeamMembersRepo(s.DB))
+	s.Require().NoError(err, methodCtx)
+	s.service = service
+}
+
+func (s *ViewsSuite) TestCreateAndVisibility() {
+	const methodCtx = "views.ViewsSuite.TestCreateAndVisibility"
+
+	ctx := context.Background()
+	status := api.InProgress
+	assignees := []api.UUID{s.memberID}
+	shared := true
+
+	private, err := s.service.Create(ctx, s.ownerID, s.teamID, api.CreateTaskViewRequest{
+		Name:        " Mine ",
+		Status:      &status,
+		AssigneeIds: &assignees,
+	})
+	s.Require().NoError(err, methodCtx)
+	s.Equal("Mine", private.Name)
+	s.Equal(api.CreatedAtDesc, private.Sort)
+	s.False(private.Shared)
+
+	public, err := s.service.Create(ctx, s.ownerID, s.teamID, api.CreateTaskViewRequest{Name: "Team", Shared: &shared})
+	s.Require().NoError(err, methodCtx)
+
+	got, err := s.service.Get(ctx, s.ownerID, private.Id)
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(got.Status)
+	s.Equal(api.InProgress, *got.Status)
+	s.Equal([]api.UUID{s.memberID}, got.AssigneeIds)
+
+	list, err := s.service.List(ctx, s.memberID, s.teamID)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(list.Items, 1)
+	s.Equal(public.Id, list.Items[0].Id)
+
+	_, err = s.service.Get(ctx, s.memberID, private.Id)
+	s.ErrorIs(err, ErrNotFound)
+
+	_, err = s.service.Get(ctx, s.outsiderID, public.Id)
+	s.ErrorIs(err, ErrForbidden)
+
+	_, err = s.service.Create(ctx, s.ownerID, s.teamID, api.CreateTaskViewRequest{Name: "Mine"})
+	s.ErrorIs(err, ErrViewExists)
+
+	_, err = s.service.Create(ctx, s.ownerID, s.teamID, api.CreateTaskViewRequest{Name: "   "})
+	s.ErrorIs(err, ErrInvalidName)
+
+	_, err = s.service.Create(ctx, s.outsiderID, s.teamID, api.CreateTaskViewRequest{Name: "Nope"})
+	s.ErrorIs(err, ErrForbidden)
+}
+
+func (s *ViewsSuite) TestUpdateAndDelete() {
+	const methodCtx = "views.ViewsSuite.TestUpdateAndDelete"
+
+	ctx := context.Background()
+	status := api.Todo
+	shared := true
+
+	view, err := s.service.Create(ctx, s.ownerID, s.teamID, api.CreateTaskViewRequest{Name: "Daily", Status: &status, Shared: &shared})
+	s.Require().NoError(err, methodCtx)
+
+	name := "Renamed"
+	_, err = s.service.Update(ctx, s.memberID, view.Id, api.UpdateTaskViewRequest{Name: &name})
+	s.ErrorIs(err, ErrForbidden)
+
+	sortTitle := api.TitleAsc
+	clearStatus := true
+	updated, err := s.service.Update(ctx, s.ownerID, view.Id, api.UpdateTaskViewRequest{Name: &name, Sort: &sortTitle, ClearStatus: &clearStatus})
+	s.Require().NoError(err, methodCtx)
+	s.Equal("Renamed", updated.Name)
+	s.Equal(api.TitleAsc, updated.Sort)
+	s.Nil(updated.Status)
+	s.NotNil(updated.UpdatedAt)
+
+	s.ErrorIs(s.service.Delete(ctx, s.memberID, view.Id), ErrForbidden)
+	s.Require().NoError(s.service.Delete(ctx, s.ownerID, view.Id), methodCtx)
+
+	_, err = s.service.Get(ctx, s.ownerID, view.Id)
+	s.ErrorIs(err, ErrNotFound)
+}
@@ -0,0 +1,8 @@
+package watchers
+
+import "errors"
+
+var (
+	ErrForbidden = errors.New("доступ запрещен")
+	ErrNotFound  = errors.New("не найдено")
+)
@@ -0,0 +1,158 @@
+package watchers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// Service реализует подписки пользователей на задачи.
+type Service struct {
+	watchers WatchersRepository
+	tasks    TasksRepository
+	members  MembersRepository
+}
+
+// WatchersRepository описывает работу с наблюдателями задач.
+type WatchersRepository interface {
+	Add(ctx context.Context, exec repomysql.DBTX, taskID uuid.UUID, userID uuid.UUID, createdAt time.Time) error
+	Remove(ctx context.Context, taskID uuid.UUID, userID uuid.UUID) error
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]repomysql.TaskWatcherRecord, error)
+}
+
+// TasksRepository описывает доступ к задачам.
+type TasksRepository interface {
+	GetTeamID(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error)
+}
+
+// MembersRepository описывает доступ к участникам команды.
+type MembersRepository interface {
+	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// NewService создает сервис наблюдателей.
+func NewService(watchers WatchersRepository, tasks TasksRepository, members MembersRepository) (*Service, error) {
+	const methodCtx = "watchers.NewService"
+
+	slog.Debug("инициализация сервиса наблюдателей", slog.String("context", methodCtx))
+
+	if watchers == nil {
+		return nil, fmt.Errorf("%s: watchers repo не задан", methodCtx)
+	}
+	if tasks == nil {
+		return nil, fmt.Errorf("%s: tasks repo не задан", methodCtx)
+	}
+	if members == nil {
+		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
+	}
+
+	return &Service{watchers: watchers, tasks: tasks, members: members}, nil
+}
+
+// Watch подписывает пользователя на задачу.
+func (s *Service) Watch(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.TaskWatcher, error) {
+	const methodCtx = "watchers.Service.Watch"
+
+	slog.Debug("вызов подписки на задачу", slog.String("context", methodCtx))
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return api.TaskWatcher{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	now := time.Now().UTC()
+	if err := s.watchers.Add(ctx, nil, taskID, userID, now); err != nil {
+		return api.TaskWatcher{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return api.TaskWatcher{TaskId: api.UUID(taskID), UserId: api.UUID(userID), CreatedAt: now}, nil
+}
+
+// Unwatch отписывает пользователя от задачи.
+func (s *Service) Unwatch(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) error {
+	const methodCtx = "watchers.Service.Unwatch"
+
+	slog.Debug("вызов отписки от задачи", slog.String("context", methodCtx))
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if err := s.watchers.Remove(ctx, taskID, userID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return nil
+}
+
+// List возвращает наблюдателей задачи.
+func (s *Service) List(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.TaskWatchersListResponse, error) {
+	const methodCtx = "watchers.Service.List"
+
+	slog.Debug("вызов списка наблюдателей", slog.String("context", methodCtx))
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return api.TaskWatchersListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	records, err := s.watchers.ListByTask(ctx, taskID)
+	if err != nil {
+		return api.TaskWatchersListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	items := make([]api.TaskWatcher, 0, len(records))
+	for _, record := range records {
+		items = append(items, api.TaskWatcher{
+			TaskId:    api.UUID(record.TaskID),
+			UserId:    api.UUID(record.UserID),
+			CreatedAt: record.CreatedAt,
+		})
+	}
+
+	return api.TaskWatchersListResponse{Items: items}, nil
+}
+
+// Watchers возвращает id пользователей, которых нужно уведомлять об изменениях задачи.
+// Метод не проверяет права и предназначен для внутренних подсистем (уведомления, почта).
+func (s *Service) Watchers(ctx context.Context, taskID uuid.UUID) ([]uuid.UUID, error) {
+	const methodCtx = "watchers.Service.Watchers"
+
+	records, err := s.watchers.ListByTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.UserID)
+	}
+
+	return ids, nil
+}
+
+func (s *Service) checkAccess(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) error {
+	teamID, err := s.tasks.GetTeamID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	isMember, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrForbidden
+	}
+
+	return nil
+}
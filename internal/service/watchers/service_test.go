@@ -0,0 +1,136 @@
+package watchers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+type WatchersSuite struct {
+	tests.IntegrationSuite
+	service    *Service
+	ownerID    uuid.UUID
+	memberID   uuid.UUID
+	outsiderID uuid.UUID
+	teamID     uuid.UUID
+	taskID     uuid.UUID
+}
+
+func TestWatchersSuite(t *testing.T) {
+	const methodCtx = "watchers.TestWatchersSuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(WatchersSuite))
+}
+
+func (s *WatchersSuite) SetupTest() {
+	const methodCtx = "watchers.WatchersSuite.SetupTest"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-watch@example.com")
+	s.memberID = s.CreateUser("member-watch@example.com")
+	s.outsiderID = s.CreateUser("outsider-watch@example.com")
+
+	s.teamID = s.CreateTeam("Watch Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.memberID, "member")
+
+	s.taskID = s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Task", "")
+
+	watchersRepo := repomysql.NewTaskWatchersRepo(s.DB)
+	tasksRepo := repomysql.NewTasksRepo(s.DB)
+	membersRepo := repomysql.NewTeamMembersRepo(s.DB)
+	service, err := NewService(watchersRepo, tasksRepo, membersRepo)
+	s.Require().NoError(err, methodCtx)
+	s.service = service
+}
+
+func (s *WatchersSuite) TestWatchAndList() {
+	const methodCtx = "watchers.WatchersSuite.TestWatchAndList"
+
+	ctx := context.Background()
+
+	resp, err := s.service.Watch(ctx, s.memberID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal(s.memberID, resp.UserId)
+
+	_, err = s.service.Watch(ctx, s.memberID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+
+	list, err := s.service.List(ctx, s.ownerID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.Require().Len(list.Items, 1)
+	s.Equal(s.memberID, list.Items[0].UserId)
+}
+
+func (s *WatchersSuite) TestUnwatch() {
+	const methodCtx = "watchers.WatchersSuite.TestUnwatch"
+
+	ctx := context.Background()
+
+	_, err := s.service.Watch(ctx, s.memberID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+
+	err = s.service.Unwatch(ctx, s.memberID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+
+	ids, err := s.service.Watchers(ctx, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.Empty(ids)
+}
+
+func (s *WatchersSuite) TestWatchForbidden() {
+	const methodCtx = "watchers.WatchersSuite.TestWatchForbidden"
+
+	ctx := context.Background()
+
+	_, err := s.service.Watch(ctx, s.outsiderID, s.taskID)
+	s.Require().Error(err, methodCtx)
+	s.ErrorIs(err, ErrForbidden)
+}
+
+func (s *WatchersSuite) TestWatchNotFound() {
+	const methodCtx = "watchers.WatchersSuite.TestWatchNotFound"
+
+	ctx := context.Background()
+
+	_, err := s.service.Watch(ctx, s.memberID, uuid.New())
+	s.Require().Error(err, methodCtx)
+	s.ErrorIs(err, ErrNotFound)
+}
+
+func (s *WatchersSuite) TestWatchersSkipsFormerMembers() {
+	const methodCtx = "watchers.WatchersSuite.TestWatchersSkipsFormerMembers"
+
+	ctx := context.Background()
+
+	_, err := s.service.Watch(ctx, s.memberID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	_, err = s.service.Watch(ctx, s.ownerID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.DB.ExecContext(ctx, "DELETE FROM team_members WHERE team_id = ? AND user_id = ?", s.teamID.String(), s.memberID.String())
+	s.Require().NoError(err, methodCtx)
+
+	ids, err := s.service.Watchers(ctx, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.Equal([]uuid.UUID{s.ownerID}, ids)
+}
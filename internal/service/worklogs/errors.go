@@ -0,0 +1,11 @@
+package worklogs
+
+import "errors"
+
+var (
+	ErrForbidden       = errors.New("доступ запрещен")
+	ErrNotFound        = errors.New("не найдено")
+	ErrInvalidDuration = errors.New("длительность должна быть положительной")
+	ErrTimerRunning    = errors.New("таймер уже запущен")
+	ErrTimerNotRunning = errors.New("таймер не запущен")
+)
@@ -0,0 +1,331 @@
+package worklogs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+)
+
+// Service реализует учет времени по задачам.
+type Service struct {
+	worklogs WorklogsRepository
+	tasks    TasksRepository
+	members  MembersRepository
+	now      func() time.Time
+}
+
+// WorklogsRepository описывает работу с записями времени.
+type WorklogsRepository interface {
+	Create(ctx context.Context, record repomysql.WorklogRecord) error
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]repomysql.WorklogRecord, error)
+	Get(ctx context.Context, taskID uuid.UUID, worklogID uuid.UUID) (repomysql.WorklogRecord, error)
+	GetRunning(ctx context.Context, taskID uuid.UUID, userID uuid.UUID) (repomysql.WorklogRecord, error)
+	Update(ctx context.Context, record repomysql.WorklogRecord) error
+	Stop(ctx context.Context, worklogID uuid.UUID, durationMinutes int, note *string) (bool, error)
+	Delete(ctx context.Context, worklogID uuid.UUID) error
+}
+
+// TasksRepository описывает доступ к задачам.
+type TasksRepository interface {
+	GetTeamID(ctx context.Context, taskID uuid.UUID) (uuid.UUID, error)
+}
+
+// MembersRepository описывает доступ к участникам команды.
+type MembersRepository interface {
+	IsMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+// NewService создает сервис учета времени.
+func NewService(worklogs WorklogsRepository, tasks TasksRepository, members MembersRepository) (*Service, error) {
+	const methodCtx = "worklogs.NewService"
+
+	slog.Debug("инициализация сервиса учета времени", slog.String("context", methodCtx))
+
+	if worklogs == nil {
+		return nil, fmt.Errorf("%s: worklogs repo не задан", methodCtx)
+	}
+	if tasks == nil {
+		return nil, fmt.Errorf("%s: tasks repo не задан", methodCtx)
+	}
+	if members == nil {
+		return nil, fmt.Errorf("%s: members repo не задан", methodCtx)
+	}
+
+	return &Service{worklogs: worklogs, tasks: tasks, members: members, now: time.Now}, nil
+}
+
+// Create добавляет запись о затраченном времени.
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, req api.CreateWorklogRequest) (api.Worklog, error) {
+	const methodCtx = "worklogs.Service.Create"
+
+	slog.Debug("вызов создания записи времени", slog.String("context", methodCtx))
+
+	if req.DurationMinutes <= 0 {
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidDuration)
+	}
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	duration := req.DurationMinutes
+	record := repomysql.WorklogRecord{
+		ID:              uuid.New(),
+		TaskID:          taskID,
+		UserID:          userID,
+		StartedAt:       req.StartedAt.UTC(),
+		DurationMinutes: &duration,
+		Note:            normalizeNote(req.Note),
+		CreatedAt:       s.now().UTC(),
+	}
+
+	if err := s.worklogs.Create(ctx, record); err != nil {
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return worklogToAPI(record), nil
+}
+
+// List возвращает записи задачи и суммарное время по завершенным записям.
+func (s *Service) List(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.WorklogsListResponse, error) {
+	const methodCtx = "worklogs.Service.List"
+
+	slog.Debug("вызов списка записей времени", slog.String("context", methodCtx))
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return api.WorklogsListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	records, err := s.worklogs.ListByTask(ctx, taskID)
+	if err != nil {
+		return api.WorklogsListResponse{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	total := 0
+	items := make([]api.Worklog, 0, len(records))
+	for _, record := range records {
+		if record.DurationMinutes != nil {
+			total += *record.DurationMinutes
+		}
+		items = append(items, worklogToAPI(record))
+	}
+
+	return api.WorklogsListResponse{Items: items, TotalMinutes: total}, nil
+}
+
+// Update изменяет завершенную запись. Изменять может только автор записи.
+func (s *Service) Update(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, worklogID uuid.UUID, req api.UpdateWorklogRequest) (api.Worklog, error) {
+	const methodCtx = "worklogs.Service.Update"
+
+	slog.Debug("вызов обновления записи времени", slog.String("context", methodCtx))
+
+	record, err := s.getOwned(ctx, userID, taskID, worklogID)
+	if err != nil {
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	if record.DurationMinutes == nil {
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, ErrTimerRunning)
+	}
+
+	if req.StartedAt != nil {
+		record.StartedAt = req.StartedAt.UTC()
+	}
+	if req.DurationMinutes != nil {
+		if *req.DurationMinutes <= 0 {
+			return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, ErrInvalidDuration)
+		}
+		duration := *req.DurationMinutes
+		record.DurationMinutes = &duration
+	}
+	if req.Note != nil {
+		record.Note = normalizeNote(req.Note)
+	}
+
+	if err := s.worklogs.Update(ctx, record); err != nil {
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return worklogToAPI(record), nil
+}
+
+// Delete удаляет запись. Удаление запущенного таймера отменяет его.
+// Свой запущенный таймер автор может отменить и после выхода из команды задачи.
+func (s *Service) Delete(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, worklogID uuid.UUID) error {
+	const methodCtx = "worklogs.Service.Delete"
+
+	slog.Debug("вызов удаления записи времени", slog.String("context", methodCtx))
+
+	running, err := s.isOwnRunning(ctx, userID, taskID, worklogID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !running {
+		if _, err := s.getOwned(ctx, userID, taskID, worklogID); err != nil {
+			return fmt.Errorf("%s: %w", methodCtx, err)
+		}
+	}
+
+	if err := s.worklogs.Delete(ctx, worklogID); err != nil {
+		return fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return nil
+}
+
+// StartTimer запускает таймер. У пользователя может быть только один запущенный таймер.
+func (s *Service) StartTimer(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) (api.Worklog, error) {
+	const methodCtx = "worklogs.Service.StartTimer"
+
+	slog.Debug("вызов запуска таймера", slog.String("context", methodCtx))
+
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	now := s.now().UTC().Truncate(time.Second)
+	record := repomysql.WorklogRecord{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: now,
+		CreatedAt: now,
+	}
+
+	if err := s.worklogs.Create(ctx, record); err != nil {
+		if errors.Is(err, repomysql.ErrWorklogRunning) {
+			return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, ErrTimerRunning)
+		}
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+
+	return worklogToAPI(record), nil
+}
+
+// StopTimer останавливает таймер пользователя по задаче.
+// Длительность округляется до минут, но не меньше одной минуты.
+// Свой запущенный таймер пользователь может остановить и после исключения из команды или переноса
+// задачи в чужую команду: иначе таймер остался бы запущенным и блокировал запуск новых.
+func (s *Service) StopTimer(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, note *string) (api.Worklog, error) {
+	const methodCtx = "worklogs.Service.StopTimer"
+
+	slog.Debug("вызов остановки таймера", slog.String("context", methodCtx))
+
+	record, err := s.worklogs.GetRunning(ctx, taskID, userID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		if err := s.checkAccess(ctx, userID, taskID); err != nil {
+			return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, err)
+		}
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, ErrTimerNotRunning)
+	}
+
+	duration := int(math.Round(s.now().Sub(record.StartedAt).Minutes()))
+	if duration < 1 {
+		duration = 1
+	}
+	note = normalizeNote(note)
+
+	stopped, err := s.worklogs.Stop(ctx, record.ID, duration, note)
+	if err != nil {
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, err)
+	}
+	if !stopped {
+		return api.Worklog{}, fmt.Errorf("%s: %w", methodCtx, ErrTimerNotRunning)
+	}
+
+	record.DurationMinutes = &duration
+	if note != nil {
+		record.Note = note
+	}
+
+	return worklogToAPI(record), nil
+}
+
+func (s *Service) getOwned(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, worklogID uuid.UUID) (repomysql.WorklogRecord, error) {
+	if err := s.checkAccess(ctx, userID, taskID); err != nil {
+		return repomysql.WorklogRecord{}, err
+	}
+
+	record, err := s.worklogs.Get(ctx, taskID, worklogID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repomysql.WorklogRecord{}, ErrNotFound
+		}
+		return repomysql.WorklogRecord{}, err
+	}
+
+	if record.UserID != userID {
+		return repomysql.WorklogRecord{}, ErrForbidden
+	}
+
+	return record, nil
+}
+
+// isOwnRunning проверяет, что запись — запущенный таймер пользователя. Такие записи доступны автору без проверки членства.
+func (s *Service) isOwnRunning(ctx context.Context, userID uuid.UUID, taskID uuid.UUID, worklogID uuid.UUID) (bool, error) {
+	record, err := s.worklogs.Get(ctx, taskID, worklogID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return record.UserID == userID && record.DurationMinutes == nil, nil
+}
+
+func (s *Service) checkAccess(ctx context.Context, userID uuid.UUID, taskID uuid.UUID) error {
+	teamID, err := s.tasks.GetTeamID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	isMember, err := s.members.IsMember(ctx, teamID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func worklogToAPI(record repomysql.WorklogRecord) api.Worklog {
+	return api.Worklog{
+		Id:              api.UUID(record.ID),
+		TaskId:          api.UUID(record.TaskID),
+		UserId:          api.UUID(record.UserID),
+		StartedAt:       record.StartedAt,
+		DurationMinutes: record.DurationMinutes,
+		Running:         record.DurationMinutes == nil,
+		Note:            record.Note,
+		CreatedAt:       record.CreatedAt,
+	}
+}
+
+func normalizeNote(note *string) *string {
+	if note == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*note)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
@@ -0,0 +1,190 @@
+package worklogs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Seraf-seraf/mkk_test/internal/api"
+	repomysql "github.com/Seraf-seraf/mkk_test/internal/repo/mysql"
+	"github.com/Seraf-seraf/mkk_test/internal/tests"
+)
+
+type WorklogsSuite struct {
+	tests.IntegrationSuite
+	service    *Service
+	ownerID    uuid.UUID
+	memberID   uuid.UUID
+	outsiderID uuid.UUID
+	teamID     uuid.UUID
+	taskID     uuid.UUID
+}
+
+func TestWorklogsSuite(t *testing.T) {
+	const methodCtx = "worklogs.TestWorklogsSuite"
+
+	t.Log(methodCtx)
+	suite.Run(t, new(WorklogsSuite))
+}
+
+func (s *WorklogsSuite) SetupTest() {
+	const methodCtx = "worklogs.WorklogsSuite.SetupTest"
+
+	s.TruncateTables(
+		"task_assignees",
+		"task_worklogs",
+		"task_attachments",
+		"task_watchers",
+		"task_comments",
+		"task_history",
+		"tasks",
+		"team_invites",
+		"team_members",
+		"teams",
+		"users",
+	)
+
+	s.ownerID = s.CreateUser("owner-worklog@example.com")
+	s.memberID = s.CreateUser("member-worklog@example.com")
+	s.outsiderID = s.CreateUser("outsider-worklog@example.com")
+
+	s.teamID = s.CreateTeam("Worklog Team", s.ownerID)
+	s.AddTeamMember(s.teamID, s.ownerID, "owner")
+	s.AddTeamMember(s.teamID, s.memberID, "member")
+
+	s.taskID = s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Task", "")
+
+	service, err := NewService(
+		repomysql.NewTaskWorklogsRepo(s.DB),
+		repomysql.NewTasksRepo(s.DB),
+		repomysql.NewTeamMembersRepo(s.DB),
+	)
+	s.Require().NoError(err, methodCtx)
+	s.service = service
+}
+
+func (s *WorklogsSuite) TestCreateListUpdateDelete() {
+	const methodCtx = "worklogs.WorklogsSuite.TestCreateListUpdateDelete"
+
+	ctx := context.Background()
+	note := "ревью"
+	startedAt := time.Now().UTC().Add(-2 * time.Hour).Truncate(time.Second)
+
+	created, err := s.service.Create(ctx, s.memberID, s.taskID, api.CreateWorklogRequest{
+		StartedAt:       startedAt,
+		DurationMinutes: 45,
+		Note:            &note,
+	})
+	s.Require().NoError(err, methodCtx)
+	s.False(created.Running)
+
+	_, err = s.service.Create(ctx, s.ownerID, s.taskID, api.CreateWorklogRequest{StartedAt: startedAt, DurationMinutes: 15})
+	s.Require().NoError(err, methodCtx)
+
+	list, err := s.service.List(ctx, s.ownerID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.Len(list.Items, 2)
+	s.Equal(60, list.TotalMinutes)
+
+	duration := 30
+	_, err = s.service.Update(ctx, s.ownerID, s.taskID, created.Id, api.UpdateWorklogRequest{DurationMinutes: &duration})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	updated, err := s.service.Update(ctx, s.memberID, s.taskID, created.Id, api.UpdateWorklogRequest{DurationMinutes: &duration})
+	s.Require().NoError(err, methodCtx)
+	s.Require().NotNil(updated.DurationMinutes)
+	s.Equal(30, *updated.DurationMinutes)
+
+	s.Require().NoError(s.service.Delete(ctx, s.memberID, s.taskID, created.Id), methodCtx)
+
+	list, err = s.service.List(ctx, s.ownerID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.Len(list.Items, 1)
+	s.Equal(15, list.TotalMinutes)
+}
+
+func (s *WorklogsSuite) TestCreateValidation() {
+	const methodCtx = "worklogs.WorklogsSuite.TestCreateValidation"
+
+	ctx := context.Background()
+
+	_, err := s.service.Create(ctx, s.memberID, s.taskID, api.CreateWorklogRequest{StartedAt: time.Now(), DurationMinutes: 0})
+	s.ErrorIs(err, ErrInvalidDuration, methodCtx)
+
+	_, err = s.service.Create(ctx, s.outsiderID, s.taskID, api.CreateWorklogRequest{StartedAt: time.Now(), DurationMinutes: 5})
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	_, err = s.service.List(ctx, s.memberID, uuid.New())
+	s.ErrorIs(err, ErrNotFound, methodCtx)
+}
+
+func (s *WorklogsSuite) TestTimer() {
+	const methodCtx = "worklogs.WorklogsSuite.TestTimer"
+
+	ctx := context.Background()
+	start := time.Now().UTC()
+	s.service.now = func() time.Time { return start }
+
+	_, err := s.service.StopTimer(ctx, s.memberID, s.taskID, nil)
+	s.ErrorIs(err, ErrTimerNotRunning, methodCtx)
+
+	started, err := s.service.StartTimer(ctx, s.memberID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	s.True(started.Running)
+
+	otherTask := s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Other", "")
+	_, err = s.service.StartTimer(ctx, s.memberID, otherTask)
+	s.ErrorIs(err, ErrTimerRunning, methodCtx)
+
+	_, err = s.service.StartTimer(ctx, s.ownerID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+
+	duration := 10
+	_, err = s.service.Update(ctx, s.memberID, s.taskID, started.Id, api.UpdateWorklogRequest{DurationMinutes: &duration})
+	s.ErrorIs(err, ErrTimerRunning, methodCtx)
+
+	s.service.now = func() time.Time { return start.Add(25 * time.Minute) }
+	note := "готово"
+	stopped, err := s.service.StopTimer(ctx, s.memberID, s.taskID, &note)
+	s.Require().NoError(err, methodCtx)
+	s.False(stopped.Running)
+	s.Require().NotNil(stopped.DurationMinutes)
+	s.Equal(25, *stopped.DurationMinutes)
+
+	_, err = s.service.StartTimer(ctx, s.memberID, otherTask)
+	s.Require().NoError(err, methodCtx)
+}
+
+func (s *WorklogsSuite) TestStopTimerAfterRemoval() {
+	const methodCtx = "worklogs.WorklogsSuite.TestStopTimerAfterRemoval"
+
+	ctx := context.Background()
+	start := time.Now().UTC()
+	s.service.now = func() time.Time { return start }
+
+	_, err := s.service.StartTimer(ctx, s.memberID, s.taskID)
+	s.Require().NoError(err, methodCtx)
+	otherTask := s.CreateTask(s.teamID, s.ownerID, nil, "todo", "Other", "")
+	cancelled, err := s.service.StartTimer(ctx, s.ownerID, otherTask)
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.DB.ExecContext(ctx, "DELETE FROM team_members WHERE team_id = ? AND user_id IN (?, ?)", s.teamID.String(), s.memberID.String(), s.ownerID.String())
+	s.Require().NoError(err, methodCtx)
+
+	_, err = s.service.StartTimer(ctx, s.memberID, s.taskID)
+	s.ErrorIs(err, ErrForbidden, methodCtx)
+
+	s.service.now = func() time.Time { return start.Add(5 * time.Minute) }
+	stopped, err := s.service.StopTimer(ctx, s.memberID, s.taskID, nil)
+	s.Require().NoError(err, "свой таймер останавливается без членства в команде")
+	s.False(stopped.Running)
+
+	_, err = s.service.StopTimer(ctx, s.memberID, s.taskID, nil)
+	s.ErrorIs(err, ErrForbidden, "без запущенного таймера проверяется членство")
+
+	s.Require().NoError(s.service.Delete(ctx, s.ownerID, otherTask, cancelled.Id), "свой запущенный таймер отменяется без членства")
+	s.ErrorIs(s.service.Delete(ctx, s.memberID, s.taskID, stopped.Id), ErrForbidden, "завершенные записи требуют членства")
+}
@@ -1,7 +1,9 @@
 package tests
 
 import (
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"time"
 
 	"github.com/google/uuid"
@@ -75,16 +77,18 @@ func (s *IntegrationSuite) CreateInvite(teamID uuid.UUID, inviterID uuid.UUID, e
 		code = uuid.NewString()
 	}
 	now := time.Now().UTC()
+	sum := sha256.Sum256([]byte(code))
 
 	_, err := s.DB.ExecContext(
 		s.ctx,
-		"INSERT INTO team_invites (id, team_id, email, inviter_id, code, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		"INSERT INTO team_invites (id, team_id, email, inviter_id, code_hash, created_at, expires_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
 		id.String(),
 		teamID.String(),
 		email,
 		inviterID.String(),
-		code,
+		hex.EncodeToString(sum[:]),
 		now,
+		now.Add(24*time.Hour),
 	)
 	s.Require().NoError(err, methodCtx)
 
@@ -123,6 +127,17 @@ func (s *IntegrationSuite) CreateTask(teamID uuid.UUID, createdBy uuid.UUID, ass
 	)
 	s.Require().NoError(err, methodCtx)
 
+	if assigneeID != nil {
+		_, err = s.DB.ExecContext(
+			s.ctx,
+			"INSERT INTO task_assignees (task_id, user_id, is_primary, created_at) VALUES (?, ?, 1, ?)",
+			id.String(),
+			assigneeID.String(),
+			now,
+		)
+		s.Require().NoError(err, methodCtx)
+	}
+
 	return id
 }
 
@@ -168,3 +183,39 @@ func (s *IntegrationSuite) CreateComment(taskID uuid.UUID, userID uuid.UUID, bod
 
 	return id
 }
+
+func (s *IntegrationSuite) CreateMemberEvent(teamID uuid.UUID, userID *uuid.UUID, actorID uuid.UUID, event string, email string, oldRole string, newRole string) uuid.UUID {
+	const methodCtx = "tests.IntegrationSuite.CreateMemberEvent"
+
+	id := uuid.New()
+	now := time.Now().UTC()
+
+	var userValue interface{}
+	if userID != nil {
+		userValue = userID.String()
+	}
+	nullable := func(value string) interface{} {
+		if value == "" {
+			return nil
+		}
+		return value
+	}
+
+	_, err := s.DB.ExecContext(
+		s.ctx,
+		`INSERT INTO team_member_events (id, team_id, user_id, email, actor_id, event, old_role, new_role, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		id.String(),
+		teamID.String(),
+		userValue,
+		nullable(email),
+		actorID.String(),
+		event,
+		nullable(oldRole),
+		nullable(newRole),
+		now,
+	)
+	s.Require().NoError(err, methodCtx)
+
+	return id
+}